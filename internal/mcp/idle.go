@@ -0,0 +1,45 @@
+package mcp
+
+import (
+	"time"
+
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// WithIdleTimeout stops the server, closing its transport, when no request
+// arrives for timeout. The timer restarts after each request is handled.
+// Zero or negative disables the timeout.
+func WithIdleTimeout(timeout time.Duration) Option {
+	return func(s *Server) {
+		if timeout > 0 {
+			s.idleTimeout = timeout
+		}
+	}
+}
+
+// readResult is the outcome of one transport read
+type readResult struct {
+	req *api.Request
+	err error
+}
+
+// readAsync starts a single transport read, delivering its result on the
+// returned channel so the caller can wait on it alongside other events
+func readAsync(transport api.Transport) <-chan readResult {
+	results := make(chan readResult, 1)
+	go func() {
+		req, err := transport.Read()
+		results <- readResult{req: req, err: err}
+	}()
+	return results
+}
+
+// idleTimer returns a channel that fires after the server's idle timeout,
+// or nil when there is none, and a function releasing the timer
+func (s *Server) idleTimer() (<-chan time.Time, func()) {
+	if s.idleTimeout <= 0 {
+		return nil, func() {}
+	}
+	timer := time.NewTimer(s.idleTimeout)
+	return timer.C, func() { timer.Stop() }
+}
@@ -0,0 +1,61 @@
+package mcp
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// tokenBucket is a token-bucket rate limiter. Tokens refill continuously at
+// rate per second up to burst; each allowed call takes one token.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+// newTokenBucket creates a full bucket. A burst below 1 defaults to the
+// per-second rate, rounded up.
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = int(math.Max(1, math.Ceil(rate)))
+	}
+	b := &tokenBucket{
+		rate:  rate,
+		burst: float64(burst),
+		now:   time.Now,
+	}
+	b.tokens = b.burst
+	b.last = b.now()
+	return b
+}
+
+// allow takes a token if one is available
+func (b *tokenBucket) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// WithRateLimit limits tools/call to rate calls per second with bursts of up
+// to burst calls. Calls over the limit are rejected with RateLimitExceeded.
+// A non-positive rate leaves tool calls unlimited.
+func WithRateLimit(rate float64, burst int) Option {
+	return func(s *Server) {
+		if rate > 0 {
+			s.rateLimiter = newTokenBucket(rate, burst)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// maxWriteBackoff caps the delay between write retries
+const maxWriteBackoff = 5 * time.Second
+
+// writeRetry is the retry policy for transient transport write failures
+type writeRetry struct {
+	attempts int
+	backoff  time.Duration
+}
+
+// transientError marks a transport error as worth retrying
+type transientError struct {
+	err error
+}
+
+func (e *transientError) Error() string   { return e.err.Error() }
+func (e *transientError) Unwrap() error   { return e.err }
+func (e *transientError) Temporary() bool { return true }
+
+// TransientError marks err as a transient transport failure that the server
+// may retry. Errors reporting Temporary() true, such as temporary net.Errors,
+// are treated the same way; everything else is fatal.
+func TransientError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &transientError{err: err}
+}
+
+// isTransient reports whether a transport error is worth retrying
+func isTransient(err error) bool {
+	var t interface{ Temporary() bool }
+	return errors.As(err, &t) && t.Temporary()
+}
+
+// WithWriteRetry retries transient response write failures up to attempts
+// more times, doubling the delay from backoff after each failure. Fatal
+// errors, such as a closed transport, and every error from transports that
+// never report transient failures (like stdio) still stop the server.
+func WithWriteRetry(attempts int, backoff time.Duration) Option {
+	return func(s *Server) {
+		if attempts > 0 {
+			s.writeRetry = writeRetry{attempts: attempts, backoff: backoff}
+		}
+	}
+}
+
+// writeResponse writes resp, retrying transient failures per the server's
+// retry policy
+func (s *Server) writeResponse(ctx context.Context, transport api.Transport, resp *api.Response) error {
+	delay := s.writeRetry.backoff
+	for attempt := 0; ; attempt++ {
+		err := transport.Write(resp)
+		if err == nil || !isTransient(err) || attempt >= s.writeRetry.attempts {
+			return err
+		}
+
+		fmt.Fprintf(os.Stderr, "[ERROR] Transient write error, retrying in %v: %v\n", delay, err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxWriteBackoff)
+	}
+}
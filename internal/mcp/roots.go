@@ -0,0 +1,63 @@
+package mcp
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// requestRoots asks a client that advertised the roots capability for its
+// filesystem roots. The response is handled by handleClientResponse; until
+// it arrives, tools see no roots. Failures are logged since the client
+// message that triggered the request needs no response.
+func (s *Server) requestRoots() {
+	s.mu.Lock()
+	transport := s.transport
+	if s.clientCaps.Roots == nil || transport == nil {
+		s.mu.Unlock()
+		return
+	}
+	requester, ok := transport.(api.RequestTransport)
+	if !ok {
+		s.mu.Unlock()
+		fmt.Fprintln(os.Stderr, "[ERROR] Transport cannot send requests, client roots ignored")
+		return
+	}
+	s.nextRequestID++
+	id := s.nextRequestID
+	s.rootsRequestID = fmt.Sprint(id)
+	s.mu.Unlock()
+
+	req := &api.Request{JSONRPC: JSONRPCVersion, ID: id, Method: MethodRootsList}
+	if err := requester.Request(req); err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] Failed to send %s: %v\n", MethodRootsList, err)
+	}
+}
+
+// handleClientResponse handles the client's response to a server-initiated
+// request. Only the latest roots/list response is applied, so a stale list
+// cannot replace a newer one.
+func (s *Server) handleClientResponse(resp *api.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if resp.ID == nil || fmt.Sprint(resp.ID) != s.rootsRequestID {
+		fmt.Fprintf(os.Stderr, "[ERROR] Ignoring response to unknown request: id=%v\n", resp.ID)
+		return
+	}
+	s.rootsRequestID = ""
+
+	if resp.Error != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] Client failed %s: %s\n", MethodRootsList, resp.Error.Message)
+		return
+	}
+	var result api.RootsListResult
+	if err := json.Unmarshal(resp.Result, &result); err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] Invalid %s result: %v\n", MethodRootsList, err)
+		return
+	}
+	s.roots = api.RootDirectories(result.Roots)
+	fmt.Fprintf(os.Stderr, "[INFO] Client roots: %d\n", len(s.roots))
+}
@@ -0,0 +1,31 @@
+package mcp
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// RedactedErrorData replaces the data of error responses in safe error mode
+const RedactedErrorData = "details omitted; see server logs"
+
+// WithSafeErrors replaces the data of JSON-RPC error responses, which may
+// hold internal error strings, with RedactedErrorData. The full detail is
+// logged to stderr instead. Intended for production; development keeps the
+// detailed errors.
+func WithSafeErrors(safe bool) Option {
+	return func(s *Server) {
+		s.safeErrors = safe
+	}
+}
+
+// redactErrorData applies safe error mode to a response
+func (s *Server) redactErrorData(resp *api.Response) *api.Response {
+	if !s.safeErrors || resp == nil || resp.Error == nil || resp.Error.Data == nil {
+		return resp
+	}
+	fmt.Fprintf(os.Stderr, "[ERROR] %s (id=%v): %v\n", resp.Error.Message, resp.ID, resp.Error.Data)
+	resp.Error.Data = RedactedErrorData
+	return resp
+}
@@ -3,9 +3,11 @@ package mcp
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/rosstaco/vexdoc-mcp/pkg/api"
 )
@@ -18,22 +20,74 @@ type Server struct {
 	capabilities api.ServerCapabilities
 	mu           sync.RWMutex
 	initialized  bool
+	clientCaps   api.ClientCapabilities
+	shutdown     bool
+	middlewares  []RequestMiddleware
+	rateLimiter  *tokenBucket
+	transport    api.Transport
+	writeRetry   writeRetry
+	idleTimeout  time.Duration
+	safeErrors   bool
+
+	// Client roots from the latest roots/list response, and the ID of the
+	// outstanding roots/list request
+	roots          []string
+	rootsRequestID string
+	nextRequestID  int
+}
+
+// RequestMiddleware inspects or replaces a request before it is routed, e.g.
+// for authentication or rate limiting. Returning a non-nil response answers
+// the request without routing it; otherwise the returned request, or the
+// original one if nil, is passed on.
+type RequestMiddleware func(*api.Request) (*api.Request, *api.Response)
+
+// Option configures optional Server behavior
+type Option func(*Server)
+
+// WithName overrides the server name reported in initialize; empty keeps ServerName
+func WithName(name string) Option {
+	return func(s *Server) {
+		if name != "" {
+			s.name = name
+		}
+	}
+}
+
+// WithVersion overrides the server version reported in initialize; empty keeps Version()
+func WithVersion(version string) Option {
+	return func(s *Server) {
+		if version != "" {
+			s.version = version
+		}
+	}
+}
+
+// WithMiddleware adds request middlewares, run in order before routing
+func WithMiddleware(middlewares ...RequestMiddleware) Option {
+	return func(s *Server) {
+		s.middlewares = append(s.middlewares, middlewares...)
+	}
 }
 
 // NewServer creates a new MCP server instance
-func NewServer() *Server {
-	return &Server{
+func NewServer(opts ...Option) *Server {
+	s := &Server{
 		name:    ServerName,
-		version: ServerVersion,
+		version: Version(),
 		tools:   make(map[string]api.Tool),
 		capabilities: api.ServerCapabilities{
 			Tools: struct {
 				ListChanged bool `json:"listChanged,omitempty"`
 			}{
-				ListChanged: false,
+				ListChanged: true,
 			},
 		},
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // Start begins the MCP server execution
@@ -45,49 +99,144 @@ func (s *Server) Start() error {
 func (s *Server) StartWithTransport(ctx context.Context, transport api.Transport) error {
 	defer transport.Close()
 
+	s.mu.Lock()
+	s.transport = transport
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.transport = nil
+		s.mu.Unlock()
+	}()
+
 	fmt.Fprintln(os.Stderr, "[INFO] MCP Server starting...")
 	fmt.Fprintf(os.Stderr, "[INFO] Server: %s v%s\n", s.name, s.version)
 	fmt.Fprintf(os.Stderr, "[INFO] Protocol Version: %s\n", ProtocolVersion)
 
 	for {
+		// Read in the background so cancellation and the idle timeout are
+		// noticed while a read blocks. Only one read is ever outstanding.
+		reads := readAsync(transport)
+		idle, stopIdle := s.idleTimer()
+
+		var result readResult
 		select {
 		case <-ctx.Done():
+			stopIdle()
 			fmt.Fprintln(os.Stderr, "[INFO] Server shutting down...")
 			return ctx.Err()
-		default:
-			req, err := transport.Read()
-			if err != nil {
-				if err.Error() == "EOF" {
-					fmt.Fprintln(os.Stderr, "[INFO] Connection closed")
-					return nil
-				}
-				fmt.Fprintf(os.Stderr, "[ERROR] Read error: %v\n", err)
-				continue
-			}
+		case <-idle:
+			fmt.Fprintf(os.Stderr, "[INFO] Idle for %v, server shutting down...\n", s.idleTimeout)
+			return nil
+		case result = <-reads:
+			stopIdle()
+		}
 
-			resp := s.handleRequest(ctx, req)
-			if err := transport.Write(resp); err != nil {
-				fmt.Fprintf(os.Stderr, "[ERROR] Write error: %v\n", err)
-				return err
+		req, err := result.req, result.err
+		if err != nil {
+			if err.Error() == "EOF" {
+				fmt.Fprintln(os.Stderr, "[INFO] Connection closed")
+				return nil
 			}
+			fmt.Fprintf(os.Stderr, "[ERROR] Read error: %v\n", err)
+			continue
+		}
+
+		// exit is a notification: stop without responding
+		if req.Method == MethodExit {
+			fmt.Fprintln(os.Stderr, "[INFO] Exit received, server shutting down...")
+			return nil
+		}
+
+		// Client responses and notifications get no response
+		switch req.Method {
+		case "":
+			s.handleClientResponse(req)
+			continue
+		case NotificationInitialized, NotificationRootsListChanged:
+			s.requestRoots()
+			continue
+		}
+
+		resp := s.handleRequest(ctx, req)
+		if err := s.writeResponse(ctx, transport, resp); err != nil {
+			fmt.Fprintf(os.Stderr, "[ERROR] Write error: %v\n", err)
+			return err
+		}
+	}
+}
+
+// SendNotification sends a JSON-RPC notification to the connected client.
+// It fails when the server is not running on a transport.
+func (s *Server) SendNotification(method string, params interface{}) error {
+	s.mu.RLock()
+	transport := s.transport
+	s.mu.RUnlock()
+	if transport == nil {
+		return fmt.Errorf("server is not running")
+	}
+
+	notification := &api.Notification{
+		JSONRPC: JSONRPCVersion,
+		Method:  method,
+	}
+	if params != nil {
+		data, err := json.Marshal(params)
+		if err != nil {
+			return fmt.Errorf("error marshaling %s params: %w", method, err)
 		}
+		notification.Params = data
 	}
+
+	return transport.Notify(notification)
 }
 
 // RegisterTool registers a tool with the server
 func (s *Server) RegisterTool(tool api.Tool) error {
 	s.mu.Lock()
-	defer s.mu.Unlock()
 
 	if _, exists := s.tools[tool.Name()]; exists {
+		s.mu.Unlock()
 		return fmt.Errorf("tool %s already registered", tool.Name())
 	}
 
 	s.tools[tool.Name()] = tool
+	initialized := s.initialized
+	s.mu.Unlock()
+
 	fmt.Fprintf(os.Stderr, "[INFO] Registered tool: %s\n", tool.Name())
+	if initialized {
+		s.notifyToolsChanged()
+	}
 	return nil
 }
 
+// UnregisterTool removes a registered tool from the server
+func (s *Server) UnregisterTool(name string) error {
+	s.mu.Lock()
+	if _, exists := s.tools[name]; !exists {
+		s.mu.Unlock()
+		return fmt.Errorf("tool %s not registered", name)
+	}
+
+	delete(s.tools, name)
+	initialized := s.initialized
+	s.mu.Unlock()
+
+	fmt.Fprintf(os.Stderr, "[INFO] Unregistered tool: %s\n", name)
+	if initialized {
+		s.notifyToolsChanged()
+	}
+	return nil
+}
+
+// notifyToolsChanged tells an initialized client that the tool set changed.
+// Failures are logged rather than returned since the change itself succeeded.
+func (s *Server) notifyToolsChanged() {
+	if err := s.SendNotification(NotificationToolsListChanged, nil); err != nil {
+		fmt.Fprintf(os.Stderr, "[ERROR] Failed to send %s: %v\n", NotificationToolsListChanged, err)
+	}
+}
+
 // ListTools returns information about all registered tools
 func (s *Server) ListTools() []api.ToolInfo {
 	s.mu.RLock()
@@ -95,11 +244,18 @@ func (s *Server) ListTools() []api.ToolInfo {
 
 	tools := make([]api.ToolInfo, 0, len(s.tools))
 	for _, tool := range s.tools {
-		tools = append(tools, api.ToolInfo{
+		info := api.ToolInfo{
 			Name:        tool.Name(),
 			Description: tool.Description(),
 			InputSchema: tool.InputSchema(),
-		})
+		}
+		if titled, ok := tool.(api.TitledTool); ok {
+			info.Title = titled.Title()
+		}
+		if structured, ok := tool.(api.StructuredTool); ok {
+			info.OutputSchema = structured.OutputSchema()
+		}
+		tools = append(tools, info)
 	}
 	return tools
 }
@@ -112,17 +268,83 @@ func (s *Server) Stop() error {
 
 // handleRequest routes incoming requests to appropriate handlers
 func (s *Server) handleRequest(ctx context.Context, req *api.Request) *api.Response {
+	for _, middleware := range s.middlewares {
+		next, resp := middleware(req)
+		if resp != nil {
+			return s.redactErrorData(resp)
+		}
+		if next != nil {
+			req = next
+		}
+	}
+
+	if req.Meta == nil {
+		req.Meta = paramsMeta(req.Params)
+	}
+
+	var resp *api.Response
 	switch req.Method {
 	case MethodInitialize:
-		return s.handleInitialize(req)
+		resp = s.handleInitialize(req)
 	case MethodToolsList:
-		return s.handleToolsList(req)
+		resp = s.handleToolsList(req)
 	case MethodToolsCall:
-		return s.handleToolsCall(ctx, req)
+		resp = s.handleToolsCall(ctx, req)
+	case MethodShutdown:
+		resp = s.handleShutdown(req)
 	default:
-		return NewErrorResponse(req.ID, MethodNotFound,
+		resp = NewErrorResponse(req.ID, MethodNotFound,
 			fmt.Sprintf("Method not found: %s", req.Method), nil)
 	}
+
+	if token, ok := req.Meta[MetaProgressTokenKey]; ok && resp != nil && resp.Error == nil {
+		resp.Result = withResultMeta(resp.Result, MetaProgressTokenKey, token)
+	}
+	return s.redactErrorData(resp)
+}
+
+// withResultMeta returns result with key set in its _meta object. MCP
+// carries response metadata inside the result, since JSON-RPC allows no
+// extra members in the response envelope. Tool results keep their type;
+// other results are converted to a generic JSON object.
+func withResultMeta(result interface{}, key string, value interface{}) interface{} {
+	if toolResult, ok := result.(*api.ToolResult); ok && toolResult != nil {
+		withMeta := *toolResult
+		withMeta.Meta = map[string]interface{}{key: value}
+		for k, v := range toolResult.Meta {
+			if k != key {
+				withMeta.Meta[k] = v
+			}
+		}
+		return &withMeta
+	}
+
+	var fields map[string]interface{}
+	if data, err := json.Marshal(result); err != nil || json.Unmarshal(data, &fields) != nil {
+		return result
+	}
+	if fields == nil {
+		fields = map[string]interface{}{}
+	}
+	meta, _ := fields["_meta"].(map[string]interface{})
+	if meta == nil {
+		meta = map[string]interface{}{}
+	}
+	meta[key] = value
+	fields["_meta"] = meta
+	return fields
+}
+
+// paramsMeta returns the _meta object MCP clients send inside request
+// params, or nil when there is none
+func paramsMeta(params json.RawMessage) map[string]interface{} {
+	var withMeta struct {
+		Meta map[string]interface{} `json:"_meta"`
+	}
+	if len(params) == 0 || json.Unmarshal(params, &withMeta) != nil {
+		return nil
+	}
+	return withMeta.Meta
 }
 
 // handleInitialize handles the initialize request
@@ -137,6 +359,8 @@ func (s *Server) handleInitialize(req *api.Request) *api.Response {
 
 	s.mu.Lock()
 	s.initialized = true
+	s.clientCaps = params.Capabilities
+	s.roots = nil
 	s.mu.Unlock()
 
 	result := api.InitializeResult{
@@ -157,6 +381,11 @@ func (s *Server) handleInitialize(req *api.Request) *api.Response {
 // handleToolsList handles the tools/list request
 func (s *Server) handleToolsList(req *api.Request) *api.Response {
 	tools := s.ListTools()
+	if !s.clientSupports(ExperimentalStructuredOutput) {
+		for i := range tools {
+			tools[i].OutputSchema = nil
+		}
+	}
 	result := api.ToolsListResult{
 		Tools: tools,
 	}
@@ -165,13 +394,44 @@ func (s *Server) handleToolsList(req *api.Request) *api.Response {
 	return NewSuccessResponse(req.ID, result)
 }
 
+// handleShutdown handles the shutdown request. The server keeps running until
+// the client sends exit, but no longer executes tools.
+func (s *Server) handleShutdown(req *api.Request) *api.Response {
+	s.mu.Lock()
+	s.shutdown = true
+	s.mu.Unlock()
+
+	fmt.Fprintln(os.Stderr, "[INFO] Shutdown requested")
+	return NewSuccessResponse(req.ID, struct{}{})
+}
+
 // handleToolsCall handles the tools/call request
 func (s *Server) handleToolsCall(ctx context.Context, req *api.Request) *api.Response {
+	s.mu.RLock()
+	shutdown := s.shutdown
+	roots := s.roots
+	s.mu.RUnlock()
+
+	if shutdown {
+		return NewErrorResponse(req.ID, InvalidRequest,
+			"Server is shutting down", nil)
+	}
+
+	if s.rateLimiter != nil && !s.rateLimiter.allow() {
+		fmt.Fprintln(os.Stderr, "[ERROR] Tool call rejected: rate limit exceeded")
+		return NewErrorResponse(req.ID, RateLimitExceeded,
+			"Rate limit exceeded, retry later", nil)
+	}
+
 	var params api.ToolCallParams
 	if err := json.Unmarshal(req.Params, &params); err != nil {
 		return NewErrorResponse(req.ID, InvalidParams,
 			"Invalid tool call parameters", err.Error())
 	}
+	// Tools see omitted or null arguments as an empty object
+	if params.Arguments == nil {
+		params.Arguments = map[string]interface{}{}
+	}
 
 	s.mu.RLock()
 	tool, exists := s.tools[params.Name]
@@ -184,12 +444,82 @@ func (s *Server) handleToolsCall(ctx context.Context, req *api.Request) *api.Res
 
 	fmt.Fprintf(os.Stderr, "[INFO] Executing tool: %s\n", params.Name)
 
-	result, err := tool.Execute(ctx, params.Arguments)
+	// Bound execution by the client's deadline, if it sent one
+	if timeout := metaTimeout(params.Meta); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	// Let tools narrow file access to the client's roots, if it listed any
+	if len(roots) > 0 {
+		ctx = api.ContextWithRoots(ctx, roots)
+	}
+
+	result, err := executeTool(ctx, tool, params.Arguments)
+	if errors.Is(err, context.DeadlineExceeded) {
+		fmt.Fprintf(os.Stderr, "[ERROR] Tool execution timed out: %s\n", params.Name)
+		return NewErrorResponse(req.ID, RequestTimeout,
+			"Tool execution timed out", err.Error())
+	}
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "[ERROR] Tool execution failed: %v\n", err)
 		return NewErrorResponse(req.ID, InternalError,
 			"Tool execution failed", err.Error())
 	}
 
+	if result != nil && result.StructuredContent != nil && !s.clientSupports(ExperimentalStructuredOutput) {
+		text := *result
+		text.StructuredContent = nil
+		result = &text
+	}
+
 	return NewSuccessResponse(req.ID, result)
 }
+
+// clientSupports reports whether the client advertised the experimental
+// capability during initialize. Any value other than false or null counts.
+func (s *Server) clientSupports(capability string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	value, ok := s.clientCaps.Experimental[capability]
+	if !ok || value == nil {
+		return false
+	}
+	if enabled, isBool := value.(bool); isBool {
+		return enabled
+	}
+	return true
+}
+
+// executeTool runs tool.Execute, returning early with the context error if ctx
+// is done first so a tool that ignores cancellation cannot hold the response
+func executeTool(ctx context.Context, tool api.Tool, args map[string]interface{}) (*api.ToolResult, error) {
+	type outcome struct {
+		result *api.ToolResult
+		err    error
+	}
+
+	done := make(chan outcome, 1)
+	go func() {
+		result, err := tool.Execute(ctx, args)
+		done <- outcome{result: result, err: err}
+	}()
+
+	select {
+	case o := <-done:
+		return o.result, o.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// metaTimeout returns the deadline requested in a tools/call _meta, or zero
+func metaTimeout(meta map[string]interface{}) time.Duration {
+	ms, ok := meta[MetaTimeoutKey].(float64)
+	if !ok || ms <= 0 {
+		return 0
+	}
+	return time.Duration(ms * float64(time.Millisecond))
+}
@@ -1,9 +1,16 @@
 package mcp
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/rosstaco/vexdoc-mcp/pkg/api"
 )
@@ -46,6 +53,70 @@ func (m *mockTool) Execute(ctx context.Context, args map[string]interface{}) (*a
 	}, nil
 }
 
+// slowTool blocks until its context is cancelled or a long delay passes
+type slowTool struct {
+	mockTool
+}
+
+func (s *slowTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(5 * time.Second):
+		return s.mockTool.Execute(ctx, args)
+	}
+}
+
+// mockTransport replays a fixed list of requests and records every response,
+// notification, and server-initiated request
+type mockTransport struct {
+	mu            sync.Mutex
+	requests      []*api.Request
+	responses     []*api.Response
+	notifications []*api.Notification
+	sent          []*api.Request
+}
+
+func (m *mockTransport) Read() (*api.Request, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := m.requests[0]
+	m.requests = m.requests[1:]
+	return req, nil
+}
+
+func (m *mockTransport) Write(resp *api.Response) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.responses = append(m.responses, resp)
+	return nil
+}
+
+func (m *mockTransport) Notify(notification *api.Notification) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.notifications = append(m.notifications, notification)
+	return nil
+}
+
+func (m *mockTransport) Request(req *api.Request) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sent = append(m.sent, req)
+	return nil
+}
+
+func (m *mockTransport) Close() error {
+	return nil
+}
+
 func TestNewServer(t *testing.T) {
 	server := NewServer()
 	if server == nil {
@@ -54,8 +125,8 @@ func TestNewServer(t *testing.T) {
 	if server.name != ServerName {
 		t.Errorf("Expected name %s, got %s", ServerName, server.name)
 	}
-	if server.version != ServerVersion {
-		t.Errorf("Expected version %s, got %s", ServerVersion, server.version)
+	if server.version != Version() {
+		t.Errorf("Expected version %s, got %s", Version(), server.version)
 	}
 }
 
@@ -78,6 +149,38 @@ func TestRegisterTool(t *testing.T) {
 	}
 }
 
+func TestUnregisterTool(t *testing.T) {
+	server := NewServer()
+	tool := &mockTool{name: "test-tool", description: "A test tool"}
+
+	if err := server.RegisterTool(tool); err != nil {
+		t.Fatalf("Failed to register tool: %v", err)
+	}
+	if err := server.UnregisterTool("test-tool"); err != nil {
+		t.Fatalf("Failed to unregister tool: %v", err)
+	}
+	if tools := server.ListTools(); len(tools) != 0 {
+		t.Errorf("Expected no tools after unregistering, got %d", len(tools))
+	}
+
+	// The name is free to register again
+	if err := server.RegisterTool(tool); err != nil {
+		t.Errorf("Failed to re-register tool: %v", err)
+	}
+}
+
+func TestUnregisterToolNotRegistered(t *testing.T) {
+	server := NewServer()
+
+	err := server.UnregisterTool("missing")
+	if err == nil {
+		t.Fatal("Expected error when unregistering unknown tool, got nil")
+	}
+	if !strings.Contains(err.Error(), "not registered") {
+		t.Errorf("UnregisterTool() error = %v, want to contain %v", err, "not registered")
+	}
+}
+
 func TestListTools(t *testing.T) {
 	server := NewServer()
 	tool1 := &mockTool{name: "tool1", description: "Tool 1"}
@@ -92,6 +195,83 @@ func TestListTools(t *testing.T) {
 	}
 }
 
+// mockStructuredTool is a mock tool declaring an output schema
+type mockStructuredTool struct {
+	mockTool
+}
+
+func (m *mockStructuredTool) OutputSchema() *api.JSONSchema {
+	return &api.JSONSchema{Type: "object", Required: []string{"ok"}}
+}
+
+func (m *mockStructuredTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	return &api.ToolResult{
+		Content:           []api.Content{{Type: "text", Text: `{"ok": true}`}},
+		StructuredContent: map[string]interface{}{"ok": true},
+	}, nil
+}
+
+func TestListToolsOutputSchema(t *testing.T) {
+	server := NewServer()
+	server.RegisterTool(&mockTool{name: "plain"})
+	server.RegisterTool(&mockStructuredTool{mockTool{name: "structured"}})
+
+	for _, info := range server.ListTools() {
+		switch info.Name {
+		case "plain":
+			if info.OutputSchema != nil {
+				t.Errorf("Expected no output schema for plain tool, got %+v", info.OutputSchema)
+			}
+		case "structured":
+			if info.OutputSchema == nil || info.OutputSchema.Type != "object" {
+				t.Errorf("Expected object output schema for structured tool, got %+v", info.OutputSchema)
+			}
+		}
+	}
+}
+
+// mockTitledTool is a mock tool with a display title
+type mockTitledTool struct {
+	mockTool
+	title string
+}
+
+func (m *mockTitledTool) Title() string {
+	return m.title
+}
+
+func TestHandleToolsListTitle(t *testing.T) {
+	server := NewServer()
+	server.RegisterTool(&mockTool{name: "plain"})
+	server.RegisterTool(&mockTitledTool{mockTool: mockTool{name: "titled"}, title: "Titled Tool"})
+
+	resp := server.handleToolsList(&api.Request{JSONRPC: JSONRPCVersion, ID: 1, Method: MethodToolsList})
+	if resp.Error != nil {
+		t.Fatalf("Tools list failed: %v", resp.Error)
+	}
+
+	resultJSON, _ := json.Marshal(resp.Result)
+	var result struct {
+		Tools []map[string]interface{} `json:"tools"`
+	}
+	if err := json.Unmarshal(resultJSON, &result); err != nil {
+		t.Fatalf("Failed to decode tools list: %v", err)
+	}
+	for _, tool := range result.Tools {
+		title, hasTitle := tool["title"]
+		switch tool["name"] {
+		case "plain":
+			if hasTitle {
+				t.Errorf("Expected no title for untitled tool, got %v", title)
+			}
+		case "titled":
+			if title != "Titled Tool" {
+				t.Errorf("Expected title %q, got %v", "Titled Tool", title)
+			}
+		}
+	}
+}
+
 func TestHandleInitialize(t *testing.T) {
 	server := NewServer()
 	params := api.InitializeRequest{
@@ -220,3 +400,865 @@ func TestHandleMethodNotFound(t *testing.T) {
 		t.Errorf("Expected error code %d, got %d", MethodNotFound, resp.Error.Code)
 	}
 }
+
+func TestShutdownExitSequence(t *testing.T) {
+	server := NewServer()
+	transport := &mockTransport{
+		requests: []*api.Request{
+			{JSONRPC: JSONRPCVersion, ID: 1, Method: MethodShutdown},
+			{JSONRPC: JSONRPCVersion, Method: MethodExit},
+			{JSONRPC: JSONRPCVersion, ID: 2, Method: MethodToolsList},
+		},
+	}
+
+	if err := server.StartWithTransport(context.Background(), transport); err != nil {
+		t.Fatalf("StartWithTransport() error = %v", err)
+	}
+
+	if len(transport.responses) != 1 {
+		t.Fatalf("Expected 1 response (shutdown only), got %d", len(transport.responses))
+	}
+	if transport.responses[0].Error != nil {
+		t.Errorf("Shutdown failed: %v", transport.responses[0].Error)
+	}
+	if len(transport.requests) != 1 {
+		t.Error("Requests after exit should not be read")
+	}
+}
+
+func TestToolsCallAfterShutdown(t *testing.T) {
+	server := NewServer()
+	server.RegisterTool(&mockTool{name: "test-tool", description: "Test"})
+
+	server.handleRequest(context.Background(), &api.Request{
+		JSONRPC: JSONRPCVersion,
+		ID:      1,
+		Method:  MethodShutdown,
+	})
+
+	paramsJSON, _ := json.Marshal(api.ToolCallParams{Name: "test-tool"})
+	resp := server.handleRequest(context.Background(), &api.Request{
+		JSONRPC: JSONRPCVersion,
+		ID:      2,
+		Method:  MethodToolsCall,
+		Params:  paramsJSON,
+	})
+	if resp.Error == nil {
+		t.Fatal("Expected error for tool call after shutdown")
+	}
+	if resp.Error.Code != InvalidRequest {
+		t.Errorf("Expected error code %d, got %d", InvalidRequest, resp.Error.Code)
+	}
+}
+
+func TestHandleToolsCallDeadline(t *testing.T) {
+	server := NewServer()
+	server.RegisterTool(&slowTool{mockTool{name: "slow-tool", description: "Slow"}})
+	server.RegisterTool(&mockTool{name: "fast-tool", description: "Fast"})
+
+	call := func(name string, meta map[string]interface{}) *api.Response {
+		paramsJSON, _ := json.Marshal(api.ToolCallParams{Name: name, Meta: meta})
+		return server.handleToolsCall(context.Background(), &api.Request{
+			JSONRPC: JSONRPCVersion,
+			ID:      1,
+			Method:  MethodToolsCall,
+			Params:  paramsJSON,
+		})
+	}
+
+	start := time.Now()
+	resp := call("slow-tool", map[string]interface{}{MetaTimeoutKey: 20})
+	if resp.Error == nil {
+		t.Fatal("Expected timeout error for slow tool")
+	}
+	if resp.Error.Code != RequestTimeout {
+		t.Errorf("Expected error code %d, got %d", RequestTimeout, resp.Error.Code)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Deadline not honored, call took %v", elapsed)
+	}
+
+	resp = call("fast-tool", map[string]interface{}{MetaTimeoutKey: 1000})
+	if resp.Error != nil {
+		t.Errorf("Fast tool within deadline failed: %v", resp.Error)
+	}
+}
+
+func TestHandleInitializeOverriddenIdentity(t *testing.T) {
+	server := NewServer(WithName("acme-vex"), WithVersion("2.3.4"))
+
+	resp := server.handleInitialize(&api.Request{
+		JSONRPC: JSONRPCVersion,
+		ID:      1,
+		Method:  MethodInitialize,
+	})
+	if resp.Error != nil {
+		t.Fatalf("Initialize failed: %v", resp.Error)
+	}
+
+	result, ok := resp.Result.(api.InitializeResult)
+	if !ok {
+		t.Fatal("Result is not InitializeResult")
+	}
+	if result.ServerInfo.Name != "acme-vex" || result.ServerInfo.Version != "2.3.4" {
+		t.Errorf("Expected acme-vex 2.3.4, got %s %s", result.ServerInfo.Name, result.ServerInfo.Version)
+	}
+
+	// Empty overrides fall back to the defaults
+	server = NewServer(WithName(""), WithVersion(""))
+	if server.name != ServerName || server.version != Version() {
+		t.Errorf("Expected defaults %s %s, got %s %s", ServerName, Version(), server.name, server.version)
+	}
+}
+
+func TestRequestMiddleware(t *testing.T) {
+	var seen []string
+	logging := func(req *api.Request) (*api.Request, *api.Response) {
+		seen = append(seen, req.Method)
+		return nil, nil
+	}
+	auth := func(req *api.Request) (*api.Request, *api.Response) {
+		if req.Method == MethodToolsCall {
+			return nil, NewErrorResponse(req.ID, InvalidRequest, "Unauthorized", nil)
+		}
+		return req, nil
+	}
+
+	server := NewServer(WithMiddleware(logging, auth))
+	server.RegisterTool(&mockTool{name: "test-tool", description: "Test"})
+	ctx := context.Background()
+
+	resp := server.handleRequest(ctx, &api.Request{
+		JSONRPC: JSONRPCVersion,
+		ID:      1,
+		Method:  MethodToolsCall,
+		Params:  json.RawMessage(`{"name":"test-tool","arguments":{}}`),
+	})
+	if resp.Error == nil || resp.Error.Message != "Unauthorized" {
+		t.Errorf("Expected middleware rejection, got %+v", resp)
+	}
+
+	resp = server.handleRequest(ctx, &api.Request{JSONRPC: JSONRPCVersion, ID: 2, Method: MethodToolsList})
+	if resp.Error != nil {
+		t.Errorf("Expected tools/list to pass through middleware, got %v", resp.Error)
+	}
+
+	if len(seen) != 2 || seen[0] != MethodToolsCall || seen[1] != MethodToolsList {
+		t.Errorf("Expected every request to reach the first middleware, got %v", seen)
+	}
+}
+
+func TestHandleToolsCallRateLimit(t *testing.T) {
+	server := NewServer(WithRateLimit(1, 2))
+	server.RegisterTool(&mockTool{name: "test-tool", description: "Test"})
+
+	now := time.Now()
+	server.rateLimiter.now = func() time.Time { return now }
+
+	call := func(id int) *api.Response {
+		return server.handleToolsCall(context.Background(), &api.Request{
+			JSONRPC: JSONRPCVersion,
+			ID:      id,
+			Method:  MethodToolsCall,
+			Params:  json.RawMessage(`{"name":"test-tool","arguments":{}}`),
+		})
+	}
+
+	// The burst is allowed, then the bucket is empty
+	for i := 1; i <= 2; i++ {
+		if resp := call(i); resp.Error != nil {
+			t.Fatalf("Call %d within burst failed: %v", i, resp.Error)
+		}
+	}
+	resp := call(3)
+	if resp.Error == nil || resp.Error.Code != RateLimitExceeded {
+		t.Fatalf("Expected rate limit error, got %+v", resp)
+	}
+
+	// One token refills after a second
+	now = now.Add(time.Second)
+	if resp := call(4); resp.Error != nil {
+		t.Errorf("Call after refill failed: %v", resp.Error)
+	}
+	if resp := call(5); resp.Error == nil {
+		t.Error("Expected rate limit error after refilled token is used")
+	}
+}
+
+func TestRateLimitDisabledByDefault(t *testing.T) {
+	if NewServer().rateLimiter != nil {
+		t.Error("Expected no rate limiter by default")
+	}
+	if NewServer(WithRateLimit(0, 5)).rateLimiter != nil {
+		t.Error("Expected a zero rate to disable rate limiting")
+	}
+}
+
+func TestSendNotification(t *testing.T) {
+	server := NewServer()
+
+	if err := server.SendNotification("notifications/message", nil); err == nil {
+		t.Error("Expected error when the server is not running")
+	}
+
+	var buf bytes.Buffer
+	server.transport = &StdioTransport{writer: &buf}
+
+	params := map[string]string{"level": "info"}
+	if err := server.SendNotification("notifications/message", params); err != nil {
+		t.Fatalf("SendNotification failed: %v", err)
+	}
+
+	var written map[string]interface{}
+	if err := json.Unmarshal(bytes.TrimSpace(buf.Bytes()), &written); err != nil {
+		t.Fatalf("Failed to parse written notification %q: %v", buf.String(), err)
+	}
+	if _, ok := written["id"]; ok {
+		t.Errorf("Notification must not carry an id, got %v", written)
+	}
+	if written["jsonrpc"] != JSONRPCVersion {
+		t.Errorf("Expected jsonrpc %s, got %v", JSONRPCVersion, written["jsonrpc"])
+	}
+	if written["method"] != "notifications/message" {
+		t.Errorf("Expected method notifications/message, got %v", written["method"])
+	}
+	if got, ok := written["params"].(map[string]interface{}); !ok || got["level"] != "info" {
+		t.Errorf("Expected params %v, got %v", params, written["params"])
+	}
+}
+
+func TestRegisterToolNotifiesListChanged(t *testing.T) {
+	server := NewServer()
+	transport := &mockTransport{}
+	server.transport = transport
+
+	if !server.capabilities.Tools.ListChanged {
+		t.Error("Expected listChanged capability to be advertised")
+	}
+
+	if err := server.RegisterTool(&mockTool{name: "before_init"}); err != nil {
+		t.Fatalf("RegisterTool failed: %v", err)
+	}
+	if len(transport.notifications) != 0 {
+		t.Fatalf("Expected no notification before initialize, got %d", len(transport.notifications))
+	}
+
+	server.handleInitialize(&api.Request{JSONRPC: JSONRPCVersion, ID: 1, Method: MethodInitialize})
+
+	if err := server.RegisterTool(&mockTool{name: "after_init"}); err != nil {
+		t.Fatalf("RegisterTool failed: %v", err)
+	}
+	if len(transport.notifications) != 1 {
+		t.Fatalf("Expected 1 notification after initialize, got %d", len(transport.notifications))
+	}
+	if got := transport.notifications[0].Method; got != NotificationToolsListChanged {
+		t.Errorf("Expected method %s, got %s", NotificationToolsListChanged, got)
+	}
+
+	// A rejected duplicate does not change the tool set
+	if err := server.RegisterTool(&mockTool{name: "after_init"}); err == nil {
+		t.Error("Expected error registering duplicate tool")
+	}
+	if len(transport.notifications) != 1 {
+		t.Errorf("Expected no notification for duplicate registration, got %d", len(transport.notifications))
+	}
+
+	if err := server.UnregisterTool("after_init"); err != nil {
+		t.Fatalf("UnregisterTool failed: %v", err)
+	}
+	if len(transport.notifications) != 2 {
+		t.Errorf("Expected a notification after unregistering, got %d", len(transport.notifications))
+	}
+}
+
+// flakyTransport fails the first failures writes with err
+type flakyTransport struct {
+	mockTransport
+	failures int
+	err      error
+	writes   int
+}
+
+func (f *flakyTransport) Write(resp *api.Response) error {
+	f.writes++
+	if f.writes <= f.failures {
+		return f.err
+	}
+	return f.mockTransport.Write(resp)
+}
+
+func TestWriteRetry(t *testing.T) {
+	request := func() []*api.Request {
+		return []*api.Request{{JSONRPC: JSONRPCVersion, ID: 1, Method: MethodToolsList}}
+	}
+
+	tests := []struct {
+		name          string
+		opts          []Option
+		err           error
+		wantErr       bool
+		wantWrites    int
+		wantResponses int
+	}{
+		{
+			name:          "transient failure is retried",
+			opts:          []Option{WithWriteRetry(2, time.Millisecond)},
+			err:           TransientError(errors.New("connection reset")),
+			wantWrites:    2,
+			wantResponses: 1,
+		},
+		{
+			name:       "fatal failure is not retried",
+			opts:       []Option{WithWriteRetry(2, time.Millisecond)},
+			err:        errors.New("transport is closed"),
+			wantErr:    true,
+			wantWrites: 1,
+		},
+		{
+			name:       "fail fast without retry policy",
+			err:        TransientError(errors.New("connection reset")),
+			wantErr:    true,
+			wantWrites: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewServer(tt.opts...)
+			transport := &flakyTransport{
+				mockTransport: mockTransport{requests: request()},
+				failures:      1,
+				err:           tt.err,
+			}
+
+			err := server.StartWithTransport(context.Background(), transport)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("StartWithTransport() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if transport.writes != tt.wantWrites {
+				t.Errorf("Expected %d write attempts, got %d", tt.wantWrites, transport.writes)
+			}
+			if len(transport.responses) != tt.wantResponses {
+				t.Errorf("Expected %d delivered responses, got %d", tt.wantResponses, len(transport.responses))
+			}
+		})
+	}
+}
+
+func TestExperimentalStructuredOutputNegotiation(t *testing.T) {
+	tests := []struct {
+		name           string
+		experimental   map[string]interface{}
+		wantStructured bool
+	}{
+		{name: "not advertised"},
+		{name: "disabled", experimental: map[string]interface{}{ExperimentalStructuredOutput: false}},
+		{name: "advertised", experimental: map[string]interface{}{ExperimentalStructuredOutput: true}, wantStructured: true},
+		{name: "advertised as object", experimental: map[string]interface{}{ExperimentalStructuredOutput: map[string]interface{}{}}, wantStructured: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewServer()
+			server.RegisterTool(&mockStructuredTool{mockTool{name: "structured"}})
+
+			initParams, _ := json.Marshal(api.InitializeRequest{
+				ProtocolVersion: ProtocolVersion,
+				Capabilities:    api.ClientCapabilities{Experimental: tt.experimental},
+			})
+			if resp := server.handleInitialize(&api.Request{JSONRPC: JSONRPCVersion, ID: 1, Method: MethodInitialize, Params: initParams}); resp.Error != nil {
+				t.Fatalf("Initialize failed: %v", resp.Error)
+			}
+
+			callParams, _ := json.Marshal(api.ToolCallParams{Name: "structured"})
+			resp := server.handleToolsCall(context.Background(), &api.Request{JSONRPC: JSONRPCVersion, ID: 2, Method: MethodToolsCall, Params: callParams})
+			if resp.Error != nil {
+				t.Fatalf("Tool call failed: %v", resp.Error)
+			}
+			result := resp.Result.(*api.ToolResult)
+			if (result.StructuredContent != nil) != tt.wantStructured {
+				t.Errorf("Structured content = %v, want present %v", result.StructuredContent, tt.wantStructured)
+			}
+			if len(result.Content) != 1 {
+				t.Errorf("Expected text content to be kept, got %+v", result.Content)
+			}
+
+			list := server.handleToolsList(&api.Request{JSONRPC: JSONRPCVersion, ID: 3, Method: MethodToolsList})
+			tools := list.Result.(api.ToolsListResult).Tools
+			if (tools[0].OutputSchema != nil) != tt.wantStructured {
+				t.Errorf("Output schema = %+v, want present %v", tools[0].OutputSchema, tt.wantStructured)
+			}
+		})
+	}
+}
+
+func TestMetaSerialization(t *testing.T) {
+	result := &api.ToolResult{
+		Content: []api.Content{{Type: "text", Text: "ok"}},
+		Meta:    map[string]interface{}{"cached": true},
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(data), `"_meta":{"cached":true}`) {
+		t.Errorf("ToolResult JSON = %s, want _meta", data)
+	}
+
+	data, err = json.Marshal(&api.Response{JSONRPC: JSONRPCVersion, ID: 1})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if strings.Contains(string(data), "_meta") {
+		t.Errorf("Response JSON = %s, want no _meta in the envelope", data)
+	}
+
+	var req api.Request
+	raw := `{"jsonrpc":"2.0","id":1,"method":"tools/list","_meta":{"progressToken":"abc"}}`
+	if err := json.Unmarshal([]byte(raw), &req); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if req.Meta[MetaProgressTokenKey] != "abc" {
+		t.Errorf("Request meta = %v, want progressToken abc", req.Meta)
+	}
+}
+
+func TestHandleRequestEchoesProgressToken(t *testing.T) {
+	server := NewServer()
+	server.RegisterTool(&mockTool{name: "test-tool", description: "Test"})
+
+	paramsJSON, _ := json.Marshal(api.ToolCallParams{
+		Name: "test-tool",
+		Meta: map[string]interface{}{MetaProgressTokenKey: "tok-1"},
+	})
+	resp := server.handleRequest(context.Background(), &api.Request{
+		JSONRPC: JSONRPCVersion,
+		ID:      1,
+		Method:  MethodToolsCall,
+		Params:  paramsJSON,
+	})
+	if resp.Error != nil {
+		t.Fatalf("Unexpected error: %v", resp.Error)
+	}
+	if meta := resp.Result.(*api.ToolResult).Meta; meta[MetaProgressTokenKey] != "tok-1" {
+		t.Errorf("Result meta = %v, want progressToken tok-1", meta)
+	}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if _, ok := envelope["_meta"]; ok {
+		t.Errorf("Response JSON = %s, want _meta only inside the result", data)
+	}
+
+	listParams, _ := json.Marshal(map[string]interface{}{"_meta": map[string]interface{}{MetaProgressTokenKey: "tok-2"}})
+	resp = server.handleRequest(context.Background(), &api.Request{
+		JSONRPC: JSONRPCVersion,
+		ID:      2,
+		Method:  MethodToolsList,
+		Params:  listParams,
+	})
+	result, _ := resp.Result.(map[string]interface{})
+	if meta, _ := result["_meta"].(map[string]interface{}); meta[MetaProgressTokenKey] != "tok-2" {
+		t.Errorf("tools/list result = %v, want _meta with progressToken tok-2", resp.Result)
+	}
+	if tools, _ := result["tools"].([]interface{}); len(tools) != 1 {
+		t.Errorf("tools/list result = %v, want the listed tool", resp.Result)
+	}
+
+	resp = server.handleRequest(context.Background(), &api.Request{
+		JSONRPC: JSONRPCVersion,
+		ID:      3,
+		Method:  MethodToolsList,
+	})
+	if _, ok := resp.Result.(api.ToolsListResult); !ok {
+		t.Errorf("Result = %T, want an unchanged tools/list result without request meta", resp.Result)
+	}
+}
+
+// argsTool records the arguments of its last execution
+type argsTool struct {
+	mockTool
+	args map[string]interface{}
+}
+
+func (a *argsTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	a.args = args
+	return a.mockTool.Execute(ctx, args)
+}
+
+func TestHandleToolsCallArguments(t *testing.T) {
+	tests := []struct {
+		name     string
+		params   string
+		wantArgs map[string]interface{}
+	}{
+		{
+			name:     "absent",
+			params:   `{"name": "args-tool"}`,
+			wantArgs: map[string]interface{}{},
+		},
+		{
+			name:     "null",
+			params:   `{"name": "args-tool", "arguments": null}`,
+			wantArgs: map[string]interface{}{},
+		},
+		{
+			name:     "empty",
+			params:   `{"name": "args-tool", "arguments": {}}`,
+			wantArgs: map[string]interface{}{},
+		},
+		{
+			name:     "populated",
+			params:   `{"name": "args-tool", "arguments": {"test": "value"}}`,
+			wantArgs: map[string]interface{}{"test": "value"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewServer()
+			tool := &argsTool{mockTool: mockTool{name: "args-tool", description: "Test"}}
+			server.RegisterTool(tool)
+
+			resp := server.handleToolsCall(context.Background(), &api.Request{
+				JSONRPC: JSONRPCVersion,
+				ID:      1,
+				Method:  MethodToolsCall,
+				Params:  json.RawMessage(tt.params),
+			})
+			if resp.Error != nil {
+				t.Fatalf("Tool call failed: %v", resp.Error)
+			}
+			if tool.args == nil {
+				t.Fatal("Tool received nil arguments")
+			}
+			if len(tool.args) != len(tt.wantArgs) || tool.args["test"] != tt.wantArgs["test"] {
+				t.Errorf("Tool arguments = %v, want %v", tool.args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+// blockingTransport blocks reads until it is closed, like an idle stdin
+type blockingTransport struct {
+	mockTransport
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func (b *blockingTransport) Read() (*api.Request, error) {
+	<-b.closed
+	return nil, io.EOF
+}
+
+func (b *blockingTransport) Close() error {
+	b.closeOnce.Do(func() { close(b.closed) })
+	return nil
+}
+
+func TestIdleTimeout(t *testing.T) {
+	server := NewServer(WithIdleTimeout(20 * time.Millisecond))
+	transport := &blockingTransport{closed: make(chan struct{})}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- server.StartWithTransport(context.Background(), transport)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("StartWithTransport() error = %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("StartWithTransport() did not return after the idle timeout")
+	}
+
+	select {
+	case <-transport.closed:
+	default:
+		t.Error("Transport was not closed after the idle timeout")
+	}
+}
+
+// echoTool returns its "test" argument so responses can be matched to requests
+type echoTool struct {
+	mockTool
+}
+
+func (e *echoTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	text, _ := args["test"].(string)
+	return &api.ToolResult{Content: []api.Content{{Type: "text", Text: text}}}, nil
+}
+
+// chanTransport is an in-memory transport fed by concurrent writers through
+// a channel. Reads return io.EOF once the channel is closed.
+type chanTransport struct {
+	mockTransport
+	incoming chan *api.Request
+}
+
+func (c *chanTransport) Read() (*api.Request, error) {
+	req, ok := <-c.incoming
+	if !ok {
+		return nil, io.EOF
+	}
+	return req, nil
+}
+
+func TestConcurrentToolCalls(t *testing.T) {
+	const callers = 50
+
+	server := NewServer()
+	if err := server.RegisterTool(&echoTool{mockTool{name: "echo", description: "Echo"}}); err != nil {
+		t.Fatalf("RegisterTool() error = %v", err)
+	}
+
+	callRequest := func(id int) *api.Request {
+		params, _ := json.Marshal(api.ToolCallParams{
+			Name:      "echo",
+			Arguments: map[string]interface{}{"test": fmt.Sprintf("call-%d", id)},
+		})
+		return &api.Request{JSONRPC: JSONRPCVersion, ID: id, Method: MethodToolsCall, Params: params}
+	}
+	checkResponse := func(resp *api.Response, id int) {
+		if resp.Error != nil {
+			t.Errorf("Request %d failed: %v", id, resp.Error)
+			return
+		}
+		result, ok := resp.Result.(*api.ToolResult)
+		if !ok || len(result.Content) != 1 {
+			t.Errorf("Request %d result = %#v", id, resp.Result)
+			return
+		}
+		if want := fmt.Sprintf("call-%d", id); result.Content[0].Text != want {
+			t.Errorf("Request %d result = %q, want %q", id, result.Content[0].Text, want)
+		}
+	}
+
+	transport := &chanTransport{incoming: make(chan *api.Request)}
+	done := make(chan error, 1)
+	go func() {
+		done <- server.StartWithTransport(context.Background(), transport)
+	}()
+
+	var wg sync.WaitGroup
+	var writers sync.WaitGroup
+
+	// Many clients write to the shared transport at once
+	for i := 0; i < callers; i++ {
+		writers.Add(1)
+		go func(id int) {
+			defer writers.Done()
+			transport.incoming <- callRequest(id)
+		}(i)
+	}
+
+	// Parallel transports dispatch directly into the same server
+	for i := callers; i < 2*callers; i++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			checkResponse(server.handleRequest(context.Background(), callRequest(id)), id)
+		}(i)
+	}
+
+	// The tool set changes while calls are in flight
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < callers; i++ {
+			name := fmt.Sprintf("extra-%d", i)
+			if err := server.RegisterTool(&mockTool{name: name, description: "Extra"}); err != nil {
+				t.Errorf("RegisterTool() error = %v", err)
+			}
+			server.ListTools()
+			if err := server.UnregisterTool(name); err != nil {
+				t.Errorf("UnregisterTool() error = %v", err)
+			}
+		}
+	}()
+
+	writers.Wait()
+	close(transport.incoming)
+	wg.Wait()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("StartWithTransport() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("StartWithTransport() did not return after the transport closed")
+	}
+
+	transport.mu.Lock()
+	defer transport.mu.Unlock()
+	if len(transport.responses) != callers {
+		t.Fatalf("Got %d responses, want %d", len(transport.responses), callers)
+	}
+	seen := make(map[int]bool)
+	for _, resp := range transport.responses {
+		id, ok := resp.ID.(int)
+		if !ok || seen[id] {
+			t.Errorf("Unexpected response ID %v", resp.ID)
+			continue
+		}
+		seen[id] = true
+		checkResponse(resp, id)
+	}
+}
+
+// rootsTool reports the client roots it sees in its context
+type rootsTool struct {
+	mockTool
+}
+
+func (r *rootsTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	text := strings.Join(api.RootsFromContext(ctx), ",")
+	return &api.ToolResult{Content: []api.Content{{Type: "text", Text: text}}}, nil
+}
+
+func TestClientRoots(t *testing.T) {
+	rootsResult := json.RawMessage(`{"roots": [{"uri": "file:///srv/vex/", "name": "vex"}, {"uri": "https://example.com/repo"}, {"uri": "file:///home/user/project"}]}`)
+
+	tests := []struct {
+		name     string
+		roots    *api.RootsCapability
+		response *api.Request
+		wantSent bool
+		want     string
+	}{
+		{name: "no roots capability", want: ""},
+		{
+			name:     "file roots",
+			roots:    &api.RootsCapability{},
+			response: &api.Request{JSONRPC: JSONRPCVersion, ID: 1, Result: rootsResult},
+			wantSent: true,
+			want:     "/srv/vex,/home/user/project",
+		},
+		{
+			name:     "roots/list failed",
+			roots:    &api.RootsCapability{ListChanged: true},
+			response: &api.Request{JSONRPC: JSONRPCVersion, ID: 1, Error: &api.Error{Code: InternalError, Message: "no roots"}},
+			wantSent: true,
+			want:     "",
+		},
+		{
+			name:     "response to unknown request",
+			roots:    &api.RootsCapability{},
+			response: &api.Request{JSONRPC: JSONRPCVersion, ID: 7, Result: rootsResult},
+			wantSent: true,
+			want:     "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewServer()
+			server.RegisterTool(&rootsTool{mockTool{name: "roots", description: "Roots"}})
+
+			initParams, _ := json.Marshal(api.InitializeRequest{
+				ProtocolVersion: ProtocolVersion,
+				Capabilities:    api.ClientCapabilities{Roots: tt.roots},
+			})
+			callParams, _ := json.Marshal(api.ToolCallParams{Name: "roots"})
+			requests := []*api.Request{
+				{JSONRPC: JSONRPCVersion, ID: 1, Method: MethodInitialize, Params: initParams},
+				{JSONRPC: JSONRPCVersion, Method: NotificationInitialized},
+			}
+			if tt.response != nil {
+				requests = append(requests, tt.response)
+			}
+			requests = append(requests, &api.Request{JSONRPC: JSONRPCVersion, ID: 2, Method: MethodToolsCall, Params: callParams})
+
+			transport := &mockTransport{requests: requests}
+			if err := server.StartWithTransport(context.Background(), transport); err != nil {
+				t.Fatalf("StartWithTransport() error = %v", err)
+			}
+
+			if tt.wantSent {
+				if len(transport.sent) != 1 || transport.sent[0].Method != MethodRootsList {
+					t.Fatalf("Sent requests = %v, want one %s", transport.sent, MethodRootsList)
+				}
+			} else if len(transport.sent) != 0 {
+				t.Fatalf("Sent requests = %v, want none", transport.sent)
+			}
+			if len(transport.responses) != 2 {
+				t.Fatalf("Got %d responses, want 2 (client responses and notifications are not answered)", len(transport.responses))
+			}
+			resp := transport.responses[1]
+			if resp.Error != nil {
+				t.Fatalf("Tool call failed: %v", resp.Error)
+			}
+			if got := resp.Result.(*api.ToolResult).Content[0].Text; got != tt.want {
+				t.Errorf("Tool roots = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// failingTool fails with an internal error message
+type failingTool struct {
+	mockTool
+}
+
+func (f *failingTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	return nil, errors.New("open /var/lib/vexdoc/secret.db: permission denied")
+}
+
+func TestSafeErrors(t *testing.T) {
+	callParams, _ := json.Marshal(api.ToolCallParams{Name: "failing"})
+
+	tests := []struct {
+		name     string
+		safe     bool
+		req      *api.Request
+		wantData string
+	}{
+		{
+			name:     "tool failure redacted",
+			safe:     true,
+			req:      &api.Request{JSONRPC: JSONRPCVersion, ID: 1, Method: MethodToolsCall, Params: callParams},
+			wantData: RedactedErrorData,
+		},
+		{
+			name:     "invalid params redacted",
+			safe:     true,
+			req:      &api.Request{JSONRPC: JSONRPCVersion, ID: 2, Method: MethodToolsCall, Params: json.RawMessage(`"not an object"`)},
+			wantData: RedactedErrorData,
+		},
+		{
+			name:     "tool failure detailed by default",
+			req:      &api.Request{JSONRPC: JSONRPCVersion, ID: 3, Method: MethodToolsCall, Params: callParams},
+			wantData: "open /var/lib/vexdoc/secret.db: permission denied",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewServer(WithSafeErrors(tt.safe))
+			server.RegisterTool(&failingTool{mockTool{name: "failing", description: "Fails"}})
+
+			resp := server.handleRequest(context.Background(), tt.req)
+			if resp.Error == nil {
+				t.Fatal("Expected an error response")
+			}
+			if resp.Error.Data != tt.wantData {
+				t.Errorf("Error data = %v, want %v", resp.Error.Data, tt.wantData)
+			}
+		})
+	}
+
+	// Errors without data are left alone
+	server := NewServer(WithSafeErrors(true))
+	resp := server.handleRequest(context.Background(), &api.Request{JSONRPC: JSONRPCVersion, ID: 4, Method: "unknown"})
+	if resp.Error == nil || resp.Error.Data != nil {
+		t.Errorf("Expected method not found without data, got %+v", resp.Error)
+	}
+}
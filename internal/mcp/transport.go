@@ -11,10 +11,13 @@ import (
 	"github.com/rosstaco/vexdoc-mcp/pkg/api"
 )
 
-// StdioTransport implements the Transport interface using stdin/stdout
+// StdioTransport implements the Transport interface using stdin/stdout.
+// Reads and writes are locked separately so notifications can be written
+// while a read is blocked waiting for input.
 type StdioTransport struct {
 	reader *bufio.Scanner
 	writer io.Writer
+	readMu sync.Mutex
 	mu     sync.Mutex
 	closed bool
 }
@@ -34,10 +37,13 @@ func NewStdioTransport() *StdioTransport {
 
 // Read reads a request from stdin
 func (t *StdioTransport) Read() (*api.Request, error) {
-	t.mu.Lock()
-	defer t.mu.Unlock()
+	t.readMu.Lock()
+	defer t.readMu.Unlock()
 
-	if t.closed {
+	t.mu.Lock()
+	closed := t.closed
+	t.mu.Unlock()
+	if closed {
 		return nil, io.EOF
 	}
 
@@ -66,6 +72,53 @@ func (t *StdioTransport) Read() (*api.Request, error) {
 
 // Write writes a response to stdout
 func (t *StdioTransport) Write(resp *api.Response) error {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return fmt.Errorf("error marshaling response: %w", err)
+	}
+	if err := t.writeLine(data); err != nil {
+		return err
+	}
+
+	// Log to stderr for debugging
+	fmt.Fprintf(os.Stderr, "[DEBUG] Sent response: id=%v error=%v\n", resp.ID, resp.Error != nil)
+
+	return nil
+}
+
+// Notify writes a notification to stdout
+func (t *StdioTransport) Notify(notification *api.Notification) error {
+	data, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("error marshaling notification: %w", err)
+	}
+	if err := t.writeLine(data); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "[DEBUG] Sent notification: method=%s\n", notification.Method)
+
+	return nil
+}
+
+// Request writes a server-initiated request to stdout. The client's
+// response is read back through Read.
+func (t *StdioTransport) Request(req *api.Request) error {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("error marshaling request: %w", err)
+	}
+	if err := t.writeLine(data); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "[DEBUG] Sent request: method=%s id=%v\n", req.Method, req.ID)
+
+	return nil
+}
+
+// writeLine writes one JSON message followed by a newline
+func (t *StdioTransport) writeLine(data []byte) error {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
@@ -73,11 +126,6 @@ func (t *StdioTransport) Write(resp *api.Response) error {
 		return fmt.Errorf("transport is closed")
 	}
 
-	data, err := json.Marshal(resp)
-	if err != nil {
-		return fmt.Errorf("error marshaling response: %w", err)
-	}
-
 	// Write JSON followed by newline
 	if _, err := t.writer.Write(data); err != nil {
 		return fmt.Errorf("error writing to stdout: %w", err)
@@ -85,10 +133,6 @@ func (t *StdioTransport) Write(resp *api.Response) error {
 	if _, err := t.writer.Write([]byte("\n")); err != nil {
 		return fmt.Errorf("error writing newline: %w", err)
 	}
-
-	// Log to stderr for debugging
-	fmt.Fprintf(os.Stderr, "[DEBUG] Sent response: id=%v error=%v\n", resp.ID, resp.Error != nil)
-
 	return nil
 }
 
@@ -14,6 +14,10 @@ const (
 	InvalidParams = -32602
 	// InternalError - Internal JSON-RPC error
 	InternalError = -32603
+	// RequestTimeout - The request did not complete before its deadline
+	RequestTimeout = -32001
+	// RateLimitExceeded - The client exceeded the configured tool call rate
+	RateLimitExceeded = -32002
 )
 
 // MCP Protocol Constants
@@ -25,13 +29,45 @@ const (
 
 // ServerVersion can be set at build time via ldflags:
 // go build -ldflags="-X github.com/rosstaco/vexdoc-mcp/internal/mcp.ServerVersion=v1.0.0"
-var ServerVersion = "dev"
+// Without it, Version derives the version from the build info.
+var ServerVersion = defaultServerVersion
+
+// Environment variables overriding the reported server identity at runtime
+const (
+	ServerNameEnv    = "VEXDOC_SERVER_NAME"
+	ServerVersionEnv = "VEXDOC_SERVER_VERSION"
+)
+
+// ExperimentalStructuredOutput is the experimental client capability that
+// opts in to structuredContent in tool results and outputSchema in tools/list
+const ExperimentalStructuredOutput = "vexdoc.structuredOutput"
+
+// MetaTimeoutKey is the tools/call _meta key carrying the client's deadline in milliseconds
+const MetaTimeoutKey = "timeoutMs"
+
+// MetaProgressTokenKey is the request _meta key carrying the client's
+// progress token, echoed in the _meta of the response result
+const MetaProgressTokenKey = "progressToken"
 
 // MCP Method Names
 const (
 	MethodInitialize = "initialize"
 	MethodToolsList  = "tools/list"
 	MethodToolsCall  = "tools/call"
+	MethodShutdown   = "shutdown"
+	MethodExit       = "exit"
+
+	// MethodRootsList asks the client for its filesystem roots
+	MethodRootsList = "roots/list"
+
+	// NotificationToolsListChanged tells the client to refetch tools/list
+	NotificationToolsListChanged = "notifications/tools/list_changed"
+
+	// NotificationInitialized tells the server the client finished initializing
+	NotificationInitialized = "notifications/initialized"
+
+	// NotificationRootsListChanged tells the server to refetch roots/list
+	NotificationRootsListChanged = "notifications/roots/list_changed"
 )
 
 // NewErrorResponse creates a standard error response
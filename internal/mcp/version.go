@@ -0,0 +1,51 @@
+package mcp
+
+import "runtime/debug"
+
+// defaultServerVersion is the ServerVersion of builds without ldflags
+const defaultServerVersion = "dev"
+
+// Version returns the server version. A version injected via ldflags wins;
+// otherwise the module version or VCS revision recorded in the build info
+// is used, falling back to ServerVersion for builds without either.
+func Version() string {
+	info, ok := debug.ReadBuildInfo()
+	return resolveVersion(ServerVersion, info, ok)
+}
+
+// resolveVersion picks the reported version from the ldflags value and the
+// build info
+func resolveVersion(injected string, info *debug.BuildInfo, ok bool) string {
+	if injected != "" && injected != defaultServerVersion {
+		return injected
+	}
+	if !ok || info == nil {
+		return injected
+	}
+
+	// go install module@version records the release tag
+	if v := info.Main.Version; v != "" && v != "(devel)" {
+		return v
+	}
+
+	var revision string
+	var modified bool
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			revision = setting.Value
+		case "vcs.modified":
+			modified = setting.Value == "true"
+		}
+	}
+	if revision == "" {
+		return injected
+	}
+	if len(revision) > 12 {
+		revision = revision[:12]
+	}
+	if modified {
+		revision += "-dirty"
+	}
+	return revision
+}
@@ -0,0 +1,78 @@
+package mcp
+
+import (
+	"runtime/debug"
+	"testing"
+)
+
+func TestVersion(t *testing.T) {
+	if Version() == "" {
+		t.Error("Version() returned an empty version")
+	}
+}
+
+func TestResolveVersion(t *testing.T) {
+	vcs := func(revision, modified string) []debug.BuildSetting {
+		return []debug.BuildSetting{
+			{Key: "vcs.revision", Value: revision},
+			{Key: "vcs.modified", Value: modified},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		injected string
+		info     *debug.BuildInfo
+		ok       bool
+		want     string
+	}{
+		{
+			name:     "ldflags version wins",
+			injected: "v1.2.3",
+			info:     &debug.BuildInfo{Main: debug.Module{Version: "v1.0.0"}},
+			ok:       true,
+			want:     "v1.2.3",
+		},
+		{
+			name:     "module version preferred over revision",
+			injected: defaultServerVersion,
+			info:     &debug.BuildInfo{Main: debug.Module{Version: "v1.0.0"}, Settings: vcs("0123456789abcdef", "false")},
+			ok:       true,
+			want:     "v1.0.0",
+		},
+		{
+			name:     "vcs revision for local builds",
+			injected: defaultServerVersion,
+			info:     &debug.BuildInfo{Main: debug.Module{Version: "(devel)"}, Settings: vcs("0123456789abcdef", "false")},
+			ok:       true,
+			want:     "0123456789ab",
+		},
+		{
+			name:     "modified working tree",
+			injected: defaultServerVersion,
+			info:     &debug.BuildInfo{Main: debug.Module{Version: "(devel)"}, Settings: vcs("0123456789abcdef", "true")},
+			ok:       true,
+			want:     "0123456789ab-dirty",
+		},
+		{
+			name:     "no build info falls back",
+			injected: defaultServerVersion,
+			want:     defaultServerVersion,
+		},
+		{
+			name:     "devel build without vcs falls back",
+			injected: defaultServerVersion,
+			info:     &debug.BuildInfo{Main: debug.Module{Version: "(devel)"}},
+			ok:       true,
+			want:     defaultServerVersion,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resolveVersion(tt.injected, tt.info, tt.ok); got != tt.want {
+				t.Errorf("resolveVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package tools
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// parseDocumentArg returns a required VEX document argument as a JSON object
+func parseDocumentArg(args map[string]interface{}, name string) (map[string]interface{}, error) {
+	value, ok := args[name]
+	if !ok {
+		return nil, fmt.Errorf("%s field is required", name)
+	}
+
+	doc, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be a valid JSON object", name)
+	}
+	return doc, nil
+}
+
+// parseDocumentsArg returns a required array argument of VEX documents
+func parseDocumentsArg(args map[string]interface{}, name string) ([]map[string]interface{}, error) {
+	value, ok := args[name]
+	if !ok {
+		return nil, fmt.Errorf("%s field is required", name)
+	}
+
+	array, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be an array", name)
+	}
+
+	// Convert each document to map[string]interface{}
+	docs := make([]map[string]interface{}, 0, len(array))
+	for i, v := range array {
+		doc, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("document %d must be a valid JSON object", i+1)
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
+// parseIntArg returns a required integer argument
+func parseIntArg(args map[string]interface{}, name string) (int, error) {
+	value, ok := args[name]
+	if !ok {
+		return 0, fmt.Errorf("%s field is required", name)
+	}
+
+	number, ok := value.(float64)
+	if !ok || number != float64(int(number)) {
+		return 0, fmt.Errorf("%s must be an integer", name)
+	}
+	return int(number), nil
+}
+
+// parseStringArray returns the string elements of an optional array argument,
+// skipping any non-string entries
+func parseStringArray(args map[string]interface{}, name string) []string {
+	array, ok := args[name].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var values []string
+	for _, v := range array {
+		if value, ok := v.(string); ok {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// parseEnumArg returns an optional string argument restricted to allowed
+// values, or "" when it is absent
+func parseEnumArg(args map[string]interface{}, name string, allowed []string) (string, error) {
+	value, ok := args[name]
+	if !ok {
+		return "", nil
+	}
+
+	str, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("%s must be a string", name)
+	}
+	for _, a := range allowed {
+		if str == a {
+			return str, nil
+		}
+	}
+	return "", fmt.Errorf("invalid %s %q: must be one of %s", name, str, strings.Join(allowed, ", "))
+}
+
+// parseLabelsArg returns the optional labels argument as a string map
+func parseLabelsArg(args map[string]interface{}) (map[string]string, error) {
+	value, ok := args["labels"]
+	if !ok {
+		return nil, nil
+	}
+
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("labels must be a JSON object")
+	}
+
+	labels := make(map[string]string, len(object))
+	for key, v := range object {
+		str, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("label %s must be a string", key)
+		}
+		labels[key] = str
+	}
+	return labels, nil
+}
+
+// parseStringMapArg returns an optional object argument whose values are all strings
+func parseStringMapArg(args map[string]interface{}, name string) (map[string]string, error) {
+	value, ok := args[name]
+	if !ok {
+		return nil, nil
+	}
+
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be a JSON object", name)
+	}
+
+	result := make(map[string]string, len(object))
+	for key, v := range object {
+		str, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s[%s] must be a string", name, key)
+		}
+		result[key] = str
+	}
+	return result, nil
+}
+
+// parseCVSSArg returns the optional cvss argument, given as a vector string or
+// a numeric score
+func parseCVSSArg(args map[string]interface{}) (*vex.CVSS, error) {
+	switch value := args["cvss"].(type) {
+	case nil:
+		return nil, nil
+	case float64:
+		return vex.ParseCVSS(strconv.FormatFloat(value, 'f', -1, 64))
+	case string:
+		return vex.ParseCVSS(value)
+	default:
+		return nil, fmt.Errorf("cvss must be a vector string or a score")
+	}
+}
+
+// labelsProperty returns the schema for the labels argument
+func labelsProperty() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type:                 "object",
+		Description:          "Custom key/value labels (e.g., team, environment) stored in the document's 'labels' extension field for indexing. This is an extension, not part of the OpenVEX specification.",
+		AdditionalProperties: &api.JSONSchema{Type: "string"},
+	}
+}
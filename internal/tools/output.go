@@ -0,0 +1,252 @@
+package tools
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// Success message formats for tools returning VEX documents
+const (
+	MessageFormatText     = "text"
+	MessageFormatJSONOnly = "json_only"
+)
+
+// messageFormatValues are the allowed values of the message_format argument
+var messageFormatValues = []string{MessageFormatText, MessageFormatJSONOnly}
+
+// outputOptions controls how VEX documents are serialized in tool results
+type outputOptions struct {
+	compact        bool
+	statementsOnly bool
+	jsonOnly       bool
+	sortKeys       bool
+	generatorMeta  bool
+	generator      vex.Generator
+	maxBytes       int
+}
+
+// parseOutputOptions parses the optional output formatting arguments. Output
+// over the client's MaxOutputBytes is rejected, and a requested generator
+// block records the client's Generator.
+func parseOutputOptions(args map[string]interface{}, client *vex.Client) (outputOptions, error) {
+	opts := outputOptions{maxBytes: client.MaxOutputBytes(), generator: client.Generator()}
+	messageFormat, err := parseEnumArg(args, "message_format", messageFormatValues)
+	if err != nil {
+		return opts, err
+	}
+	opts.compact, _ = args["compact"].(bool)
+	opts.statementsOnly, _ = args["statements_only"].(bool)
+	opts.jsonOnly = messageFormat == MessageFormatJSONOnly
+	opts.sortKeys, _ = args["sort_keys"].(bool)
+	opts.generatorMeta, _ = args["include_generator_metadata"].(bool)
+	return opts, nil
+}
+
+// addOutputProperties adds the output formatting arguments shared by tools
+// that return VEX documents
+func addOutputProperties(properties map[string]*api.JSONSchema) map[string]*api.JSONSchema {
+	properties["compact"] = &api.JSONSchema{
+		Type:        "boolean",
+		Description: "Emit the document as compact single-line JSON instead of indented JSON. Useful for machine consumption and size-sensitive transports.",
+		Default:     false,
+	}
+	properties["statements_only"] = &api.JSONSchema{
+		Type:        "boolean",
+		Description: "Emit only the statements as a bare JSON array instead of the full document, for splicing into another document.",
+		Default:     false,
+	}
+	properties["sort_keys"] = &api.JSONSchema{
+		Type:        "boolean",
+		Description: "Sort the keys of every JSON object, including extension fields, for reproducible output and stable diffs.",
+		Default:     false,
+	}
+	properties["include_generator_metadata"] = &api.JSONSchema{
+		Type:        "boolean",
+		Description: "Record the generating tool, its version, and the generation time in a '_generator' extension field. This is an extension, not part of the OpenVEX specification.",
+		Default:     false,
+	}
+	properties["message_format"] = &api.JSONSchema{
+		Type:        "string",
+		Description: "Format of the result text: 'text' prefixes the JSON with a success message, 'json_only' returns just the JSON for clients that parse the text directly.",
+		Enum:        messageFormatValues,
+		Default:     MessageFormatText,
+	}
+	return properties
+}
+
+// text returns the result text for a formatted document, prefixed with
+// message unless only JSON was requested
+func (o outputOptions) text(message, output string) string {
+	if o.jsonOnly {
+		return output
+	}
+	return fmt.Sprintf("%s\n\n%s", message, output)
+}
+
+// format serializes doc according to the options. A requested generator
+// block is added to the document itself, so it also reaches structured content.
+// doc is encoded once; the size check, statement extraction, key sorting and
+// indentation all work from that encoding.
+func (o outputOptions) format(doc interface{}) (string, error) {
+	if d, ok := doc.(*vex.Document); ok && o.generatorMeta {
+		meta := o.generator
+		meta.GeneratedAt = time.Now().UTC()
+		d.SetExtension(vex.GeneratorExtension, meta)
+	}
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return "", err
+	}
+	// Indenting only grows the output, so oversized documents stop here
+	if len(data) > o.maxBytes {
+		return "", outputSizeError(len(data), o.maxBytes)
+	}
+
+	if _, ok := doc.(*vex.Document); ok && o.statementsOnly {
+		if data, err = documentStatements(data); err != nil {
+			return "", err
+		}
+	}
+
+	if o.sortKeys {
+		if data, err = sortedKeys(data); err != nil {
+			return "", err
+		}
+	}
+
+	if !o.compact {
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, data, "", "  "); err != nil {
+			return "", err
+		}
+		data = indented.Bytes()
+	}
+	if len(data) > o.maxBytes {
+		return "", outputSizeError(len(data), o.maxBytes)
+	}
+	return string(data), nil
+}
+
+// sortedKeys re-encodes JSON data with sorted keys at every level. Numbers
+// are kept verbatim.
+func sortedKeys(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var generic interface{}
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, err
+	}
+	return json.Marshal(generic)
+}
+
+// outputSizeError describes output over the size cap
+func outputSizeError(size, max int) error {
+	return fmt.Errorf("output of at least %d bytes exceeds the maximum of %d bytes; narrow the request, e.g. with the products or vulnerabilities filters", size, max)
+}
+
+// documentStatements returns the statements array of a marshaled document,
+// including any statement extension fields
+func documentStatements(data []byte) (json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	statements := fields["statements"]
+	if len(statements) == 0 || string(statements) == "null" {
+		return json.RawMessage("[]"), nil
+	}
+	return statements, nil
+}
+
+// vexDocumentOutputSchema describes the structuredContent of tools returning
+// a complete VEX document
+func vexDocumentOutputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"@context":     {Type: "string", Description: "OpenVEX JSON-LD context"},
+			"@id":          {Type: "string", Description: "Document identifier"},
+			"author":       {Type: "string", Description: "Document author"},
+			"role":         {Type: "string", Description: "Role of the author"},
+			"timestamp":    {Type: "string", Description: "RFC 3339 creation time"},
+			"last_updated": {Type: "string", Description: "RFC 3339 time of the last update"},
+			"version":      {Type: "integer", Description: "Document version"},
+			"statements": {
+				Type:        "array",
+				Description: "VEX statements",
+				Items: &api.JSONSchema{
+					Type: "object",
+					Properties: map[string]*api.JSONSchema{
+						"vulnerability": {
+							Type: "object",
+							Properties: map[string]*api.JSONSchema{
+								"name": {Type: "string"},
+							},
+							Required: []string{"name"},
+						},
+						"products": {
+							Type: "array",
+							Items: &api.JSONSchema{
+								Type: "object",
+								Properties: map[string]*api.JSONSchema{
+									"@id": {Type: "string"},
+								},
+							},
+						},
+						"status":           {Type: "string", Enum: statusValues},
+						"justification":    {Type: "string"},
+						"impact_statement": {Type: "string"},
+						"action_statement": {Type: "string"},
+						"timestamp":        {Type: "string"},
+					},
+					Required: []string{"vulnerability", "products", "status"},
+				},
+			},
+		},
+		Required: []string{"@context", "@id", "author", "timestamp", "version", "statements"},
+	}
+}
+
+// structuredDocument converts doc to the plain JSON object returned as
+// structuredContent, including any extension fields
+func structuredDocument(doc *vex.Document) (map[string]interface{}, error) {
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	var structured map[string]interface{}
+	if err := json.Unmarshal(data, &structured); err != nil {
+		return nil, err
+	}
+	return structured, nil
+}
+
+// formatVEXDocument formats a VEX document as indented JSON of at most
+// maxBytes
+func formatVEXDocument(doc interface{}, maxBytes int) (string, error) {
+	return outputOptions{maxBytes: maxBytes}.format(doc)
+}
+
+// jsonResult creates a tool result with a message followed by v as indented
+// JSON of at most maxBytes
+func jsonResult(message string, v interface{}, maxBytes int) *api.ToolResult {
+	output, err := formatVEXDocument(v, maxBytes)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: failed to format result: %s", err.Error()))
+	}
+
+	return &api.ToolResult{
+		Content: []api.Content{
+			{
+				Type: "text",
+				Text: fmt.Sprintf("%s\n\n%s", message, output),
+			},
+		},
+	}
+}
@@ -2,10 +2,15 @@ package tools
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
 	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
 )
 
 func TestVEXCreateTool_Name(t *testing.T) {
@@ -454,6 +459,14 @@ func TestVEXMergeTool_Execute_ValidationErrors(t *testing.T) {
 			},
 			wantErrContains: "statements",
 		},
+		{
+			name: "non-integer max_products",
+			args: map[string]interface{}{
+				"documents":    []interface{}{doc, doc},
+				"max_products": "two",
+			},
+			wantErrContains: "max_products must be an integer",
+		},
 	}
 
 	for _, tt := range tests {
@@ -489,7 +502,7 @@ func TestFormatVEXDocument(t *testing.T) {
 		"version":  1,
 	}
 
-	output, err := formatVEXDocument(doc)
+	output, err := formatVEXDocument(doc, vex.DefaultMaxOutputBytes)
 	if err != nil {
 		t.Fatalf("formatVEXDocument() error = %v", err)
 	}
@@ -527,3 +540,2824 @@ func TestErrorResult(t *testing.T) {
 		t.Errorf("errorResult() content text = %v, want %v", result.Content[0].Text, message)
 	}
 }
+
+func TestVEXDirectoryMergeTool_Execute(t *testing.T) {
+	dir := t.TempDir()
+	for i, name := range []string{"a.vex.json", "b.vex.json"} {
+		doc := fmt.Sprintf(`{"@context": "https://openvex.dev/ns", "@id": "%s", "author": "team", "version": 1, "timestamp": "2023-01-01T00:00:00Z", "statements": [{"vulnerability": {"name": "CVE-2023-000%d"}, "products": [{"@id": "pkg:npm/svc@1.0.0"}], "status": "fixed"}]}`, name, i)
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(doc), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	client := vex.NewClient("test-author")
+	ctx := context.Background()
+
+	t.Run("merges configured directory", func(t *testing.T) {
+		tool := NewVEXDirectoryMergeTool(client, dir)
+		result, err := tool.Execute(ctx, map[string]interface{}{"author": "nightly"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if result.IsError {
+			t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+		}
+		if !strings.Contains(result.Content[0].Text, "CVE-2023-0001") {
+			t.Error("Result should contain statements from every file")
+		}
+	})
+
+	t.Run("empty directory", func(t *testing.T) {
+		tool := NewVEXDirectoryMergeTool(client, t.TempDir())
+		result, err := tool.Execute(ctx, map[string]interface{}{})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if !result.IsError {
+			t.Error("Execute() should return error result for an empty directory")
+		}
+	})
+
+	t.Run("client roots narrow access", func(t *testing.T) {
+		other := t.TempDir()
+		configured := t.TempDir()
+		if err := os.Symlink(dir, filepath.Join(configured, "escape")); err != nil {
+			t.Fatal(err)
+		}
+		tests := []struct {
+			name     string
+			tool     *VEXDirectoryMergeTool
+			ctx      context.Context
+			args     map[string]interface{}
+			wantErr  bool
+			contains string
+		}{
+			{
+				name:     "directory within configured directory and root",
+				tool:     NewVEXDirectoryMergeTool(client, filepath.Dir(dir)),
+				ctx:      api.ContextWithRoots(ctx, []string{dir}),
+				args:     map[string]interface{}{"directory": dir},
+				contains: "CVE-2023-0001",
+			},
+			{
+				name:     "directory outside root",
+				tool:     NewVEXDirectoryMergeTool(client, filepath.Dir(dir)),
+				ctx:      api.ContextWithRoots(ctx, []string{other}),
+				args:     map[string]interface{}{"directory": dir},
+				wantErr:  true,
+				contains: "directory must be within an allowed directory",
+			},
+			{
+				name:     "roots narrow configured directory",
+				tool:     NewVEXDirectoryMergeTool(client, dir),
+				ctx:      api.ContextWithRoots(ctx, []string{other}),
+				args:     map[string]interface{}{},
+				wantErr:  true,
+				contains: "directory must be within an allowed directory",
+			},
+			{
+				name:     "roots cannot widen configured directory",
+				tool:     NewVEXDirectoryMergeTool(client, configured),
+				ctx:      api.ContextWithRoots(ctx, []string{"/"}),
+				args:     map[string]interface{}{"directory": dir},
+				wantErr:  true,
+				contains: "directory must be within an allowed directory",
+			},
+			{
+				name:     "configured directory without roots",
+				tool:     NewVEXDirectoryMergeTool(client, dir),
+				ctx:      ctx,
+				args:     map[string]interface{}{"directory": filepath.Join(dir, "..")},
+				wantErr:  true,
+				contains: "directory must be within an allowed directory",
+			},
+			{
+				name:     "symlink out of configured directory",
+				tool:     NewVEXDirectoryMergeTool(client, configured),
+				ctx:      ctx,
+				args:     map[string]interface{}{"directory": filepath.Join(configured, "escape")},
+				wantErr:  true,
+				contains: "directory must be within an allowed directory",
+			},
+			{
+				name:     "no configured directory",
+				tool:     NewVEXDirectoryMergeTool(client, ""),
+				ctx:      api.ContextWithRoots(ctx, []string{dir}),
+				args:     map[string]interface{}{"directory": dir},
+				wantErr:  true,
+				contains: "no directory is configured",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := tt.tool.Execute(tt.ctx, tt.args)
+				if err != nil {
+					t.Fatalf("Execute() error = %v", err)
+				}
+				if result.IsError != tt.wantErr {
+					t.Fatalf("Execute() IsError = %v, want %v: %v", result.IsError, tt.wantErr, result.Content[0].Text)
+				}
+				if !strings.Contains(result.Content[0].Text, tt.contains) {
+					t.Errorf("Result should contain %q, got %v", tt.contains, result.Content[0].Text)
+				}
+			})
+		}
+	})
+}
+
+func TestVEXDirectoryMigrateTool_Execute(t *testing.T) {
+	dir := t.TempDir()
+	legacy := `{"@context": "https://openvex.dev/ns/v0.0.1", "@id": "legacy", "author": "team", "version": "1", "timestamp": "2023-01-01T00:00:00Z", "statements": [{"vulnerability": "CVE-2023-0001", "products": ["pkg:npm/svc@1.0.0"], "status": "fixed"}]}`
+	if err := os.WriteFile(filepath.Join(dir, "legacy.vex.json"), []byte(legacy), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tool := NewVEXDirectoryMigrateTool(vex.NewClient("test-author"), dir)
+	ctx := context.Background()
+
+	t.Run("migrates configured directory", func(t *testing.T) {
+		result, err := tool.Execute(ctx, map[string]interface{}{"output_dir": "migrated"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if result.IsError {
+			t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+		}
+		if !strings.Contains(result.Content[0].Text, "Migrated 1 of 1 document(s)") {
+			t.Errorf("Unexpected result: %v", result.Content[0].Text)
+		}
+		if _, err := os.Stat(filepath.Join(dir, "migrated", "legacy.vex.json")); err != nil {
+			t.Errorf("Expected migrated document in output directory: %v", err)
+		}
+	})
+
+	t.Run("client roots narrow access", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			roots    []string
+			args     map[string]interface{}
+			contains string
+		}{
+			{
+				name:     "root excludes directory",
+				roots:    []string{t.TempDir()},
+				args:     map[string]interface{}{},
+				contains: "directory must be within an allowed directory",
+			},
+			{
+				name:     "root excludes output directory",
+				roots:    []string{dir},
+				args:     map[string]interface{}{"output_dir": "../elsewhere"},
+				contains: "output_dir must be within an allowed directory",
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				before, err := os.ReadFile(filepath.Join(dir, "legacy.vex.json"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				result, err := tool.Execute(api.ContextWithRoots(ctx, tt.roots), tt.args)
+				if err != nil {
+					t.Fatalf("Execute() error = %v", err)
+				}
+				if !result.IsError || !strings.Contains(result.Content[0].Text, tt.contains) {
+					t.Errorf("Execute() = %v, want error containing %q", result.Content[0].Text, tt.contains)
+				}
+				after, err := os.ReadFile(filepath.Join(dir, "legacy.vex.json"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(before) != string(after) {
+					t.Error("Document outside the client roots should not be rewritten")
+				}
+			})
+		}
+	})
+
+	t.Run("invalid target context", func(t *testing.T) {
+		result, err := tool.Execute(ctx, map[string]interface{}{"target_context": "https://example.com/ns"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if !result.IsError {
+			t.Error("Execute() should return error result for an unsupported context")
+		}
+	})
+}
+
+func TestOutputOptions_Compact(t *testing.T) {
+	doc := map[string]interface{}{
+		"@context":   "https://openvex.dev/ns",
+		"@id":        "test-doc",
+		"statements": []interface{}{map[string]interface{}{"status": "fixed"}},
+	}
+
+	opts, err := parseOutputOptions(map[string]interface{}{"compact": true}, vex.NewClient("test-author"))
+	if err != nil {
+		t.Fatalf("parseOutputOptions() error = %v", err)
+	}
+	output, err := opts.format(doc)
+	if err != nil {
+		t.Fatalf("format() error = %v", err)
+	}
+	if strings.Contains(output, "\n") {
+		t.Errorf("compact output should not contain newlines: %q", output)
+	}
+
+	opts, err = parseOutputOptions(map[string]interface{}{}, vex.NewClient("test-author"))
+	if err != nil {
+		t.Fatalf("parseOutputOptions() error = %v", err)
+	}
+	pretty, err := opts.format(doc)
+	if err != nil {
+		t.Fatalf("format() error = %v", err)
+	}
+	if !strings.Contains(pretty, "\n  ") {
+		t.Error("default output should be indented")
+	}
+}
+
+func TestOutputOptions_SortKeys(t *testing.T) {
+	client := vex.NewClient("test-author")
+	score := 7.5
+	doc, err := client.CreateDocument(&vex.CreateInput{
+		Product:       "pkg:npm/lodash@4.17.21",
+		Vulnerability: "CVE-2023-1234",
+		Status:        "fixed",
+		Labels:        map[string]string{"zone": "eu", "team": "payments", "app": "api", "env": "prod"},
+		CVSS:          &vex.CVSS{Score: &score},
+		Notes:         []string{"first", "second"},
+	})
+	if err != nil {
+		t.Fatalf("CreateDocument() error = %v", err)
+	}
+
+	opts, err := parseOutputOptions(map[string]interface{}{"sort_keys": true, "compact": true}, vex.NewClient("test-author"))
+	if err != nil {
+		t.Fatalf("parseOutputOptions() error = %v", err)
+	}
+	first, err := opts.format(doc)
+	if err != nil {
+		t.Fatalf("format() error = %v", err)
+	}
+	for i := 0; i < 20; i++ {
+		output, err := opts.format(doc)
+		if err != nil {
+			t.Fatalf("format() error = %v", err)
+		}
+		if output != first {
+			t.Fatalf("format() output differs between runs:\n%s\n%s", first, output)
+		}
+	}
+
+	// Keys are sorted in the document, statements, and extension maps
+	for _, ordered := range [][]string{
+		{`"@context"`, `"@id"`, `"author"`, `"labels"`, `"statements"`, `"timestamp"`, `"version"`},
+		{`"app"`, `"env"`, `"team"`, `"zone"`},
+		{`"cvss"`, `"notes"`, `"products"`, `"status"`, `"vulnerability"`},
+	} {
+		last := -1
+		for _, key := range ordered {
+			index := strings.Index(first, key+":")
+			if index < last {
+				t.Errorf("key %s out of order in %s", key, first)
+			}
+			last = index
+		}
+	}
+	if !strings.Contains(first, `"score":7.5`) {
+		t.Errorf("numbers should be preserved, got %s", first)
+	}
+}
+
+func TestVEXCreateTool_Execute_Compact(t *testing.T) {
+	tool := NewVEXCreateTool(vex.NewClient("test-author"))
+
+	result, err := tool.Execute(context.Background(), map[string]interface{}{
+		"product":       "pkg:npm/react@17.0.0",
+		"vulnerability": "CVE-2023-9999",
+		"status":        "fixed",
+		"compact":       true,
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+
+	_, output, _ := strings.Cut(result.Content[0].Text, "\n\n")
+	if output == "" || strings.Contains(output, "\n") {
+		t.Errorf("compact document should be a single line, got %q", output)
+	}
+}
+
+func TestVEXCreateTool_Execute_JSONOnly(t *testing.T) {
+	tool := NewVEXCreateTool(vex.NewClient("test-author"))
+
+	result, err := tool.Execute(context.Background(), map[string]interface{}{
+		"product":        "pkg:npm/react@17.0.0",
+		"vulnerability":  "CVE-2023-9999",
+		"status":         "fixed",
+		"message_format": "json_only",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal([]byte(result.Content[0].Text), &doc); err != nil {
+		t.Fatalf("json_only result should be a bare JSON document: %v\n%s", err, result.Content[0].Text)
+	}
+	if doc["@context"] == nil {
+		t.Errorf("json_only result should be the VEX document, got %v", doc)
+	}
+}
+
+func TestOutputOptions_InvalidMessageFormat(t *testing.T) {
+	client := vex.NewClient("test-author")
+	document := map[string]interface{}{
+		"@context":  "https://openvex.dev/ns",
+		"@id":       "doc-1",
+		"timestamp": "2023-01-01T00:00:00Z",
+		"statements": []interface{}{
+			map[string]interface{}{
+				"vulnerability": map[string]interface{}{"name": "CVE-2023-9999"},
+				"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/react@17.0.0"}},
+				"status":        "fixed",
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+		tool api.Tool
+		args map[string]interface{}
+	}{
+		{
+			name: "create",
+			tool: NewVEXCreateTool(client),
+			args: map[string]interface{}{"product": "pkg:npm/react@17.0.0", "vulnerability": "CVE-2023-9999", "status": "fixed"},
+		},
+		{
+			name: "merge",
+			tool: NewVEXMergeTool(client),
+			args: map[string]interface{}{"documents": []interface{}{document, document}},
+		},
+		{
+			name: "normalize ids",
+			tool: NewVEXNormalizeIDsTool(client),
+			args: map[string]interface{}{"document": document},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args["message_format"] = "jsononly"
+			result, err := tt.tool.Execute(context.Background(), tt.args)
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if !result.IsError || !strings.Contains(result.Content[0].Text, `invalid message_format "jsononly"`) {
+				t.Errorf("Expected invalid message_format error, got %v", result.Content[0].Text)
+			}
+		})
+	}
+}
+
+func TestVEXCreateTool_InputSchema_Examples(t *testing.T) {
+	tool := NewVEXCreateTool(vex.NewClient("test-author"))
+
+	listResult := api.ToolsListResult{
+		Tools: []api.ToolInfo{{
+			Name:        tool.Name(),
+			Description: tool.Description(),
+			InputSchema: tool.InputSchema(),
+		}},
+	}
+	data, err := json.Marshal(listResult)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var decoded struct {
+		Tools []struct {
+			InputSchema struct {
+				Properties map[string]struct {
+					Examples []interface{} `json:"examples"`
+				} `json:"properties"`
+			} `json:"inputSchema"`
+		} `json:"tools"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	props := decoded.Tools[0].InputSchema.Properties
+	for _, name := range []string{"product", "vulnerability", "status"} {
+		if len(props[name].Examples) == 0 {
+			t.Errorf("Property %v should serialize examples", name)
+		}
+	}
+	if props["product"].Examples[0] != "pkg:npm/lodash@4.17.21" {
+		t.Errorf("product example = %v, want pkg:npm/lodash@4.17.21", props["product"].Examples[0])
+	}
+	if strings.Contains(string(data), `"examples":null`) {
+		t.Error("Properties without examples should omit the field")
+	}
+}
+
+func TestAddOutputProperties_Default(t *testing.T) {
+	properties := addOutputProperties(map[string]*api.JSONSchema{
+		"product": {Type: "string"},
+	})
+
+	data, err := json.Marshal(properties)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var decoded map[string]map[string]interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	if got, ok := decoded["compact"]["default"]; !ok || got != false {
+		t.Errorf("compact default = %v, want false", got)
+	}
+	if _, ok := decoded["product"]["default"]; ok {
+		t.Error("Properties without a default should omit the field")
+	}
+}
+
+func TestVEXTimestampCheckTool_Execute(t *testing.T) {
+	tool := NewVEXTimestampCheckTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"document": map[string]interface{}{
+			"statements": []interface{}{
+				map[string]interface{}{"timestamp": "not-a-date"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{"2 issue(s)", `"valid": false`, "statements[0].timestamp"} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+
+	result, _ = tool.Execute(ctx, map[string]interface{}{})
+	if !result.IsError {
+		t.Error("Execute() should return error result when document is missing")
+	}
+}
+
+func TestVEXCreateTool_Execute_Labels(t *testing.T) {
+	tool := NewVEXCreateTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"product":       "pkg:npm/react@17.0.0",
+		"vulnerability": "CVE-2023-9999",
+		"status":        "fixed",
+		"labels":        map[string]interface{}{"team": "web"},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	if !strings.Contains(result.Content[0].Text, `"team": "web"`) {
+		t.Errorf("Result should contain labels, got %v", result.Content[0].Text)
+	}
+
+	result, _ = tool.Execute(ctx, map[string]interface{}{
+		"product":       "pkg:npm/react@17.0.0",
+		"vulnerability": "CVE-2023-9999",
+		"status":        "fixed",
+		"labels":        map[string]interface{}{"team": 1},
+	})
+	if !result.IsError {
+		t.Error("Execute() should reject non-string label values")
+	}
+}
+
+func TestVEXMergeTool_Execute_ValidationWarnings(t *testing.T) {
+	tool := NewVEXMergeTool(vex.NewClient("test-author"))
+
+	doc := map[string]interface{}{
+		"@context":  "https://openvex.dev/ns",
+		"timestamp": "2023-01-01T00:00:00Z",
+		"statements": []interface{}{
+			map[string]interface{}{
+				"vulnerability": map[string]interface{}{"name": "CVE-2023-5678"},
+				"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/express@4.18.0"}},
+				"status":        "affected",
+			},
+		},
+	}
+
+	result, err := tool.Execute(context.Background(), map[string]interface{}{
+		"documents": []interface{}{doc, doc},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError || len(result.Content) != 2 {
+		t.Fatalf("Execute() should succeed with a warnings content item, got %v", result.Content)
+	}
+	if !strings.Contains(result.Content[1].Text, "Validation warnings (2)") {
+		t.Errorf("Warnings content = %v", result.Content[1].Text)
+	}
+
+	result, _ = tool.Execute(context.Background(), map[string]interface{}{
+		"documents":       []interface{}{doc, doc},
+		"validate_result": true,
+	})
+	if !result.IsError {
+		t.Error("Execute() with validate_result should fail for invalid statements")
+	}
+}
+
+func TestVEXRemediationsTool_Execute(t *testing.T) {
+	tool := NewVEXRemediationsTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"document": map[string]interface{}{
+			"@context": "https://openvex.dev/ns",
+			"statements": []interface{}{
+				map[string]interface{}{
+					"vulnerability":    map[string]interface{}{"name": "CVE-2023-1234"},
+					"products":         []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.20"}},
+					"status":           "affected",
+					"action_statement": "Upgrade lodash to 4.17.21",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{"1 remediation(s)", "CVE-2023-1234", "Upgrade lodash to 4.17.21"} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+
+	result, _ = tool.Execute(ctx, map[string]interface{}{})
+	if !result.IsError {
+		t.Error("Execute() should return error result when document is missing")
+	}
+}
+
+func TestVEXBatchCreateTool_Execute(t *testing.T) {
+	tool := NewVEXBatchCreateTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	items := []interface{}{
+		map[string]interface{}{"product": "pkg:npm/lodash@4.17.21", "vulnerability": "CVE-2023-1234", "status": "fixed"},
+		map[string]interface{}{"product": "pkg:npm/axios@0.21.0", "status": "fixed"},
+		map[string]interface{}{"product": "pkg:npm/express@4.18.0", "vulnerability": "CVE-2023-5678", "status": "bogus"},
+		map[string]interface{}{"product": "pkg:npm/react@18.0.0", "vulnerability": "CVE-2023-9999", "status": "fixed"},
+	}
+
+	result, err := tool.Execute(ctx, map[string]interface{}{"items": items})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !result.IsError || !strings.Contains(result.Content[0].Text, "item 1") {
+		t.Errorf("Execute() should abort on the first failure, got %v", result.Content[0].Text)
+	}
+
+	result, err = tool.Execute(ctx, map[string]interface{}{"items": items, "continue_on_error": true})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+
+	text := result.Content[0].Text
+	var batch struct {
+		Succeeded []vex.BatchItemResult `json:"succeeded"`
+		Failed    []vex.BatchItemResult `json:"failed"`
+	}
+	if err := json.Unmarshal([]byte(text[strings.Index(text, "{"):]), &batch); err != nil {
+		t.Fatalf("Result is not valid JSON: %v", err)
+	}
+	if len(batch.Succeeded) != 2 || batch.Succeeded[0].Index != 0 || batch.Succeeded[1].Index != 3 {
+		t.Errorf("unexpected successes %+v", batch.Succeeded)
+	}
+	if len(batch.Failed) != 2 || batch.Failed[0].Index != 1 || batch.Failed[1].Index != 2 {
+		t.Errorf("unexpected failures %+v", batch.Failed)
+	}
+}
+
+func TestVEXEqualTool_Execute(t *testing.T) {
+	tool := NewVEXEqualTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	document := func(id, status string) map[string]interface{} {
+		return map[string]interface{}{
+			"@context":  "https://openvex.dev/ns",
+			"@id":       id,
+			"timestamp": "2023-01-01T00:00:00Z",
+			"statements": []interface{}{
+				map[string]interface{}{
+					"vulnerability": map[string]interface{}{"name": "CVE-2023-1234"},
+					"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+					"status":        status,
+				},
+			},
+		}
+	}
+
+	result, err := tool.Execute(ctx, map[string]interface{}{"left": document("a", "fixed"), "right": document("b", "fixed")})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError || !strings.Contains(result.Content[0].Text, `"equal": true`) {
+		t.Errorf("Documents differing only by @id should be equal, got %v", result.Content[0].Text)
+	}
+
+	result, _ = tool.Execute(ctx, map[string]interface{}{"left": document("a", "fixed"), "right": document("a", "under_investigation")})
+	if result.IsError || !strings.Contains(result.Content[0].Text, "statements[0].status") {
+		t.Errorf("Result should report the differing status, got %v", result.Content[0].Text)
+	}
+
+	result, _ = tool.Execute(ctx, map[string]interface{}{"left": document("a", "fixed")})
+	if !result.IsError {
+		t.Error("Execute() should return error result when right is missing")
+	}
+}
+
+func TestVEXAffectedProductsTool_Execute(t *testing.T) {
+	tool := NewVEXAffectedProductsTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	statement := func(vuln, product, status string) map[string]interface{} {
+		stmt := map[string]interface{}{
+			"vulnerability": map[string]interface{}{"name": vuln},
+			"products":      []interface{}{map[string]interface{}{"@id": product}},
+			"status":        status,
+		}
+		if status == "affected" {
+			stmt["action_statement"] = "Upgrade"
+		}
+		return stmt
+	}
+	documents := []interface{}{
+		map[string]interface{}{
+			"@context": "https://openvex.dev/ns",
+			"statements": []interface{}{
+				statement("CVE-2023-1234", "pkg:npm/lodash@4.17.20", "affected"),
+				statement("CVE-2023-1234", "pkg:npm/lodash@4.17.21", "fixed"),
+			},
+		},
+		map[string]interface{}{
+			"@context": "https://openvex.dev/ns",
+			"statements": []interface{}{
+				statement("GHSA-jfh8-c2jp-5v3q", "pkg:docker/app@1.0.0", "affected"),
+			},
+		},
+	}
+
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"documents":     documents,
+		"vulnerability": "CVE-2023-1234",
+		"alias_map":     map[string]interface{}{"GHSA-jfh8-c2jp-5v3q": "CVE-2023-1234"},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	text := result.Content[0].Text
+	for _, want := range []string{"2 affected product(s)", "pkg:npm/lodash@4.17.20", "pkg:docker/app@1.0.0"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Result should contain %q, got %v", want, text)
+		}
+	}
+	if strings.Contains(text, "pkg:npm/lodash@4.17.21") {
+		t.Errorf("Result should not contain fixed products, got %v", text)
+	}
+
+	result, _ = tool.Execute(ctx, map[string]interface{}{"documents": documents, "alias_map": "invalid", "vulnerability": "CVE-2023-1234"})
+	if !result.IsError {
+		t.Error("Execute() should return error result for a malformed alias_map")
+	}
+}
+
+func TestVEXCreateTool_Execute_StatementsOnly(t *testing.T) {
+	tool := NewVEXCreateTool(vex.NewClient("test-author"))
+
+	result, err := tool.Execute(context.Background(), map[string]interface{}{
+		"product":         "pkg:npm/react@17.0.0",
+		"vulnerability":   "CVE-2023-9999",
+		"status":          "fixed",
+		"statements_only": true,
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+
+	_, output, _ := strings.Cut(result.Content[0].Text, "\n\n")
+	var statements []map[string]interface{}
+	if err := json.Unmarshal([]byte(output), &statements); err != nil {
+		t.Fatalf("statements_only output should be a bare JSON array: %v\n%s", err, output)
+	}
+	if len(statements) != 1 || statements[0]["status"] != "fixed" {
+		t.Errorf("unexpected statements %v", statements)
+	}
+}
+
+func TestVEXCreateTool_Execute_AuthorRole(t *testing.T) {
+	tool := NewVEXCreateTool(vex.NewClient("test-author"))
+
+	result, err := tool.Execute(context.Background(), map[string]interface{}{
+		"product":       "pkg:npm/react@17.0.0",
+		"vulnerability": "CVE-2023-9999",
+		"status":        "fixed",
+		"author_role":   "Vulnerability Manager",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	if !strings.Contains(result.Content[0].Text, `"role": "Vulnerability Manager"`) {
+		t.Errorf("Result should contain the author role, got %v", result.Content[0].Text)
+	}
+}
+
+func TestVEXBumpVersionTool_Execute(t *testing.T) {
+	tool := NewVEXBumpVersionTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"document": map[string]interface{}{
+			"@context":   "https://openvex.dev/ns",
+			"version":    float64(2),
+			"statements": []interface{}{},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{"version 3", `"version": 3`} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+
+	result, _ = tool.Execute(ctx, map[string]interface{}{})
+	if !result.IsError {
+		t.Error("Execute() should return error result when document is missing")
+	}
+}
+
+func TestParseEnumArg(t *testing.T) {
+	allowed := []string{"first", "second"}
+
+	tests := []struct {
+		name            string
+		args            map[string]interface{}
+		want            string
+		wantErrContains string
+	}{
+		{name: "valid", args: map[string]interface{}{"policy": "second"}, want: "second"},
+		{name: "absent", args: map[string]interface{}{}, want: ""},
+		{name: "invalid", args: map[string]interface{}{"policy": "third"}, wantErrContains: `invalid policy "third": must be one of first, second`},
+		{name: "not a string", args: map[string]interface{}{"policy": 1.0}, wantErrContains: "policy must be a string"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseEnumArg(tt.args, "policy", allowed)
+			if tt.wantErrContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrContains) {
+					t.Errorf("parseEnumArg() error = %v, want to contain %v", err, tt.wantErrContains)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseEnumArg() unexpected error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("parseEnumArg() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVEXCreateTool_Execute_InvalidEnumListsValues(t *testing.T) {
+	tool := NewVEXCreateTool(vex.NewClient("test-author"))
+
+	result, err := tool.Execute(context.Background(), map[string]interface{}{
+		"product":       "pkg:npm/lodash@4.17.21",
+		"vulnerability": "CVE-2023-1234",
+		"status":        "not_affected",
+		"justification": "not_reachable",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !result.IsError || !strings.Contains(result.Content[0].Text, "must be one of component_not_present") {
+		t.Errorf("Error should list the valid justifications, got %v", result.Content[0].Text)
+	}
+}
+
+func TestVEXConsolidateTool_Execute(t *testing.T) {
+	tool := NewVEXConsolidateTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	document := func(timestamp, status string) map[string]interface{} {
+		return map[string]interface{}{
+			"@context":  "https://openvex.dev/ns",
+			"timestamp": timestamp,
+			"statements": []interface{}{
+				map[string]interface{}{
+					"vulnerability": map[string]interface{}{"name": "CVE-2023-1234"},
+					"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+					"status":        status,
+				},
+			},
+		}
+	}
+
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"documents": []interface{}{
+			document("2023-03-01T00:00:00Z", "fixed"),
+			document("2023-01-01T00:00:00Z", "under_investigation"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	text := result.Content[0].Text
+	if !strings.Contains(text, "1 statement(s)") || !strings.Contains(text, `"status": "fixed"`) {
+		t.Errorf("Result should keep only the newest statement, got %v", text)
+	}
+
+	older := document("2023-01-01T00:00:00Z", "under_investigation")
+	older["@id"] = "vendor"
+	result, err = tool.Execute(ctx, map[string]interface{}{
+		"documents":         []interface{}{document("2023-03-01T00:00:00Z", "fixed"), older},
+		"document_priority": []interface{}{"vendor"},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError || !strings.Contains(result.Content[0].Text, `"status": "under_investigation"`) {
+		t.Errorf("Result should keep the priority document's statement, got %v", result.Content[0].Text)
+	}
+
+	result, _ = tool.Execute(ctx, map[string]interface{}{"documents": []interface{}{}})
+	if !result.IsError {
+		t.Error("Execute() should return error result for no documents")
+	}
+}
+
+func TestVEXValidateTool_Execute(t *testing.T) {
+	tool := NewVEXValidateTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	doc := map[string]interface{}{
+		"@context":  "https://openvex.dev/ns",
+		"@id":       "doc1",
+		"author":    "Security Team",
+		"timestamp": "2023-01-01T00:00:00Z",
+		"version":   float64(1),
+		"statements": []interface{}{
+			map[string]interface{}{
+				"vulnerability": map[string]interface{}{"name": "CVE-2023-1234"},
+				"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+				"status":        "fixed",
+			},
+		},
+	}
+
+	result, err := tool.Execute(ctx, map[string]interface{}{"document": doc})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError || !strings.Contains(result.Content[0].Text, "VEX document is valid") {
+		t.Errorf("Document should be valid, got %v", result.Content[0].Text)
+	}
+
+	result, _ = tool.Execute(ctx, map[string]interface{}{"document": doc, "version": "0.2.0"})
+	if result.IsError || !strings.Contains(result.Content[0].Text, "does not match OpenVEX version 0.2.0") {
+		t.Errorf("Unversioned context should not match 0.2.0, got %v", result.Content[0].Text)
+	}
+
+	result, _ = tool.Execute(ctx, map[string]interface{}{"document": doc, "version": "0.1.0"})
+	if !result.IsError || !strings.Contains(result.Content[0].Text, "must be one of 0.2.0") {
+		t.Errorf("Unsupported version should be rejected, got %v", result.Content[0].Text)
+	}
+
+	delete(doc, "author")
+	result, _ = tool.Execute(ctx, map[string]interface{}{"document": doc})
+	if result.IsError || !strings.Contains(result.Content[0].Text, "VEX document is valid with 1 warning(s)") ||
+		!strings.Contains(result.Content[0].Text, "author is empty") {
+		t.Errorf("Missing author should be a warning, got %v", result.Content[0].Text)
+	}
+}
+
+func TestVEXCreateTool_Execute_LenientJustifications(t *testing.T) {
+	tool := NewVEXCreateTool(vex.NewClient("test-author", vex.WithLenientJustifications(true)))
+
+	if tool.InputSchema().Properties["justification"].Enum != nil {
+		t.Error("justification enum should be omitted when variants are accepted")
+	}
+
+	result, err := tool.Execute(context.Background(), map[string]interface{}{
+		"product":       "pkg:npm/lodash@4.17.21",
+		"vulnerability": "CVE-2023-1234",
+		"status":        "not_affected",
+		"justification": "componentNotPresent",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError || !strings.Contains(result.Content[0].Text, `"justification": "component_not_present"`) {
+		t.Errorf("Justification should be normalized, got %v", result.Content[0].Text)
+	}
+}
+
+func TestVEXCreateTool_Execute_CVSS(t *testing.T) {
+	tool := NewVEXCreateTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"product":       "pkg:npm/react@17.0.0",
+		"vulnerability": "CVE-2023-9999",
+		"status":        "fixed",
+		"cvss":          9.8,
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError || !strings.Contains(result.Content[0].Text, `"score": 9.8`) {
+		t.Errorf("Result should carry the cvss score, got %v", result.Content[0].Text)
+	}
+
+	result, _ = tool.Execute(ctx, map[string]interface{}{
+		"product":       "pkg:npm/react@17.0.0",
+		"vulnerability": "CVE-2023-9999",
+		"status":        "fixed",
+		"cvss":          "CVSS:3.1/AV:X",
+	})
+	if !result.IsError || !strings.Contains(result.Content[0].Text, "cvss must be a CVSS") {
+		t.Errorf("Invalid vector should be rejected, got %v", result.Content[0].Text)
+	}
+}
+
+func TestVEXCreateTool_Execute_Notes(t *testing.T) {
+	tool := NewVEXCreateTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"product":       "pkg:npm/react@17.0.0",
+		"vulnerability": "CVE-2023-9999",
+		"status":        "fixed",
+		"notes":         []interface{}{"Reviewed by the platform team", "Fixed in the 17.0.1 rebuild"},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError || !strings.Contains(result.Content[0].Text, `"Reviewed by the platform team",`) {
+		t.Errorf("Result should carry the notes, got %v", result.Content[0].Text)
+	}
+
+	result, _ = tool.Execute(ctx, map[string]interface{}{
+		"product":       "pkg:npm/react@17.0.0",
+		"vulnerability": "CVE-2023-9999",
+		"status":        "fixed",
+		"notes":         []interface{}{"ok", "rm -rf /; echo"},
+	})
+	if !result.IsError || !strings.Contains(result.Content[0].Text, "notes[1]") {
+		t.Errorf("Invalid note should be rejected, got %v", result.Content[0].Text)
+	}
+}
+
+func TestVEXCreateTool_Execute_CollectErrors(t *testing.T) {
+	tool := NewVEXCreateTool(vex.NewClient("test-author"))
+
+	result, err := tool.Execute(context.Background(), map[string]interface{}{
+		"product":        "pkg:npm/react@17.0.0;ls",
+		"vulnerability":  "CVE-2023-9999",
+		"status":         "fixed",
+		"author":         "$(whoami)",
+		"collect_errors": true,
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !result.IsError {
+		t.Fatal("Execute() should return error result for invalid fields")
+	}
+	for _, want := range []string{"product contains potentially dangerous characters", "author contains potentially dangerous characters"} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+}
+
+func TestVEXCreateTool_Execute_CollectErrorsArguments(t *testing.T) {
+	tool := NewVEXCreateTool(vex.NewClient("test-author"))
+
+	result, err := tool.Execute(context.Background(), map[string]interface{}{
+		"product":        "",
+		"vulnerability":  "CVE-2023-9999",
+		"status":         "bogus",
+		"justification":  "not_reachable",
+		"labels":         "team=platform",
+		"cvss":           true,
+		"collect_errors": true,
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !result.IsError {
+		t.Fatal("Execute() should return error result for invalid fields")
+	}
+	for _, want := range []string{
+		`invalid status "bogus"`,
+		`invalid justification "not_reachable"`,
+		"labels must be a JSON object",
+		"cvss must be a vector string or a score",
+		"product is required",
+	} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+}
+
+func TestVEXPURLConsistencyTool_Execute(t *testing.T) {
+	tool := NewVEXPURLConsistencyTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"document": map[string]interface{}{
+			"@context": "https://openvex.dev/ns",
+			"statements": []interface{}{
+				map[string]interface{}{
+					"vulnerability": map[string]interface{}{"name": "CVE-2023-1234"},
+					"products": []interface{}{
+						map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"},
+						map[string]interface{}{"@id": "pkg:pypi/django@4.2.0"},
+					},
+					"status": "fixed",
+				},
+			},
+		},
+		"type": "npm",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{"1 inconsistent product(s)", "pkg:pypi/django@4.2.0", `"consistent": false`} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+
+	result, err = tool.Execute(ctx, map[string]interface{}{
+		"document": map[string]interface{}{
+			"@context": "https://openvex.dev/ns",
+			"statements": []interface{}{
+				map[string]interface{}{
+					"vulnerability": map[string]interface{}{"name": "CVE-2023-1234"},
+					"products":      []interface{}{map[string]interface{}{"@id": "pkg:apk/wolfi/git@2.39.0-r1"}},
+					"status":        "fixed",
+				},
+			},
+		},
+		"required_qualifiers": []interface{}{"arch"},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !strings.Contains(result.Content[0].Text, `missing required qualifier \"arch\"`) {
+		t.Errorf("Result should report the missing qualifier, got %v", result.Content[0].Text)
+	}
+}
+
+func TestVEXStalenessTool_Execute(t *testing.T) {
+	tool := NewVEXStalenessTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"document": map[string]interface{}{
+			"@context": "https://openvex.dev/ns",
+			"statements": []interface{}{
+				map[string]interface{}{
+					"vulnerability":    map[string]interface{}{"name": "CVE-2023-1234"},
+					"products":         []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+					"status":           "affected",
+					"action_statement": "Upgrade to 4.17.22",
+				},
+				map[string]interface{}{
+					"vulnerability": map[string]interface{}{"name": "CVE-2023-1234"},
+					"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+					"status":        "fixed",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{"1 stale pair(s)", "pkg:npm/lodash@4.17.21", `"stale": true`} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+}
+
+// assertMatchesSchema checks value against the types, required properties,
+// and enums declared in schema
+func assertMatchesSchema(t *testing.T, path string, schema *api.JSONSchema, value interface{}) {
+	t.Helper()
+	switch schema.Type {
+	case "object":
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			t.Errorf("%s: expected object, got %T", path, value)
+			return
+		}
+		for _, name := range schema.Required {
+			if _, ok := obj[name]; !ok {
+				t.Errorf("%s: missing required property %q", path, name)
+			}
+		}
+		for name, prop := range schema.Properties {
+			if v, ok := obj[name]; ok {
+				assertMatchesSchema(t, path+"."+name, prop, v)
+			}
+		}
+	case "array":
+		arr, ok := value.([]interface{})
+		if !ok {
+			t.Errorf("%s: expected array, got %T", path, value)
+			return
+		}
+		for i, item := range arr {
+			assertMatchesSchema(t, fmt.Sprintf("%s[%d]", path, i), schema.Items, item)
+		}
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			t.Errorf("%s: expected string, got %T", path, value)
+			return
+		}
+		if len(schema.Enum) > 0 && !containsString(schema.Enum, str) {
+			t.Errorf("%s: %q is not one of %v", path, str, schema.Enum)
+		}
+	case "integer":
+		if n, ok := value.(float64); !ok || n != float64(int64(n)) {
+			t.Errorf("%s: expected integer, got %v", path, value)
+		}
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func TestStructuredContent_MatchesOutputSchema(t *testing.T) {
+	client := vex.NewClient("test-author")
+	ctx := context.Background()
+
+	created, err := NewVEXCreateTool(client).Execute(ctx, map[string]interface{}{
+		"product":       "pkg:npm/lodash@4.17.21",
+		"vulnerability": "CVE-2023-1234",
+		"status":        "not_affected",
+		"justification": "component_not_present",
+	})
+	if err != nil || created.IsError {
+		t.Fatalf("create Execute() failed: %v %+v", err, created)
+	}
+
+	doc := func(id, vuln string) map[string]interface{} {
+		return map[string]interface{}{
+			"@context":  "https://openvex.dev/ns",
+			"@id":       id,
+			"author":    "author",
+			"version":   1,
+			"timestamp": "2023-01-01T00:00:00Z",
+			"statements": []interface{}{
+				map[string]interface{}{
+					"vulnerability": map[string]interface{}{"name": vuln},
+					"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+					"status":        "fixed",
+				},
+			},
+		}
+	}
+	merged, err := NewVEXMergeTool(client).Execute(ctx, map[string]interface{}{
+		"documents": []interface{}{doc("doc1", "CVE-2023-1234"), doc("doc2", "CVE-2023-5678")},
+	})
+	if err != nil || merged.IsError {
+		t.Fatalf("merge Execute() failed: %v %+v", err, merged)
+	}
+
+	tests := []struct {
+		name   string
+		tool   api.StructuredTool
+		result *api.ToolResult
+	}{
+		{name: "create", tool: NewVEXCreateTool(client), result: created},
+		{name: "merge", tool: NewVEXMergeTool(client), result: merged},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.result.StructuredContent == nil {
+				t.Fatal("Expected structured content")
+			}
+			// Round-trip through JSON as a client would see it
+			data, err := json.Marshal(tt.result.StructuredContent)
+			if err != nil {
+				t.Fatalf("Failed to marshal structured content: %v", err)
+			}
+			var structured interface{}
+			if err := json.Unmarshal(data, &structured); err != nil {
+				t.Fatalf("Failed to parse structured content: %v", err)
+			}
+			assertMatchesSchema(t, "$", tt.tool.OutputSchema(), structured)
+
+			// The text content carries the same document
+			if !strings.Contains(tt.result.Content[0].Text, structured.(map[string]interface{})["@id"].(string)) {
+				t.Error("Text content should contain the structured document")
+			}
+		})
+	}
+}
+
+func TestVEXPatchTools_RoundTrip(t *testing.T) {
+	client := vex.NewClient("test-author")
+	ctx := context.Background()
+
+	doc := func(status string) map[string]interface{} {
+		return map[string]interface{}{
+			"@context":  "https://openvex.dev/ns",
+			"@id":       "doc",
+			"author":    "team",
+			"version":   1,
+			"timestamp": "2023-01-01T00:00:00Z",
+			"statements": []interface{}{
+				map[string]interface{}{
+					"vulnerability": map[string]interface{}{"name": "CVE-2023-1234"},
+					"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+					"status":        status,
+				},
+			},
+		}
+	}
+	base, target := doc("under_investigation"), doc("fixed")
+
+	patched, err := NewVEXPatchTool(client).Execute(ctx, map[string]interface{}{"base": base, "target": target})
+	if err != nil || patched.IsError {
+		t.Fatalf("vex_patch Execute() failed: %v %+v", err, patched)
+	}
+	if !strings.Contains(patched.Content[0].Text, "0 added, 0 removed, and 1 modified") {
+		t.Errorf("Unexpected patch result: %v", patched.Content[0].Text)
+	}
+
+	// Feed the JSON patch from the text result back in, as a client would
+	text := patched.Content[0].Text
+	var patch map[string]interface{}
+	if err := json.Unmarshal([]byte(text[strings.Index(text, "{"):]), &patch); err != nil {
+		t.Fatalf("Failed to parse patch from result: %v", err)
+	}
+
+	applied, err := NewVEXApplyPatchTool(client).Execute(ctx, map[string]interface{}{"base": base, "patch": patch, "compact": true})
+	if err != nil || applied.IsError {
+		t.Fatalf("apply_vex_patch Execute() failed: %v %+v", err, applied)
+	}
+	if !strings.Contains(applied.Content[0].Text, `"status":"fixed"`) {
+		t.Errorf("Patched document should contain the target status, got %v", applied.Content[0].Text)
+	}
+}
+
+func TestVEXStatusHistogramTool_Execute(t *testing.T) {
+	tool := NewVEXStatusHistogramTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	statement := func(vuln, status string) map[string]interface{} {
+		return map[string]interface{}{
+			"vulnerability":    map[string]interface{}{"name": vuln},
+			"products":         []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+			"status":           status,
+			"action_statement": "Upgrade",
+		}
+	}
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"document": map[string]interface{}{
+			"@context": "https://openvex.dev/ns",
+			"statements": []interface{}{
+				statement("CVE-2023-0001", "affected"),
+				statement("CVE-2023-0002", "fixed"),
+				statement("CVE-2023-0003", "fixed"),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{"Counted 3 statement(s)", `"fixed": 2`, `"affected": 1`, `"has_affected": true`} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+}
+
+func TestVEXAliasConsistencyTool_Execute(t *testing.T) {
+	tool := NewVEXAliasConsistencyTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	statement := func(alias string) map[string]interface{} {
+		return map[string]interface{}{
+			"vulnerability": map[string]interface{}{"name": "CVE-2023-1234", "aliases": []interface{}{alias}},
+			"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+			"status":        "fixed",
+		}
+	}
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"document": map[string]interface{}{
+			"@context":   "https://openvex.dev/ns",
+			"statements": []interface{}{statement("GHSA-aaaa-bbbb-cccc"), statement("GHSA-xxxx-yyyy-zzzz")},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{"1 conflict(s)", "GHSA-xxxx-yyyy-zzzz", `"consistent": false`} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+}
+
+func TestOutputSizeLimit(t *testing.T) {
+	t.Run("oversized document", func(t *testing.T) {
+		tool := NewVEXCreateTool(vex.NewClient("test-author", vex.WithMaxOutputBytes(200)))
+		result, err := tool.Execute(context.Background(), map[string]interface{}{
+			"product":          "pkg:npm/lodash@4.17.21",
+			"vulnerability":    "CVE-2023-1234",
+			"status":           "affected",
+			"action_statement": strings.Repeat("Upgrade to the latest release. ", 10),
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if !result.IsError {
+			t.Fatal("Execute() should return error result for oversized output")
+		}
+		if !strings.Contains(result.Content[0].Text, "exceeds the maximum of 200 bytes") {
+			t.Errorf("Unexpected error: %v", result.Content[0].Text)
+		}
+	})
+
+	t.Run("oversized report", func(t *testing.T) {
+		tool := NewVEXStatementSchemaTool(vex.NewClient("test-author", vex.WithMaxOutputBytes(200)))
+		result, err := tool.Execute(context.Background(), map[string]interface{}{})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if !result.IsError || !strings.Contains(result.Content[0].Text, "exceeds the maximum of 200 bytes") {
+			t.Errorf("Execute() = %v, want size error", result.Content[0].Text)
+		}
+	})
+
+	t.Run("oversized value", func(t *testing.T) {
+		_, err := formatVEXDocument(map[string]interface{}{"data": strings.Repeat("x", 300)}, 200)
+		if err == nil || !strings.Contains(err.Error(), "exceeds the maximum of 200 bytes") {
+			t.Errorf("formatVEXDocument() error = %v, want size error", err)
+		}
+	})
+
+	t.Run("within limit", func(t *testing.T) {
+		if _, err := formatVEXDocument(map[string]interface{}{"data": "small"}, 200); err != nil {
+			t.Errorf("formatVEXDocument() error = %v", err)
+		}
+	})
+}
+
+func TestVEXScaffoldTool_Execute(t *testing.T) {
+	tool := NewVEXScaffoldTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	t.Run("one statement per vulnerability", func(t *testing.T) {
+		result, err := tool.Execute(ctx, map[string]interface{}{
+			"product":         "pkg:npm/lodash@4.17.21",
+			"vulnerabilities": []interface{}{"CVE-2023-0001", "CVE-2023-0002", "CVE-2023-0003"},
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if result.IsError {
+			t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+		}
+		text := result.Content[0].Text
+		if !strings.Contains(text, "scaffolded with 3 statement(s)") {
+			t.Errorf("Unexpected result: %v", text)
+		}
+		if got := strings.Count(text, `"under_investigation"`); got != 3 {
+			t.Errorf("Expected 3 under_investigation statements, got %d", got)
+		}
+	})
+
+	t.Run("missing vulnerabilities", func(t *testing.T) {
+		result, err := tool.Execute(ctx, map[string]interface{}{"product": "pkg:npm/lodash@4.17.21"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if !result.IsError {
+			t.Error("Execute() should return error result without vulnerabilities")
+		}
+	})
+}
+
+func TestVEXCanParseTool_Execute(t *testing.T) {
+	tool := NewVEXCanParseTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		args         map[string]interface{}
+		wantError    bool
+		wantContains []string
+	}{
+		{
+			name: "parseable document",
+			args: map[string]interface{}{
+				"document": `{"@context": "https://openvex.dev/ns/v0.2.0", "@id": "doc1", "author": "Security Team", "version": 1, "timestamp": "2023-01-01T00:00:00Z", "statements": [{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "fixed"}]}`,
+			},
+			wantContains: []string{"VEX document can be parsed", `"parseable": true`, `"stable": true`},
+		},
+		{
+			name: "unparseable document",
+			args: map[string]interface{}{
+				"document": `{"@context": "https://openvex.dev/ns", "statements": [}`,
+			},
+			wantContains: []string{"VEX document cannot be parsed", `"parseable": false`, `"offset": 55`, `"context"`},
+		},
+		{
+			name:         "document not text",
+			args:         map[string]interface{}{"document": map[string]interface{}{}},
+			wantError:    true,
+			wantContains: []string{"document must be a string of JSON text"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tool.Execute(ctx, tt.args)
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if result.IsError != tt.wantError {
+				t.Fatalf("Execute() IsError = %v, want %v: %v", result.IsError, tt.wantError, result.Content[0].Text)
+			}
+			for _, want := range tt.wantContains {
+				if !strings.Contains(result.Content[0].Text, want) {
+					t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+				}
+			}
+		})
+	}
+}
+
+func TestVEXFindDuplicatesTool_Execute(t *testing.T) {
+	tool := NewVEXFindDuplicatesTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	statement := func(vuln string) map[string]interface{} {
+		return map[string]interface{}{
+			"vulnerability": map[string]interface{}{"name": vuln},
+			"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+			"status":        "fixed",
+		}
+	}
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"document": map[string]interface{}{
+			"@context": "https://openvex.dev/ns",
+			"statements": []interface{}{
+				statement("CVE-2023-0001"),
+				statement("CVE-2023-0002"),
+				statement("CVE-2023-0001"),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{"Found 1 group(s) of duplicate statements", `"has_duplicates": true`, "0,\n      2"} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+}
+
+func TestVEXCheckActionsTool_Execute(t *testing.T) {
+	tool := NewVEXCheckActionsTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	statement := func(vuln, action string) map[string]interface{} {
+		stmt := map[string]interface{}{
+			"vulnerability": map[string]interface{}{"name": vuln},
+			"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+			"status":        "affected",
+		}
+		if action != "" {
+			stmt["action_statement"] = action
+		}
+		return stmt
+	}
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"document": map[string]interface{}{
+			"@context": "https://openvex.dev/ns",
+			"statements": []interface{}{
+				statement("CVE-2023-0001", "Upgrade"),
+				statement("CVE-2023-0002", ""),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{"1 of 2 affected statement(s) lack an action statement", `"complete": false`, `"missing": [`} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+}
+
+func TestVEXFlattenSubcomponentsTool_Execute(t *testing.T) {
+	tool := NewVEXFlattenSubcomponentsTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"document": map[string]interface{}{
+			"@context": "https://openvex.dev/ns",
+			"statements": []interface{}{
+				map[string]interface{}{
+					"vulnerability": map[string]interface{}{"name": "CVE-2023-1234"},
+					"products": []interface{}{
+						map[string]interface{}{
+							"@id":           "pkg:oci/app@sha256:abc",
+							"subcomponents": []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}, map[string]interface{}{"@id": "pkg:npm/react@17.0.0"}},
+						},
+					},
+					"status": "fixed",
+				},
+			},
+		},
+		"mode": "statements",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	text := result.Content[0].Text
+	for _, want := range []string{"flattened into 2 statement(s)", `"@id": "pkg:npm/lodash@4.17.21"`, `"@id": "pkg:npm/react@17.0.0"`} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Result should contain %q, got %v", want, text)
+		}
+	}
+	if _, output, _ := strings.Cut(text, "\n\n"); strings.Contains(output, "subcomponents") || strings.Contains(output, "pkg:oci/app") {
+		t.Errorf("Result should not keep the parent product or subcomponents, got %v", output)
+	}
+}
+
+func TestVEXHashStatementsTool_Execute(t *testing.T) {
+	tool := NewVEXHashStatementsTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"document": map[string]interface{}{
+			"@context": "https://openvex.dev/ns",
+			"statements": []interface{}{
+				map[string]interface{}{
+					"vulnerability": map[string]interface{}{"name": "CVE-2023-1234"},
+					"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+					"status":        "fixed",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{"Hashed 1 statement(s)", `"vulnerability": "CVE-2023-1234"`, `"hash": "sha256:`} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+}
+
+func TestVEXNormalizeIDsTool_Execute(t *testing.T) {
+	tool := NewVEXNormalizeIDsTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"document": map[string]interface{}{
+			"@context": "https://openvex.dev/ns",
+			"statements": []interface{}{
+				map[string]interface{}{
+					"vulnerability": map[string]interface{}{"name": "cve-2023-1234", "aliases": []interface{}{"OSV-2023-1"}},
+					"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+					"status":        "fixed",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{"1 changed", `"name": "CVE-2023-1234"`, `"OSV-2023-1"`} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+}
+
+func TestVEXCheckBudgetTool_Execute(t *testing.T) {
+	tool := NewVEXCheckBudgetTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	statement := func(vuln string) map[string]interface{} {
+		return map[string]interface{}{
+			"vulnerability": map[string]interface{}{"name": vuln},
+			"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+			"status":        "fixed",
+		}
+	}
+	doc := map[string]interface{}{
+		"@context":   "https://openvex.dev/ns",
+		"statements": []interface{}{statement("CVE-2023-0001"), statement("CVE-2023-0002")},
+	}
+
+	result, err := tool.Execute(ctx, map[string]interface{}{"document": doc, "max": float64(1)})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{"exceeds budget by 1 statement(s)", `"within_budget": false`, `"excess": 1`} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+
+	result, err = tool.Execute(ctx, map[string]interface{}{"document": doc, "max": 1.5})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !result.IsError || !strings.Contains(result.Content[0].Text, "max must be an integer") {
+		t.Errorf("Expected integer error, got %v", result.Content[0].Text)
+	}
+}
+
+func TestVEXGetStatementTool_Execute(t *testing.T) {
+	tool := NewVEXGetStatementTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	doc := map[string]interface{}{
+		"@context": "https://openvex.dev/ns",
+		"statements": []interface{}{
+			map[string]interface{}{
+				"vulnerability": map[string]interface{}{"name": "CVE-2023-0001"},
+				"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+				"status":        "fixed",
+				"cvss":          map[string]interface{}{"score": 7.5},
+			},
+		},
+	}
+
+	result, err := tool.Execute(ctx, map[string]interface{}{"document": doc, "index": float64(0)})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{"Statement 0:", `"name": "CVE-2023-0001"`, `"status": "fixed"`, `"score": 7.5`} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+
+	result, err = tool.Execute(ctx, map[string]interface{}{"document": doc, "index": float64(3)})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !result.IsError || !strings.Contains(result.Content[0].Text, "statement index 3 out of range") {
+		t.Errorf("Expected out-of-range error, got %v", result.Content[0].Text)
+	}
+}
+
+func TestVEXReplaceStatementTool_Execute(t *testing.T) {
+	tool := NewVEXReplaceStatementTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	statement := func(vuln string) map[string]interface{} {
+		return map[string]interface{}{
+			"vulnerability": map[string]interface{}{"name": vuln},
+			"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+			"status":        "under_investigation",
+		}
+	}
+	doc := map[string]interface{}{
+		"@context": "https://openvex.dev/ns",
+		"statements": []interface{}{
+			statement("CVE-2023-0001"),
+			statement("CVE-2023-0002"),
+			statement("CVE-2023-0003"),
+		},
+	}
+
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"document":         doc,
+		"index":            float64(1),
+		"product":          "pkg:npm/lodash@4.17.21",
+		"vulnerability":    "CVE-2023-0002",
+		"status":           "affected",
+		"action_statement": "Upgrade to 4.17.22",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{"Statement 1 replaced successfully", `"action_statement": "Upgrade to 4.17.22"`, `"last_updated"`} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+
+	result, err = tool.Execute(ctx, map[string]interface{}{
+		"document":      doc,
+		"index":         float64(5),
+		"product":       "pkg:npm/lodash@4.17.21",
+		"vulnerability": "CVE-2023-0002",
+		"status":        "fixed",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !result.IsError || !strings.Contains(result.Content[0].Text, "statement index 5 out of range") {
+		t.Errorf("Expected out-of-range error, got %v", result.Content[0].Text)
+	}
+}
+
+func TestVEXRemoveStatementTool_Execute(t *testing.T) {
+	tool := NewVEXRemoveStatementTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	statement := func(vuln string) map[string]interface{} {
+		return map[string]interface{}{
+			"vulnerability": map[string]interface{}{"name": vuln},
+			"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+			"status":        "fixed",
+		}
+	}
+	doc := map[string]interface{}{
+		"@context":   "https://openvex.dev/ns",
+		"statements": []interface{}{statement("CVE-2023-0001"), statement("CVE-2023-0002")},
+	}
+
+	tests := []struct {
+		name     string
+		args     map[string]interface{}
+		wantErr  bool
+		contains string
+	}{
+		{
+			name:     "by index",
+			args:     map[string]interface{}{"document": doc, "index": float64(1)},
+			contains: "1 removed",
+		},
+		{
+			name:     "by key",
+			args:     map[string]interface{}{"document": doc, "vulnerability": "CVE-2023-0001", "product": "pkg:npm/lodash@4.17.21"},
+			contains: "1 removed",
+		},
+		{
+			name:     "no match",
+			args:     map[string]interface{}{"document": doc, "vulnerability": "CVE-2023-0003", "product": "pkg:npm/lodash@4.17.21"},
+			wantErr:  true,
+			contains: "no statement for CVE-2023-0003",
+		},
+		{
+			name:     "out of range",
+			args:     map[string]interface{}{"document": doc, "index": float64(2)},
+			wantErr:  true,
+			contains: "statement index 2 out of range",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tool.Execute(ctx, tt.args)
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if result.IsError != tt.wantErr {
+				t.Fatalf("Execute() IsError = %v, want %v: %v", result.IsError, tt.wantErr, result.Content[0].Text)
+			}
+			if !strings.Contains(result.Content[0].Text, tt.contains) {
+				t.Errorf("Result should contain %q, got %v", tt.contains, result.Content[0].Text)
+			}
+		})
+	}
+}
+
+func TestVEXAutofixTool_Execute(t *testing.T) {
+	tool := NewVEXAutofixTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"document": map[string]interface{}{
+			"@context": "https://openvex.dev/ns",
+			"statements": []interface{}{
+				map[string]interface{}{
+					"vulnerability": map[string]interface{}{"name": "cve-2023-0001"},
+					"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21 "}},
+					"status":        "not-affected",
+					"justification": "not_reachable",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{
+		"4 fix(es) applied",
+		`- statements[0].status: "not-affected" -> "not_affected"`,
+		`- statements[0].justification: "not_reachable" -> "vulnerable_code_not_in_execute_path"`,
+		`"name": "CVE-2023-0001"`,
+	} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+}
+
+func TestTools_Execute_MissingArguments(t *testing.T) {
+	client := vex.NewClient("test-author")
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		tool         api.Tool
+		wantContains string
+	}{
+		{name: "create", tool: NewVEXCreateTool(client), wantContains: "product is required"},
+		{name: "merge", tool: NewVEXMergeTool(client), wantContains: "documents field is required"},
+		{name: "validate", tool: NewVEXValidateTool(client), wantContains: "document field is required"},
+		{name: "get statement", tool: NewVEXGetStatementTool(client), wantContains: "document field is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, args := range []map[string]interface{}{nil, {}} {
+				result, err := tt.tool.Execute(ctx, args)
+				if err != nil {
+					t.Fatalf("Execute(%v) error = %v", args, err)
+				}
+				if !result.IsError {
+					t.Fatalf("Execute(%v) should return an error result", args)
+				}
+				if !strings.Contains(result.Content[0].Text, tt.wantContains) {
+					t.Errorf("Execute(%v) = %v, want to contain %q", args, result.Content[0].Text, tt.wantContains)
+				}
+			}
+		})
+	}
+}
+
+func TestVEXMatrixTool_Execute(t *testing.T) {
+	tool := NewVEXMatrixTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"document": map[string]interface{}{
+			"@context": "https://openvex.dev/ns",
+			"statements": []interface{}{
+				map[string]interface{}{
+					"vulnerability": map[string]interface{}{"name": "CVE-2023-0001"},
+					"products": []interface{}{
+						map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"},
+						map[string]interface{}{"@id": "pkg:npm/express@4.18.0"},
+					},
+					"status": "affected",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{"2 product(s) by 1 vulnerability(ies)", `"pkg:npm/express@4.18.0": {`, `"CVE-2023-0001": "affected"`} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+}
+
+func TestVEXMergeMixedTool_Execute(t *testing.T) {
+	tool := NewVEXMergeMixedTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	openVEX := map[string]interface{}{
+		"@context":  "https://openvex.dev/ns",
+		"@id":       "https://example.com/vex/1",
+		"author":    "test-author",
+		"timestamp": "2023-01-01T00:00:00Z",
+		"version":   1,
+		"statements": []interface{}{
+			map[string]interface{}{
+				"vulnerability": map[string]interface{}{"name": "CVE-2023-1000"},
+				"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+				"status":        "fixed",
+			},
+		},
+	}
+	csaf := map[string]interface{}{
+		"document": map[string]interface{}{
+			"csaf_version": "2.0",
+			"tracking":     map[string]interface{}{"id": "EXAMPLE-2023-0001"},
+		},
+		"product_tree": map[string]interface{}{
+			"branches": []interface{}{
+				map[string]interface{}{
+					"product": map[string]interface{}{
+						"product_id":                    "LODASH",
+						"product_identification_helper": map[string]interface{}{"purl": "pkg:npm/lodash@4.17.21"},
+					},
+				},
+			},
+		},
+		"vulnerabilities": []interface{}{
+			map[string]interface{}{
+				"cve":            "CVE-2023-2000",
+				"product_status": map[string]interface{}{"under_investigation": []interface{}{"LODASH"}},
+			},
+		},
+	}
+
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"documents": []interface{}{openVEX, csaf},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{
+		"1 converted",
+		"- document 2 converted from csaf",
+		`"name": "CVE-2023-1000"`,
+		`"name": "CVE-2023-2000"`,
+		`"status": "under_investigation"`,
+	} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+}
+
+func TestVEXCheckHashesTool_Execute(t *testing.T) {
+	tool := NewVEXCheckHashesTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"document": map[string]interface{}{
+			"@context": "https://openvex.dev/ns",
+			"statements": []interface{}{
+				map[string]interface{}{
+					"vulnerability": map[string]interface{}{"name": "CVE-2023-0001"},
+					"products": []interface{}{
+						map[string]interface{}{
+							"@id":    "pkg:oci/app",
+							"hashes": map[string]interface{}{"sha-256": strings.Repeat("a", 64), "sha1": "abc"},
+						},
+					},
+					"status": "fixed",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{"1 of 2 product hash(es) are malformed", `"algorithm": "sha1"`, "value is not hexadecimal"} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+}
+
+func TestVEXCreateTool_Execute_GeneratorMetadata(t *testing.T) {
+	tool := NewVEXCreateTool(vex.NewClient("test-author", vex.WithGenerator("vexdoc-mcp-server", "1.2.3")))
+	args := map[string]interface{}{
+		"product":       "pkg:npm/lodash@4.17.21",
+		"vulnerability": "CVE-2023-1234",
+		"status":        "fixed",
+	}
+
+	result, err := tool.Execute(context.Background(), args)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if strings.Contains(result.Content[0].Text, "_generator") {
+		t.Errorf("Generator block should be opt-in, got %v", result.Content[0].Text)
+	}
+
+	args["include_generator_metadata"] = true
+	result, err = tool.Execute(context.Background(), args)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{`"_generator": {`, `"name": "vexdoc-mcp-server"`, `"version": "1.2.3"`, `"generated_at": "`} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+	structured, _ := result.StructuredContent.(map[string]interface{})
+	if structured[vex.GeneratorExtension] == nil {
+		t.Errorf("Structured content should carry the generator block, got %v", result.StructuredContent)
+	}
+}
+
+func TestVEXCheckInvestigationsTool_Execute(t *testing.T) {
+	tool := NewVEXCheckInvestigationsTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	statement := func(vuln, status string) map[string]interface{} {
+		return map[string]interface{}{
+			"vulnerability": map[string]interface{}{"name": vuln},
+			"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+			"status":        status,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		statements []interface{}
+		want       []string
+	}{
+		{
+			name:       "open investigation",
+			statements: []interface{}{statement("CVE-2023-0001", "fixed"), statement("CVE-2023-0002", "under_investigation")},
+			want:       []string{"FAIL: 1 statement(s)", `"pass": false`, `"vulnerability": "CVE-2023-0002"`},
+		},
+		{
+			name:       "all resolved",
+			statements: []interface{}{statement("CVE-2023-0001", "fixed")},
+			want:       []string{"PASS", `"pass": true`, `"open": []`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tool.Execute(ctx, map[string]interface{}{
+				"document": map[string]interface{}{
+					"@context":   "https://openvex.dev/ns",
+					"statements": tt.statements,
+				},
+			})
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if result.IsError {
+				t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(result.Content[0].Text, want) {
+					t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+				}
+			}
+		})
+	}
+}
+
+func TestVEXReportMarkdownTool_Execute(t *testing.T) {
+	tool := NewVEXReportMarkdownTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"documents": []interface{}{
+			map[string]interface{}{
+				"@context": "https://openvex.dev/ns",
+				"statements": []interface{}{
+					map[string]interface{}{
+						"vulnerability":    map[string]interface{}{"name": "CVE-2023-0001"},
+						"products":         []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+						"status":           "affected",
+						"action_statement": "Upgrade to 4.17.22",
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{"# VEX Report", "| affected | 1 |", "### CVE-2023-0001", "- Remediation: Upgrade to 4.17.22", "## Not Affected Justifications"} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+}
+
+func TestVEXPatchMetadataTool_Execute(t *testing.T) {
+	tool := NewVEXPatchMetadataTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	doc := map[string]interface{}{
+		"@context": "https://openvex.dev/ns",
+		"@id":      "https://example.com/vex/1",
+		"author":   "old-author",
+		"statements": []interface{}{
+			map[string]interface{}{
+				"vulnerability": map[string]interface{}{"name": "CVE-2023-0001"},
+				"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+				"status":        "fixed",
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		args     map[string]interface{}
+		wantErr  bool
+		contains []string
+	}{
+		{
+			name: "author and id",
+			args: map[string]interface{}{
+				"document": doc,
+				"patch":    map[string]interface{}{"author": "new-author", "@id": "https://example.com/vex/2"},
+			},
+			contains: []string{"VEX metadata patched", `"author": "new-author"`, `"@id": "https://example.com/vex/2"`, "CVE-2023-0001"},
+		},
+		{
+			name:     "statements",
+			args:     map[string]interface{}{"document": doc, "patch": map[string]interface{}{"statements": []interface{}{}}},
+			wantErr:  true,
+			contains: []string{"cannot modify statements"},
+		},
+		{
+			name:     "missing patch",
+			args:     map[string]interface{}{"document": doc},
+			wantErr:  true,
+			contains: []string{"patch field is required"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tool.Execute(ctx, tt.args)
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if result.IsError != tt.wantErr {
+				t.Fatalf("Execute() IsError = %v, want %v: %v", result.IsError, tt.wantErr, result.Content[0].Text)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(result.Content[0].Text, want) {
+					t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+				}
+			}
+		})
+	}
+}
+
+func TestVEXReauthorTool_Execute(t *testing.T) {
+	tool := NewVEXReauthorTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	doc := map[string]interface{}{
+		"@context": "https://openvex.dev/ns",
+		"author":   "old-team",
+		"statements": []interface{}{
+			map[string]interface{}{
+				"vulnerability": map[string]interface{}{"name": "CVE-2023-0001"},
+				"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+				"status":        "fixed",
+				"author":        "old-team",
+			},
+		},
+	}
+
+	result, err := tool.Execute(ctx, map[string]interface{}{"document": doc, "author": "new-team", "author_role": "Security"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error: %v", result.Content[0].Text)
+	}
+	text := result.Content[0].Text
+	for _, want := range []string{"2 location(s) updated", `"author": "new-team"`, `"role": "Security"`} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Result should contain %q, got %v", want, text)
+		}
+	}
+	if strings.Contains(text, "old-team") {
+		t.Errorf("Result still contains the previous author: %v", text)
+	}
+
+	result, err = tool.Execute(ctx, map[string]interface{}{"document": doc})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !result.IsError || !strings.Contains(result.Content[0].Text, "author is required") {
+		t.Errorf("Expected missing author error, got %v", result.Content[0].Text)
+	}
+}
+
+func TestVEXCheckUniqueIDsTool_Execute(t *testing.T) {
+	tool := NewVEXCheckUniqueIDsTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	document := func(id string) interface{} {
+		return map[string]interface{}{
+			"@context":   "https://openvex.dev/ns",
+			"@id":        id,
+			"statements": []interface{}{},
+		}
+	}
+
+	tests := []struct {
+		name string
+		docs []interface{}
+		want []string
+	}{
+		{
+			name: "duplicate ids",
+			docs: []interface{}{document("doc-a"), document("doc-b"), document("doc-a")},
+			want: []string{"FAIL: 1 @id value(s)", `"unique": false`, `"id": "doc-a"`, "1,\n        3"},
+		},
+		{
+			name: "unique ids",
+			docs: []interface{}{document("doc-a"), document("doc-b")},
+			want: []string{"PASS", `"unique": true`, `"duplicates": []`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tool.Execute(ctx, map[string]interface{}{"documents": tt.docs})
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if result.IsError {
+				t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(result.Content[0].Text, want) {
+					t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+				}
+			}
+		})
+	}
+}
+
+func TestVEXCheckPublishTool_Execute(t *testing.T) {
+	tool := NewVEXCheckPublishTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	document := func(status string) map[string]interface{} {
+		return map[string]interface{}{
+			"@context":  "https://openvex.dev/ns",
+			"author":    "security-team",
+			"timestamp": "2023-01-01T00:00:00Z",
+			"statements": []interface{}{
+				map[string]interface{}{
+					"vulnerability": map[string]interface{}{"name": "CVE-2023-0001"},
+					"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+					"status":        status,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name string
+		args map[string]interface{}
+		want []string
+	}{
+		{
+			name: "ready",
+			args: map[string]interface{}{"document": document("fixed")},
+			want: []string{"PASS", `"ready": true`, `"issues": []`},
+		},
+		{
+			name: "not ready",
+			args: map[string]interface{}{"document": document("under_investigation")},
+			want: []string{"FAIL: 1 issue(s)", `"ready": false`, "still under_investigation"},
+		},
+		{
+			name: "investigations allowed",
+			args: map[string]interface{}{"document": document("under_investigation"), "allow_under_investigation": true},
+			want: []string{"PASS", `"ready": true`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tool.Execute(ctx, tt.args)
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if result.IsError {
+				t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(result.Content[0].Text, want) {
+					t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+				}
+			}
+		})
+	}
+}
+
+func TestVEXSortProductsTool_Execute(t *testing.T) {
+	client := vex.NewClient("test-author")
+	ctx := context.Background()
+
+	document := map[string]interface{}{
+		"@context": "https://openvex.dev/ns",
+		"statements": []interface{}{
+			map[string]interface{}{
+				"vulnerability": map[string]interface{}{"name": "cve-2023-0001"},
+				"products": []interface{}{
+					map[string]interface{}{"@id": "pkg:npm/zod@3.0.0"},
+					map[string]interface{}{"@id": "pkg:npm/axios@1.0.0"},
+				},
+				"status": "fixed",
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+		tool api.Tool
+		args map[string]interface{}
+		want string
+	}{
+		{
+			name: "standalone",
+			tool: NewVEXSortProductsTool(client),
+			args: map[string]interface{}{"document": document},
+			want: "1 statement(s) reordered",
+		},
+		{
+			name: "normalize with sort_products",
+			tool: NewVEXNormalizeIDsTool(client),
+			args: map[string]interface{}{"document": document, "sort_products": true},
+			want: "1 changed, 1 statement(s) with products reordered",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.tool.Execute(ctx, tt.args)
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if result.IsError {
+				t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+			}
+			text := result.Content[0].Text
+			if !strings.Contains(text, tt.want) {
+				t.Errorf("Result should contain %q, got %v", tt.want, text)
+			}
+			if strings.Index(text, "pkg:npm/axios@1.0.0") > strings.Index(text, "pkg:npm/zod@3.0.0") {
+				t.Errorf("Products should be sorted by identifier, got %v", text)
+			}
+		})
+	}
+}
+
+func TestVEXSplitStatusTool_Execute(t *testing.T) {
+	tool := NewVEXSplitStatusTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	statement := func(vuln, status string) map[string]interface{} {
+		stmt := map[string]interface{}{
+			"vulnerability": map[string]interface{}{"name": vuln},
+			"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+			"status":        status,
+		}
+		if status == "affected" {
+			stmt["action_statement"] = "Upgrade"
+		}
+		return stmt
+	}
+
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"document": map[string]interface{}{
+			"@context": "https://openvex.dev/ns",
+			"@id":      "merged",
+			"statements": []interface{}{
+				statement("CVE-2023-0001", "fixed"),
+				statement("CVE-2023-0002", "affected"),
+				statement("CVE-2023-0003", "fixed"),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	if len(result.Content) != 3 {
+		t.Fatalf("Expected a summary and 2 documents, got %d content items", len(result.Content))
+	}
+	if want := "split into 2 document(s) by status: affected, fixed"; !strings.Contains(result.Content[0].Text, want) {
+		t.Errorf("Summary should contain %q, got %v", want, result.Content[0].Text)
+	}
+
+	affected, fixed := result.Content[1].Text, result.Content[2].Text
+	for _, want := range []string{"Status affected, 1 statement(s)", `"@id": "merged-affected"`, "CVE-2023-0002"} {
+		if !strings.Contains(affected, want) {
+			t.Errorf("Affected document should contain %q, got %v", want, affected)
+		}
+	}
+	for _, want := range []string{"Status fixed, 2 statement(s)", "CVE-2023-0001", "CVE-2023-0003"} {
+		if !strings.Contains(fixed, want) {
+			t.Errorf("Fixed document should contain %q, got %v", want, fixed)
+		}
+	}
+	if strings.Contains(affected, "CVE-2023-0001") || strings.Contains(fixed, "CVE-2023-0002") {
+		t.Error("Statements should be partitioned by status")
+	}
+}
+
+func TestVEXStatementSchemaTool_Execute(t *testing.T) {
+	tool := NewVEXStatementSchemaTool(vex.NewClient("test-author"))
+
+	result, err := tool.Execute(context.Background(), map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+
+	text := result.Content[0].Text
+	var schema api.JSONSchema
+	if err := json.Unmarshal([]byte(text[strings.Index(text, "{"):]), &schema); err != nil {
+		t.Fatalf("Result should contain a JSON schema: %v", err)
+	}
+	status := schema.Properties["status"]
+	if status == nil {
+		t.Fatal("Schema should describe the status field")
+	}
+	for _, want := range statusValues {
+		if !containsString(status.Enum, want) {
+			t.Errorf("Status enum should contain %q, got %v", want, status.Enum)
+		}
+	}
+	if schema.Properties["justification"] == nil || len(schema.Properties["justification"].Enum) != len(justificationValues) {
+		t.Errorf("Schema should carry the justification enum, got %v", schema.Properties["justification"])
+	}
+	if _, ok := schema.Properties["author"]; ok {
+		t.Error("Schema should not include document-level fields")
+	}
+
+	// The statement fields must match what create_vex_statement accepts
+	create := NewVEXCreateTool(vex.NewClient("test-author")).InputSchema()
+	for name := range schema.Properties {
+		if _, ok := create.Properties[name]; !ok {
+			t.Errorf("Statement field %q is not accepted by create_vex_statement", name)
+		}
+	}
+}
+
+func TestVEXCheckJustificationTool_Execute(t *testing.T) {
+	tool := NewVEXCheckJustificationTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	statement := func(vuln, status, justification string) map[string]interface{} {
+		stmt := map[string]interface{}{
+			"vulnerability": map[string]interface{}{"name": vuln},
+			"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+			"status":        status,
+		}
+		if justification != "" {
+			stmt["justification"] = justification
+		}
+		return stmt
+	}
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"document": map[string]interface{}{
+			"@context": "https://openvex.dev/ns",
+			"statements": []interface{}{
+				statement("CVE-2023-0001", "not_affected", "component_not_present"),
+				statement("CVE-2023-0002", "affected", "component_not_present"),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{"FAIL: 1 statement(s)", `"index": 1`, `"status": "affected"`, "only valid with status not_affected"} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+}
+
+func TestVEXCheckUniformStatusTool_Execute(t *testing.T) {
+	tool := NewVEXCheckUniformStatusTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	statement := func(product, status string) map[string]interface{} {
+		return map[string]interface{}{
+			"vulnerability":    map[string]interface{}{"name": "CVE-2023-0001"},
+			"products":         []interface{}{map[string]interface{}{"@id": product}},
+			"status":           status,
+			"action_statement": "Upgrade",
+		}
+	}
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"document": map[string]interface{}{
+			"@context": "https://openvex.dev/ns",
+			"statements": []interface{}{
+				statement("pkg:npm/a@1.0.0", "fixed"),
+				statement("pkg:npm/b@1.0.0", "affected"),
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{"FAIL: 1 vulnerability(ies)", `"uniform": false`, `"vulnerability": "CVE-2023-0001"`, `"affected"`} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+}
+
+func TestVEXMergeTool_Execute_SourceHashes(t *testing.T) {
+	tool := NewVEXMergeTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	document := func(id, vuln string) map[string]interface{} {
+		return map[string]interface{}{
+			"@context":  "https://openvex.dev/ns",
+			"@id":       id,
+			"author":    "author",
+			"version":   1,
+			"timestamp": "2023-01-01T00:00:00Z",
+			"statements": []interface{}{
+				map[string]interface{}{
+					"vulnerability": map[string]interface{}{"name": vuln},
+					"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+					"status":        "fixed",
+				},
+			},
+		}
+	}
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"documents":             []interface{}{document("doc1", "CVE-2023-0001"), document("doc2", "CVE-2023-0002")},
+		"include_source_hashes": true,
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	text := result.Content[0].Text
+	for _, want := range []string{`"source_hashes": [`, `"id": "doc1"`, `"id": "doc2"`, `"hash": "sha256:`} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Result should contain %q, got %v", want, text)
+		}
+	}
+}
+
+func TestVEXCheckMonotonicityTool_Execute(t *testing.T) {
+	tool := NewVEXCheckMonotonicityTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"document": map[string]interface{}{
+			"@context":  "https://openvex.dev/ns",
+			"timestamp": "2023-02-01T00:00:00Z",
+			"statements": []interface{}{
+				map[string]interface{}{
+					"vulnerability": map[string]interface{}{"name": "CVE-2023-0001"},
+					"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+					"status":        "fixed",
+					"timestamp":     "2023-01-15T00:00:00Z",
+					"last_updated":  "2023-01-01T00:00:00Z",
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{"FAIL: 1 timestamp(s)", `"location": "statements[0].last_updated"`, "precedes the statement timestamp"} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+}
+
+func TestToolTitles(t *testing.T) {
+	client := vex.NewClient("test-author")
+
+	for _, tool := range []api.Tool{NewVEXCreateTool(client), NewVEXMergeTool(client)} {
+		titled, ok := tool.(api.TitledTool)
+		if !ok || titled.Title() == "" {
+			t.Errorf("%s should have a display title", tool.Name())
+		}
+	}
+}
+
+func TestVEXImportScannerTool_Execute(t *testing.T) {
+	tool := NewVEXImportScannerTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	report := map[string]interface{}{
+		"SchemaVersion": 2,
+		"Results": []interface{}{
+			map[string]interface{}{
+				"Target": "package-lock.json",
+				"Type":   "npm",
+				"Vulnerabilities": []interface{}{
+					map[string]interface{}{
+						"VulnerabilityID":  "CVE-2023-0001",
+						"PkgName":          "lodash",
+						"InstalledVersion": "4.17.20",
+						"PkgIdentifier":    map[string]interface{}{"PURL": "pkg:npm/lodash@4.17.20"},
+					},
+				},
+			},
+		},
+	}
+
+	result, err := tool.Execute(ctx, map[string]interface{}{"report": report})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	for _, want := range []string{"imported with 1 statement(s), 0 finding(s)", `"name": "CVE-2023-0001"`, `"@id": "pkg:npm/lodash@4.17.20"`, `"status": "under_investigation"`} {
+		if !strings.Contains(result.Content[0].Text, want) {
+			t.Errorf("Result should contain %q, got %v", want, result.Content[0].Text)
+		}
+	}
+
+	result, err = tool.Execute(ctx, map[string]interface{}{"report": report, "status": "affected"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !result.IsError || !strings.Contains(result.Content[0].Text, "invalid status") {
+		t.Errorf("Expected invalid status error, got %v", result.Content[0].Text)
+	}
+}
+
+func TestVEXCheckSBOMTool_Execute(t *testing.T) {
+	tool := NewVEXCheckSBOMTool(vex.NewClient("test-author"))
+	ctx := context.Background()
+
+	document := map[string]interface{}{
+		"@context": "https://openvex.dev/ns",
+		"statements": []interface{}{
+			map[string]interface{}{
+				"vulnerability": map[string]interface{}{"name": "CVE-2023-0001"},
+				"products": []interface{}{
+					map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"},
+					map[string]interface{}{"@id": "pkg:npm/left-pad@1.3.0"},
+				},
+				"status": "fixed",
+			},
+		},
+	}
+	result, err := tool.Execute(ctx, map[string]interface{}{
+		"document":   document,
+		"components": []interface{}{"pkg:npm/lodash@4.17.21"},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if result.IsError {
+		t.Fatalf("Execute() returned error result: %v", result.Content[0].Text)
+	}
+	text := result.Content[0].Text
+	for _, want := range []string{"FAIL: 1 statement(s)", `"covered": false`, "pkg:npm/left-pad@1.3.0"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Result should contain %q, got %v", want, text)
+		}
+	}
+	if strings.Contains(text[strings.Index(text, "orphans"):], "lodash") {
+		t.Errorf("Matched products should not be reported, got %v", text)
+	}
+
+	result, err = tool.Execute(ctx, map[string]interface{}{"document": document})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !result.IsError {
+		t.Error("Expected an error result without components")
+	}
+}
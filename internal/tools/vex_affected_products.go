@@ -0,0 +1,84 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXAffectedProductsTool implements the affected_products_for_vulnerability MCP tool
+type VEXAffectedProductsTool struct {
+	client *vex.Client
+}
+
+// NewVEXAffectedProductsTool creates a new VEX affected products tool
+func NewVEXAffectedProductsTool(client *vex.Client) *VEXAffectedProductsTool {
+	return &VEXAffectedProductsTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXAffectedProductsTool) Name() string {
+	return "affected_products_for_vulnerability"
+}
+
+// Description returns the tool description
+func (t *VEXAffectedProductsTool) Description() string {
+	return "Find which products are affected by a vulnerability across VEX documents, e.g. during incident response. Returns every product whose statement marks it affected, with the source document and action statement. Supply alias_map to match GHSA or other aliases against a canonical CVE ID."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXAffectedProductsTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"documents": {
+				Type:        "array",
+				Description: fmt.Sprintf("OpenVEX documents to search (1-%d documents).", vex.MaxMergeDocuments),
+				Items: &api.JSONSchema{
+					Type:        "object",
+					Description: "Complete OpenVEX document",
+				},
+			},
+			"vulnerability": {
+				Type:        "string",
+				Description: "Vulnerability identifier to look up (e.g., CVE-2023-1234).",
+				Examples:    []interface{}{"CVE-2023-1234"},
+			},
+			"alias_map": {
+				Type:                 "object",
+				Description:          "Maps alias identifiers to a canonical identifier (e.g., {\"GHSA-jfh8-c2jp-5v3q\": \"CVE-2023-1234\"}). Statement names and aliases are resolved through it before matching.",
+				AdditionalProperties: &api.JSONSchema{Type: "string"},
+			},
+		},
+		Required: []string{"documents", "vulnerability"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXAffectedProductsTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	docs, err := parseDocumentsArg(args, "documents")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	vulnerability, ok := args["vulnerability"].(string)
+	if !ok {
+		return errorResult("Error: vulnerability is required and must be a string"), nil
+	}
+	aliasMap, err := parseStringMapArg(args, "alias_map")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	affected, err := t.client.AffectedProducts(&vex.AffectedInput{
+		Documents:     docs,
+		Vulnerability: vulnerability,
+		AliasMap:      aliasMap,
+	})
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	return jsonResult(fmt.Sprintf("Found %d affected product(s) for %s:", len(affected), vulnerability), affected, t.client.MaxOutputBytes()), nil
+}
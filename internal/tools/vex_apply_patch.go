@@ -0,0 +1,83 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXApplyPatchTool implements the apply_vex_patch MCP tool
+type VEXApplyPatchTool struct {
+	client *vex.Client
+}
+
+// NewVEXApplyPatchTool creates a new VEX apply patch tool
+func NewVEXApplyPatchTool(client *vex.Client) *VEXApplyPatchTool {
+	return &VEXApplyPatchTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXApplyPatchTool) Name() string {
+	return "apply_vex_patch"
+}
+
+// Description returns the tool description
+func (t *VEXApplyPatchTool) Description() string {
+	return "Apply a patch produced by vex_patch to a base VEX document and return the patched document. Removed and modified pairs must exist in the base and added pairs must not. The patched document has one statement per product."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXApplyPatchTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: addOutputProperties(map[string]*api.JSONSchema{
+			"base": {
+				Type:        "object",
+				Description: "OpenVEX document to apply the patch to.",
+			},
+			"patch": {
+				Type:        "object",
+				Description: "Patch returned by vex_patch, with added, removed, and modified entries and optional metadata.",
+			},
+		}),
+		Required: []string{"base", "patch"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXApplyPatchTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	base, err := parseDocumentArg(args, "base")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	patch, err := parseDocumentArg(args, "patch")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	doc, err := t.client.ApplyPatch(base, patch)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	// Format output as JSON
+	opts, err := parseOutputOptions(args, t.client)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	output, err := opts.format(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: failed to format VEX document: %s", err.Error())), nil
+	}
+
+	return &api.ToolResult{
+		Content: []api.Content{
+			{
+				Type: "text",
+				Text: opts.text("VEX patch applied successfully:", output),
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,80 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXAutofixTool implements the autofix_vex_document MCP tool
+type VEXAutofixTool struct {
+	client *vex.Client
+}
+
+// NewVEXAutofixTool creates a new VEX document autofix tool
+func NewVEXAutofixTool(client *vex.Client) *VEXAutofixTool {
+	return &VEXAutofixTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXAutofixTool) Name() string {
+	return "autofix_vex_document"
+}
+
+// Description returns the tool description
+func (t *VEXAutofixTool) Description() string {
+	return "Correct common mistakes in a VEX document: trims whitespace, canonicalizes CVE and GHSA identifiers, and maps near-miss statuses and justifications (e.g., 'Not Affected', 'patched', 'componentNotPresent') to their canonical values. Returns every change made followed by the corrected document. Values that cannot be mapped are left for validate_vex_document to report."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXAutofixTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: addOutputProperties(map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document to correct.",
+			},
+		}),
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXAutofixTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	raw, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	doc, fixes, err := t.client.Autofix(raw)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	opts, err := parseOutputOptions(args, t.client)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	output, err := opts.format(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: failed to format VEX document: %s", err.Error())), nil
+	}
+
+	lines := []string{fmt.Sprintf("VEX document autofixed, %d fix(es) applied:", len(fixes))}
+	for _, fix := range fixes {
+		lines = append(lines, fmt.Sprintf("- %s: %q -> %q", fix.Field, fix.From, fix.To))
+	}
+
+	return &api.ToolResult{
+		Content: []api.Content{
+			{
+				Type: "text",
+				Text: opts.text(strings.Join(lines, "\n"), output),
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,126 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXBatchCreateTool implements the batch_create_vex_statements MCP tool
+type VEXBatchCreateTool struct {
+	client *vex.Client
+}
+
+// NewVEXBatchCreateTool creates a new VEX batch create tool
+func NewVEXBatchCreateTool(client *vex.Client) *VEXBatchCreateTool {
+	return &VEXBatchCreateTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXBatchCreateTool) Name() string {
+	return "batch_create_vex_statements"
+}
+
+// Description returns the tool description
+func (t *VEXBatchCreateTool) Description() string {
+	return fmt.Sprintf("Create up to %d VEX statements in one call, one document per item. Each item takes the same arguments as create_vex_statement. By default the first invalid item fails the whole batch; set continue_on_error to process every item and report successes and failures separately by index.", vex.MaxBatchItems)
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXBatchCreateTool) InputSchema() *api.JSONSchema {
+	item := NewVEXCreateTool(t.client).InputSchema()
+	delete(item.Properties, "compact")
+	delete(item.Properties, "statements_only")
+	delete(item.Properties, "message_format")
+	delete(item.Properties, "sort_keys")
+	delete(item.Properties, "include_generator_metadata")
+
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"items": {
+				Type:        "array",
+				Description: "Statements to create, each with the arguments of create_vex_statement.",
+				Items:       item,
+			},
+			"continue_on_error": {
+				Type:        "boolean",
+				Description: "Process every item and return per-item results instead of aborting on the first failure.",
+				Default:     false,
+			},
+		},
+		Required: []string{"items"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXBatchCreateTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	items, ok := args["items"].([]interface{})
+	if !ok {
+		return errorResult("Error: items field is required and must be an array"), nil
+	}
+	if err := vex.ValidateBatchCount(len(items)); err != nil {
+		return errorResult(fmt.Sprintf("Error: validation error: %s", err.Error())), nil
+	}
+
+	inputs := make([]*vex.CreateInput, len(items))
+	var parseFailures []vex.BatchItemResult
+	for i, item := range items {
+		itemArgs, ok := item.(map[string]interface{})
+		if !ok {
+			parseFailures = append(parseFailures, vex.BatchItemResult{Index: i, Error: "item must be a JSON object"})
+			continue
+		}
+		input, err := parseCreateInput(itemArgs, t.client.LenientJustifications())
+		if err != nil {
+			parseFailures = append(parseFailures, vex.BatchItemResult{Index: i, Error: err.Error()})
+			continue
+		}
+		inputs[i] = input
+	}
+
+	continueOnError, _ := args["continue_on_error"].(bool)
+	if len(parseFailures) > 0 && !continueOnError {
+		return errorResult(fmt.Sprintf("Error: item %d: %s", parseFailures[0].Index, parseFailures[0].Error)), nil
+	}
+
+	// Only well-formed items reach the client; remember their request indices
+	var valid []*vex.CreateInput
+	var indices []int
+	for i, input := range inputs {
+		if input != nil {
+			valid = append(valid, input)
+			indices = append(indices, i)
+		}
+	}
+
+	result := &vex.BatchResult{Succeeded: []vex.BatchItemResult{}, Failed: parseFailures}
+	if len(valid) > 0 {
+		created, err := t.client.CreateDocuments(valid, continueOnError)
+		if err != nil {
+			return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+		}
+		for _, r := range created.Succeeded {
+			r.Index = indices[r.Index]
+			result.Succeeded = append(result.Succeeded, r)
+		}
+		for _, r := range created.Failed {
+			r.Index = indices[r.Index]
+			result.Failed = append(result.Failed, r)
+		}
+	}
+	if result.Failed == nil {
+		result.Failed = []vex.BatchItemResult{}
+	}
+	sortBatchItems(result.Failed)
+
+	return jsonResult(fmt.Sprintf("Created %d of %d VEX statement(s), %d failed:", len(result.Succeeded), len(items), len(result.Failed)), result, t.client.MaxOutputBytes()), nil
+}
+
+// sortBatchItems orders batch results by request index
+func sortBatchItems(items []vex.BatchItemResult) {
+	sort.Slice(items, func(i, j int) bool { return items[i].Index < items[j].Index })
+}
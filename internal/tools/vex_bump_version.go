@@ -0,0 +1,74 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXBumpVersionTool implements the bump_vex_version MCP tool
+type VEXBumpVersionTool struct {
+	client *vex.Client
+}
+
+// NewVEXBumpVersionTool creates a new VEX version bump tool
+func NewVEXBumpVersionTool(client *vex.Client) *VEXBumpVersionTool {
+	return &VEXBumpVersionTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXBumpVersionTool) Name() string {
+	return "bump_vex_version"
+}
+
+// Description returns the tool description
+func (t *VEXBumpVersionTool) Description() string {
+	return "Prepare an updated VEX document for re-publishing by incrementing its version and refreshing its timestamp. A missing or non-integer version is reset to 1."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXBumpVersionTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: addOutputProperties(map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document to bump.",
+			},
+		}),
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXBumpVersionTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	raw, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	doc, err := t.client.BumpVersion(raw)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	opts, err := parseOutputOptions(args, t.client)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	output, err := opts.format(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: failed to format VEX document: %s", err.Error())), nil
+	}
+
+	return &api.ToolResult{
+		Content: []api.Content{
+			{
+				Type: "text",
+				Text: opts.text(fmt.Sprintf("VEX document bumped to version %d:", doc.Version), output),
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,75 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXCanParseTool implements the can_parse MCP tool
+type VEXCanParseTool struct {
+	client *vex.Client
+}
+
+// NewVEXCanParseTool creates a new VEX can parse tool
+func NewVEXCanParseTool(client *vex.Client) *VEXCanParseTool {
+	return &VEXCanParseTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXCanParseTool) Name() string {
+	return "can_parse"
+}
+
+// Description returns the tool description
+func (t *VEXCanParseTool) Description() string {
+	return "Check that an externally supplied VEX document can be parsed by go-vex and that serializing the parsed document gives back the input, compared as canonical JSON, so no fields are dropped or added. This is a lighter check than validate_vex_document and does not apply OpenVEX rules. On failure, returns the parse error with its byte offset and the surrounding text."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXCanParseTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"document": {
+				Type:        "string",
+				Description: "Raw JSON text of the OpenVEX document to check. Error offsets are byte offsets into this text.",
+			},
+		},
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXCanParseTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	data, err := parseRawDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	check := t.client.CanParse(data)
+
+	message := "VEX document can be parsed:"
+	if !check.Parseable {
+		message = "VEX document cannot be parsed:"
+	} else if !check.Stable {
+		message = "VEX document can be parsed but does not round-trip stably:"
+	}
+	return jsonResult(message, check, t.client.MaxOutputBytes()), nil
+}
+
+// parseRawDocumentArg returns a required document argument given as JSON text
+func parseRawDocumentArg(args map[string]interface{}, name string) ([]byte, error) {
+	value, ok := args[name]
+	if !ok {
+		return nil, fmt.Errorf("%s field is required", name)
+	}
+
+	text, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("%s must be a string of JSON text", name)
+	}
+	return []byte(text), nil
+}
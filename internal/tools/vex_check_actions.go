@@ -0,0 +1,62 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXCheckActionsTool implements the check_actions_present MCP tool
+type VEXCheckActionsTool struct {
+	client *vex.Client
+}
+
+// NewVEXCheckActionsTool creates a new VEX action statement check tool
+func NewVEXCheckActionsTool(client *vex.Client) *VEXCheckActionsTool {
+	return &VEXCheckActionsTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXCheckActionsTool) Name() string {
+	return "check_actions_present"
+}
+
+// Description returns the tool description
+func (t *VEXCheckActionsTool) Description() string {
+	return "Report affected statements in a VEX document that lack an action_statement, for remediation-tracking policies. Returns the indices (0-based) of the offending statements. This is advisory and does not reject the document."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXCheckActionsTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document to check for missing action statements.",
+			},
+		},
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXCheckActionsTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	doc, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	report, err := t.client.CheckActionsPresent(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	message := fmt.Sprintf("All %d affected statement(s) have an action statement:", report.Affected)
+	if !report.Complete {
+		message = fmt.Sprintf("%d of %d affected statement(s) lack an action statement:", len(report.Missing), report.Affected)
+	}
+	return jsonResult(message, report, t.client.MaxOutputBytes()), nil
+}
@@ -0,0 +1,58 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXAliasConsistencyTool implements the check_alias_consistency MCP tool
+type VEXAliasConsistencyTool struct {
+	client *vex.Client
+}
+
+// NewVEXAliasConsistencyTool creates a new VEX alias consistency tool
+func NewVEXAliasConsistencyTool(client *vex.Client) *VEXAliasConsistencyTool {
+	return &VEXAliasConsistencyTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXAliasConsistencyTool) Name() string {
+	return "check_alias_consistency"
+}
+
+// Description returns the tool description
+func (t *VEXAliasConsistencyTool) Description() string {
+	return "Check that vulnerability aliases are used consistently across the statements of a VEX document. Links every vulnerability name with its aliases and reports linked identifier sets containing two different identifiers of the same kind, e.g. one CVE aliased to two different GHSAs. Returns the conflicting identifier sets and the statements involved."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXAliasConsistencyTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document to check.",
+			},
+		},
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXAliasConsistencyTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	doc, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	report, err := t.client.CheckAliasConsistency(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	return jsonResult(fmt.Sprintf("Alias consistency check found %d conflict(s):", len(report.Conflicts)), report, t.client.MaxOutputBytes()), nil
+}
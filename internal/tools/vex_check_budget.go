@@ -0,0 +1,71 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXCheckBudgetTool implements the check_statement_budget MCP tool
+type VEXCheckBudgetTool struct {
+	client *vex.Client
+}
+
+// NewVEXCheckBudgetTool creates a new VEX statement budget check tool
+func NewVEXCheckBudgetTool(client *vex.Client) *VEXCheckBudgetTool {
+	return &VEXCheckBudgetTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXCheckBudgetTool) Name() string {
+	return "check_statement_budget"
+}
+
+// Description returns the tool description
+func (t *VEXCheckBudgetTool) Description() string {
+	return "Check whether a VEX document stays within a maximum statement count, for enforcing size policies in CI. Returns the statement count and by how many statements the document exceeds the budget."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXCheckBudgetTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document to check.",
+			},
+			"max": {
+				Type:        "integer",
+				Description: "Maximum number of statements allowed in the document.",
+			},
+		},
+		Required: []string{"document", "max"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXCheckBudgetTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	doc, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	max, err := parseIntArg(args, "max")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	report, err := t.client.CheckStatementBudget(doc, max)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	message := fmt.Sprintf("Document is within budget (%d of %d statements):", report.Statements, report.Max)
+	if !report.WithinBudget {
+		message = fmt.Sprintf("Document exceeds budget by %d statement(s) (%d of %d):", report.Excess, report.Statements, report.Max)
+	}
+	return jsonResult(message, report, t.client.MaxOutputBytes()), nil
+}
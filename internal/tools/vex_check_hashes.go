@@ -0,0 +1,62 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXCheckHashesTool implements the check_product_hashes MCP tool
+type VEXCheckHashesTool struct {
+	client *vex.Client
+}
+
+// NewVEXCheckHashesTool creates a new VEX product hash check tool
+func NewVEXCheckHashesTool(client *vex.Client) *VEXCheckHashesTool {
+	return &VEXCheckHashesTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXCheckHashesTool) Name() string {
+	return "check_product_hashes"
+}
+
+// Description returns the tool description
+func (t *VEXCheckHashesTool) Description() string {
+	return "Check that the product and subcomponent hashes in a VEX document are well-formed: each algorithm must be one OpenVEX defines (e.g., sha-256, sha-512) and each value a hex digest of the right length. Returns the offending products (by 0-based statement index) with reasons."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXCheckHashesTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document whose product hashes to check.",
+			},
+		},
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXCheckHashesTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	doc, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	report, err := t.client.CheckProductHashes(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	message := fmt.Sprintf("All %d product hash(es) are well-formed:", report.Checked)
+	if !report.Valid {
+		message = fmt.Sprintf("%d of %d product hash(es) are malformed:", len(report.Issues), report.Checked)
+	}
+	return jsonResult(message, report, t.client.MaxOutputBytes()), nil
+}
@@ -0,0 +1,62 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXCheckInvestigationsTool implements the check_no_open_investigations MCP tool
+type VEXCheckInvestigationsTool struct {
+	client *vex.Client
+}
+
+// NewVEXCheckInvestigationsTool creates a new VEX open investigation check tool
+func NewVEXCheckInvestigationsTool(client *vex.Client) *VEXCheckInvestigationsTool {
+	return &VEXCheckInvestigationsTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXCheckInvestigationsTool) Name() string {
+	return "check_no_open_investigations"
+}
+
+// Description returns the tool description
+func (t *VEXCheckInvestigationsTool) Description() string {
+	return "Release gate that fails when a VEX document still has statements with status under_investigation. Returns pass/fail and the open statements (0-based index, vulnerability, products), so CI can block releases with unresolved triage."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXCheckInvestigationsTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document to check for open investigations.",
+			},
+		},
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXCheckInvestigationsTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	doc, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	report, err := t.client.CheckNoOpenInvestigations(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	message := "PASS: no statements are under investigation:"
+	if !report.Pass {
+		message = fmt.Sprintf("FAIL: %d statement(s) are still under investigation:", len(report.Open))
+	}
+	return jsonResult(message, report, t.client.MaxOutputBytes()), nil
+}
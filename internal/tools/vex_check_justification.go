@@ -0,0 +1,62 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXCheckJustificationTool implements the check_justification_status_match MCP tool
+type VEXCheckJustificationTool struct {
+	client *vex.Client
+}
+
+// NewVEXCheckJustificationTool creates a new VEX justification and status match check tool
+func NewVEXCheckJustificationTool(client *vex.Client) *VEXCheckJustificationTool {
+	return &VEXCheckJustificationTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXCheckJustificationTool) Name() string {
+	return "check_justification_status_match"
+}
+
+// Description returns the tool description
+func (t *VEXCheckJustificationTool) Description() string {
+	return "Report statements in a VEX document whose justification does not match their status, such as a justification on an affected statement, an unknown justification, or a not_affected statement with neither a justification nor an impact_statement. Returns the indices (0-based) of the offending statements with the reason for each. This is advisory and does not reject the document."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXCheckJustificationTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document to check justifications against statuses.",
+			},
+		},
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXCheckJustificationTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	doc, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	report, err := t.client.CheckJustificationStatus(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	message := "PASS: every justification matches its statement's status:"
+	if !report.Consistent {
+		message = fmt.Sprintf("FAIL: %d statement(s) have a justification that does not match their status:", len(report.Mismatches))
+	}
+	return jsonResult(message, report, t.client.MaxOutputBytes()), nil
+}
@@ -0,0 +1,62 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXCheckMonotonicityTool implements the check_timestamp_monotonicity MCP tool
+type VEXCheckMonotonicityTool struct {
+	client *vex.Client
+}
+
+// NewVEXCheckMonotonicityTool creates a new VEX timestamp monotonicity check tool
+func NewVEXCheckMonotonicityTool(client *vex.Client) *VEXCheckMonotonicityTool {
+	return &VEXCheckMonotonicityTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXCheckMonotonicityTool) Name() string {
+	return "check_timestamp_monotonicity"
+}
+
+// Description returns the tool description
+func (t *VEXCheckMonotonicityTool) Description() string {
+	return "Check that the timestamps of a VEX document never run backwards, e.g. after edits through the update and append tools. Reports each statement whose last_updated precedes its timestamp, statement timestamps later than the document timestamp or last_updated, and a document last_updated before its timestamp, with the offending location and reason. This is advisory and does not reject the document."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXCheckMonotonicityTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document whose document and statement timestamps should be checked for ordering.",
+			},
+		},
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXCheckMonotonicityTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	doc, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	report, err := t.client.CheckTimestampMonotonicity(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	message := "PASS: timestamps are monotonic:"
+	if !report.Monotonic {
+		message = fmt.Sprintf("FAIL: %d timestamp(s) are out of order:", len(report.Issues))
+	}
+	return jsonResult(message, report, t.client.MaxOutputBytes()), nil
+}
@@ -0,0 +1,68 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXCheckPublishTool implements the check_publish_ready MCP tool
+type VEXCheckPublishTool struct {
+	client *vex.Client
+}
+
+// NewVEXCheckPublishTool creates a new VEX publish readiness tool
+func NewVEXCheckPublishTool(client *vex.Client) *VEXCheckPublishTool {
+	return &VEXCheckPublishTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXCheckPublishTool) Name() string {
+	return "check_publish_ready"
+}
+
+// Description returns the tool description
+func (t *VEXCheckPublishTool) Description() string {
+	return "One-call gate run before publishing a VEX document. Checks that author and timestamp are present, every not_affected statement has a justification or impact statement, every affected statement has an action statement, and no statement is still under_investigation. Returns pass/fail with the list of blocking issues."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXCheckPublishTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document to check before publishing.",
+			},
+			"allow_under_investigation": {
+				Type:        "boolean",
+				Description: "Do not block on statements that are still under_investigation.",
+				Default:     false,
+			},
+		},
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXCheckPublishTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	doc, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	allowInvestigations, _ := args["allow_under_investigation"].(bool)
+
+	report, err := t.client.CheckPublishReady(doc, allowInvestigations)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	message := "PASS: the document is ready to publish:"
+	if !report.Ready {
+		message = fmt.Sprintf("FAIL: %d issue(s) block publishing:", len(report.Issues))
+	}
+	return jsonResult(message, report, t.client.MaxOutputBytes()), nil
+}
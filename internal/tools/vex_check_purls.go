@@ -0,0 +1,72 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXPURLConsistencyTool implements the check_purl_consistency MCP tool
+type VEXPURLConsistencyTool struct {
+	client *vex.Client
+}
+
+// NewVEXPURLConsistencyTool creates a new VEX PURL consistency tool
+func NewVEXPURLConsistencyTool(client *vex.Client) *VEXPURLConsistencyTool {
+	return &VEXPURLConsistencyTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXPURLConsistencyTool) Name() string {
+	return "check_purl_consistency"
+}
+
+// Description returns the tool description
+func (t *VEXPURLConsistencyTool) Description() string {
+	return "Check that every product in a VEX document uses the same PURL type, e.g. only npm packages in a per-ecosystem document. Reports products with a different type and products that are not valid package URLs, including invalid qualifiers or subpaths. Set required_qualifiers to also report products missing qualifiers such as arch."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXPURLConsistencyTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document to check.",
+			},
+			"type": {
+				Type:        "string",
+				Description: "Expected PURL type (e.g., npm, pypi, docker). Defaults to the type of the first product.",
+				Examples:    []interface{}{"npm", "docker"},
+			},
+			"required_qualifiers": {
+				Type:        "array",
+				Description: "PURL qualifiers every product must carry with a value (e.g., arch or distro for OS packages).",
+				Items:       &api.JSONSchema{Type: "string"},
+				Examples:    []interface{}{[]interface{}{"arch"}},
+			},
+		},
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXPURLConsistencyTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	doc, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	expectedType, _ := args["type"].(string)
+
+	requiredQualifiers := parseStringArray(args, "required_qualifiers")
+
+	report, err := t.client.CheckPURLConsistency(doc, expectedType, requiredQualifiers)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	return jsonResult(fmt.Sprintf("PURL consistency check found %d inconsistent product(s):", len(report.Inconsistent)), report, t.client.MaxOutputBytes()), nil
+}
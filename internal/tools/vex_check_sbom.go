@@ -0,0 +1,73 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXCheckSBOMTool implements the check_products_in_sbom MCP tool
+type VEXCheckSBOMTool struct {
+	client *vex.Client
+}
+
+// NewVEXCheckSBOMTool creates a new VEX SBOM coverage check tool
+func NewVEXCheckSBOMTool(client *vex.Client) *VEXCheckSBOMTool {
+	return &VEXCheckSBOMTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXCheckSBOMTool) Name() string {
+	return "check_products_in_sbom"
+}
+
+// Description returns the tool description
+func (t *VEXCheckSBOMTool) Description() string {
+	return "Check that the products of a VEX document's statements are components of an SBOM, since publishing statements about products not in the SBOM is often a mistake. Package URLs are normalized before matching (case, qualifier order, percent-encoding). Returns the orphan statements by index (0-based) with the products missing from the SBOM. This is advisory and does not reject the document."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXCheckSBOMTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document whose statement products should be checked.",
+			},
+			"components": {
+				Type:        "array",
+				Description: fmt.Sprintf("Package URLs of the SBOM components, up to %d.", vex.MaxSBOMComponents),
+				Items: &api.JSONSchema{
+					Type:        "string",
+					Description: "Component identifier in PURL format",
+				},
+			},
+		},
+		Required: []string{"document", "components"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXCheckSBOMTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	doc, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	if _, ok := args["components"].([]interface{}); !ok {
+		return errorResult("Error: components is required and must be an array"), nil
+	}
+
+	report, err := t.client.CheckProductsInSBOM(doc, parseStringArray(args, "components"))
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	message := "PASS: every statement product is in the SBOM:"
+	if !report.Covered {
+		message = fmt.Sprintf("FAIL: %d statement(s) cover products not in the SBOM:", len(report.Orphans))
+	}
+	return jsonResult(message, report, t.client.MaxOutputBytes()), nil
+}
@@ -0,0 +1,58 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXStalenessTool implements the check_vex_staleness MCP tool
+type VEXStalenessTool struct {
+	client *vex.Client
+}
+
+// NewVEXStalenessTool creates a new VEX staleness tool
+func NewVEXStalenessTool(client *vex.Client) *VEXStalenessTool {
+	return &VEXStalenessTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXStalenessTool) Name() string {
+	return "check_vex_staleness"
+}
+
+// Description returns the tool description
+func (t *VEXStalenessTool) Description() string {
+	return "Check a multi-statement VEX document for stale data: (vulnerability, product) pairs that have both a fixed/not_affected statement and an affected/under_investigation statement. Returns each conflicting pair with the indices of its resolved and open statements."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXStalenessTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document to check.",
+			},
+		},
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXStalenessTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	doc, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	report, err := t.client.CheckStaleness(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	return jsonResult(fmt.Sprintf("Staleness check found %d stale pair(s):", len(report.Pairs)), report, t.client.MaxOutputBytes()), nil
+}
@@ -0,0 +1,61 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXTimestampCheckTool implements the check_vex_timestamps MCP tool
+type VEXTimestampCheckTool struct {
+	client *vex.Client
+}
+
+// NewVEXTimestampCheckTool creates a new VEX timestamp check tool
+func NewVEXTimestampCheckTool(client *vex.Client) *VEXTimestampCheckTool {
+	return &VEXTimestampCheckTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXTimestampCheckTool) Name() string {
+	return "check_vex_timestamps"
+}
+
+// Description returns the tool description
+func (t *VEXTimestampCheckTool) Description() string {
+	return "Check the timestamps of a VEX document and its statements. Reports the location of every timestamp that is missing, not a valid RFC 3339 date, or implausibly far in the future."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXTimestampCheckTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document whose document and statement timestamps should be checked.",
+			},
+		},
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXTimestampCheckTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	doc, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	issues, err := t.client.CheckTimestamps(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	return jsonResult(fmt.Sprintf("Timestamp check found %d issue(s):", len(issues)), map[string]interface{}{
+		"valid":  len(issues) == 0,
+		"issues": issues,
+	}, t.client.MaxOutputBytes()), nil
+}
@@ -0,0 +1,62 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXCheckUniformStatusTool implements the check_uniform_status MCP tool
+type VEXCheckUniformStatusTool struct {
+	client *vex.Client
+}
+
+// NewVEXCheckUniformStatusTool creates a new VEX uniform status check tool
+func NewVEXCheckUniformStatusTool(client *vex.Client) *VEXCheckUniformStatusTool {
+	return &VEXCheckUniformStatusTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXCheckUniformStatusTool) Name() string {
+	return "check_uniform_status"
+}
+
+// Description returns the tool description
+func (t *VEXCheckUniformStatusTool) Description() string {
+	return "Report vulnerabilities whose statements in a VEX document have differing statuses across products, for vulnerability-centric reports that require a single status per vulnerability (e.g., company-wide not_affected). Returns each such vulnerability with its statuses and the indices (0-based) of its statements. This is advisory and does not reject the document."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXCheckUniformStatusTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document to check for vulnerabilities with differing statuses.",
+			},
+		},
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXCheckUniformStatusTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	doc, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	report, err := t.client.CheckUniformStatus(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	message := "PASS: every vulnerability has a single status:"
+	if !report.Uniform {
+		message = fmt.Sprintf("FAIL: %d vulnerability(ies) have differing statuses across products:", len(report.Vulnerabilities))
+	}
+	return jsonResult(message, report, t.client.MaxOutputBytes()), nil
+}
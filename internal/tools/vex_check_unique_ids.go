@@ -0,0 +1,66 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXCheckUniqueIDsTool implements the check_unique_ids MCP tool
+type VEXCheckUniqueIDsTool struct {
+	client *vex.Client
+}
+
+// NewVEXCheckUniqueIDsTool creates a new VEX unique ID check tool
+func NewVEXCheckUniqueIDsTool(client *vex.Client) *VEXCheckUniqueIDsTool {
+	return &VEXCheckUniqueIDsTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXCheckUniqueIDsTool) Name() string {
+	return "check_unique_ids"
+}
+
+// Description returns the tool description
+func (t *VEXCheckUniqueIDsTool) Description() string {
+	return "Pre-ingest integrity check that a set of VEX documents have distinct @id values, so none overwrites another in storage. Reports each duplicated @id with the 1-based positions of the documents sharing it. Documents without an @id are not compared."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXCheckUniqueIDsTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"documents": {
+				Type:        "array",
+				Description: fmt.Sprintf("OpenVEX documents to check, up to %d.", vex.MaxMergeDocuments),
+				Items: &api.JSONSchema{
+					Type:        "object",
+					Description: "Complete OpenVEX document.",
+				},
+			},
+		},
+		Required: []string{"documents"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXCheckUniqueIDsTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	docs, err := parseDocumentsArg(args, "documents")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	report, err := t.client.CheckUniqueIDs(docs)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	message := "PASS: every document @id is unique:"
+	if !report.Unique {
+		message = fmt.Sprintf("FAIL: %d @id value(s) are shared by several documents:", len(report.Duplicates))
+	}
+	return jsonResult(message, report, t.client.MaxOutputBytes()), nil
+}
@@ -0,0 +1,86 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXConsolidateTool implements the consolidate_latest MCP tool
+type VEXConsolidateTool struct {
+	client *vex.Client
+}
+
+// NewVEXConsolidateTool creates a new VEX consolidate tool
+func NewVEXConsolidateTool(client *vex.Client) *VEXConsolidateTool {
+	return &VEXConsolidateTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXConsolidateTool) Name() string {
+	return "consolidate_latest"
+}
+
+// Description returns the tool description
+func (t *VEXConsolidateTool) Description() string {
+	return "Consolidate VEX documents by keeping only the newest statement for each (vulnerability, product) pair and dropping older ones. Statements covering several products are split per product. Set document_priority to let an authoritative source win regardless of timestamps. Accepts the same metadata and filter options as merge_vex_documents."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXConsolidateTool) InputSchema() *api.JSONSchema {
+	properties := addOutputProperties(mergeOptionProperties())
+	properties["documents"] = &api.JSONSchema{
+		Type:        "array",
+		Description: fmt.Sprintf("OpenVEX documents to consolidate (1-%d documents). Statements without a timestamp inherit their document's timestamp.", vex.MaxMergeDocuments),
+		Items: &api.JSONSchema{
+			Type:        "object",
+			Description: "Complete OpenVEX document",
+		},
+	}
+
+	properties["document_priority"] = &api.JSONSchema{
+		Type:        "array",
+		Description: "Source document @id values, most authoritative first. For each (vulnerability, product) pair the statement from the highest-priority document wins; newest wins among equal priority. Unlisted documents rank lowest.",
+		Items:       &api.JSONSchema{Type: "string"},
+	}
+
+	return &api.JSONSchema{
+		Type:       "object",
+		Properties: properties,
+		Required:   []string{"documents"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXConsolidateTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	input, err := parseMergeInput(args)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	input.DocumentPriority = parseStringArray(args, "document_priority")
+
+	doc, err := t.client.ConsolidateLatest(input)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	opts, err := parseOutputOptions(args, t.client)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	output, err := opts.format(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: failed to format VEX document: %s", err.Error())), nil
+	}
+
+	return withValidationWarnings(&api.ToolResult{
+		Content: []api.Content{
+			{
+				Type: "text",
+				Text: opts.text(fmt.Sprintf("VEX documents consolidated to %d statement(s):", len(doc.Statements)), output),
+			},
+		},
+	}, doc), nil
+}
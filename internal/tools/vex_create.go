@@ -2,13 +2,19 @@ package tools
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/rosstaco/vexdoc-mcp/internal/vex"
 	"github.com/rosstaco/vexdoc-mcp/pkg/api"
 )
 
+// Allowed values of the create tool's enum arguments
+var (
+	statusValues        = []string{"not_affected", "affected", "fixed", "under_investigation"}
+	justificationValues = []string{"component_not_present", "vulnerable_code_not_present", "vulnerable_code_not_in_execute_path", "vulnerable_code_cannot_be_controlled_by_adversary", "inline_mitigations_already_exist"}
+)
+
 // VEXCreateTool implements the create_vex_statement MCP tool
 type VEXCreateTool struct {
 	client *vex.Client
@@ -24,33 +30,51 @@ func (t *VEXCreateTool) Name() string {
 	return "create_vex_statement"
 }
 
+// Title returns the human-friendly tool title
+func (t *VEXCreateTool) Title() string {
+	return "Create VEX Statement"
+}
+
 // Description returns the tool description
 func (t *VEXCreateTool) Description() string {
 	return "Generate VEX (Vulnerability Exploitability eXchange) statements to document security vulnerability assessments for software products. Creates OpenVEX-compliant JSON documents that specify whether products are affected by specific vulnerabilities."
 }
 
-// InputSchema returns the JSON schema for tool input
-func (t *VEXCreateTool) InputSchema() *api.JSONSchema {
-	return &api.JSONSchema{
+// statementSchema returns the JSON schema of a single statement's fields as
+// accepted by create_vex_statement. It is shared by the create tools and
+// get_statement_schema so that they cannot drift apart.
+func statementSchema(client *vex.Client) *api.JSONSchema {
+	schema := &api.JSONSchema{
 		Type: "object",
 		Properties: map[string]*api.JSONSchema{
 			"product": {
 				Type:        "string",
 				Description: "Software product identifier using PURL (Package URL) format, e.g., pkg:npm/lodash@4.17.21, pkg:docker/nginx@1.20.1, pkg:apk/wolfi/git@2.39.0-r1?arch=x86_64",
+				Examples:    []interface{}{"pkg:npm/lodash@4.17.21", "pkg:docker/nginx@1.20.1", "pkg:apk/wolfi/git@2.39.0-r1?arch=x86_64"},
+			},
+			"products": {
+				Type:        "array",
+				Description: "Additional products covered by the same statement. Duplicates of product or of each other are removed.",
+				Items: &api.JSONSchema{
+					Type:        "string",
+					Description: "Product identifier in PURL format",
+				},
 			},
 			"vulnerability": {
 				Type:        "string",
 				Description: "Security vulnerability identifier from CVE, GHSA, or other vulnerability databases (e.g., CVE-2023-1234, GHSA-xxxx-xxxx-xxxx)",
+				Examples:    []interface{}{"CVE-2023-1234", "GHSA-jfh8-c2jp-5v3q"},
 			},
 			"status": {
 				Type:        "string",
 				Description: "Assessment of how the vulnerability affects this product: not_affected (product is safe), affected (vulnerable), fixed (patched), under_investigation (being analyzed)",
-				Enum:        []string{"not_affected", "affected", "fixed", "under_investigation"},
+				Enum:        statusValues,
+				Examples:    []interface{}{"not_affected", "affected"},
 			},
 			"justification": {
 				Type:        "string",
 				Description: "Technical reason why a product is not affected by the vulnerability (required when status=not_affected): component_not_present, vulnerable_code_not_present, vulnerable_code_not_in_execute_path, vulnerable_code_cannot_be_controlled_by_adversary, inline_mitigations_already_exist",
-				Enum:        []string{"component_not_present", "vulnerable_code_not_present", "vulnerable_code_not_in_execute_path", "vulnerable_code_cannot_be_controlled_by_adversary", "inline_mitigations_already_exist"},
+				Enum:        justificationValues,
 			},
 			"impact_statement": {
 				Type:        "string",
@@ -60,55 +84,81 @@ func (t *VEXCreateTool) InputSchema() *api.JSONSchema {
 				Type:        "string",
 				Description: "Recommended remediation actions for affected products, such as version upgrades, configuration changes, or workarounds (used with status=affected)",
 			},
-			"author": {
+			"cvss": {
 				Type:        "string",
-				Description: "Security analyst, team, or organization responsible for this vulnerability assessment (e.g., security-team@company.com, John Doe, ACME Security Team)",
+				Description: "CVSS severity for prioritization, as a v3.x or v4.0 vector (e.g., CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H) or a base score (e.g., 7.5). Stored in the statement's 'cvss' extension field. This is an extension, not part of the OpenVEX specification.",
+				Examples:    []interface{}{"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H", "7.5"},
+			},
+			"notes": {
+				Type:        "array",
+				Description: fmt.Sprintf("Freeform notes annotating the statement, up to %d. Stored in the statement's 'notes' extension field. This is an extension, not part of the OpenVEX specification.", vex.MaxNotes),
+				Items:       &api.JSONSchema{Type: "string"},
 			},
 		},
 		Required: []string{"product", "vulnerability", "status"},
 	}
-}
 
-// Execute runs the tool with the provided arguments
-func (t *VEXCreateTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
-	// Parse required fields
-	product, ok := args["product"].(string)
-	if !ok {
-		return errorResult("product is required and must be a string"), nil
+	// Variants are normalized by the client, so don't let callers enforce the enum
+	if client.LenientJustifications() {
+		schema.Properties["justification"].Enum = nil
 	}
+	return schema
+}
 
-	vulnerability, ok := args["vulnerability"].(string)
-	if !ok {
-		return errorResult("vulnerability is required and must be a string"), nil
+// InputSchema returns the JSON schema for tool input
+func (t *VEXCreateTool) InputSchema() *api.JSONSchema {
+	schema := statementSchema(t.client)
+	schema.Properties["author"] = &api.JSONSchema{
+		Type:        "string",
+		Description: "Security analyst, team, or organization responsible for this vulnerability assessment (e.g., security-team@company.com, John Doe, ACME Security Team)",
 	}
-
-	status, ok := args["status"].(string)
-	if !ok {
-		return errorResult("status is required and must be a string"), nil
+	schema.Properties["author_role"] = &api.JSONSchema{
+		Type:        "string",
+		Description: "Role or title of the author of the assessment (e.g., 'Security Engineer', 'Vulnerability Manager', 'CISO')",
+	}
+	schema.Properties["labels"] = labelsProperty()
+	schema.Properties["normalize_ids"] = &api.JSONSchema{
+		Type:        "boolean",
+		Description: "Canonicalize a CVE or GHSA vulnerability identifier (e.g., cve-2023-1234 becomes CVE-2023-1234). Other identifiers are left untouched.",
+		Default:     false,
+	}
+	schema.Properties["collect_errors"] = &api.JSONSchema{
+		Type:        "boolean",
+		Description: "Report every invalid field together instead of stopping at the first, so all problems can be fixed in one retry.",
+		Default:     false,
 	}
+	schema.Properties = addOutputProperties(schema.Properties)
+	return schema
+}
 
-	// Parse optional fields
-	justification, _ := args["justification"].(string)
-	impactStatement, _ := args["impact_statement"].(string)
-	actionStatement, _ := args["action_statement"].(string)
-	author, _ := args["author"].(string)
+// OutputSchema returns the JSON schema for the tool's structured content
+func (t *VEXCreateTool) OutputSchema() *api.JSONSchema {
+	return vexDocumentOutputSchema()
+}
+
+// Execute runs the tool with the provided arguments
+func (t *VEXCreateTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	input, err := parseCreateInput(args, t.client.LenientJustifications())
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
 
 	// Create VEX statement using simplified client
-	doc, err := t.client.CreateStatement(
-		product,
-		vulnerability,
-		status,
-		justification,
-		impactStatement,
-		actionStatement,
-		author,
-	)
+	doc, err := t.client.CreateDocument(input)
 	if err != nil {
 		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
 	}
 
 	// Format output as JSON
-	output, err := formatVEXDocument(doc)
+	opts, err := parseOutputOptions(args, t.client)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	output, err := opts.format(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: failed to format VEX document: %s", err.Error())), nil
+	}
+	structured, err := structuredDocument(doc)
 	if err != nil {
 		return errorResult(fmt.Sprintf("Error: failed to format VEX document: %s", err.Error())), nil
 	}
@@ -117,19 +167,95 @@ func (t *VEXCreateTool) Execute(ctx context.Context, args map[string]interface{}
 		Content: []api.Content{
 			{
 				Type: "text",
-				Text: fmt.Sprintf("VEX statement created successfully:\n\n%s", output),
+				Text: opts.text("VEX statement created successfully:", output),
 			},
 		},
+		StructuredContent: structured,
 	}, nil
 }
 
-// formatVEXDocument formats a VEX document as JSON
-func formatVEXDocument(doc interface{}) (string, error) {
-	jsonBytes, err := json.MarshalIndent(doc, "", "  ")
+// parseCreateInput extracts a CreateInput from the create tool arguments.
+// With lenient justifications, justification variants are passed through
+// for the client to normalize instead of being rejected here. With
+// collect_errors, every invalid argument is reported in one joined error
+// together with the client's field checks, which also report missing fields.
+func parseCreateInput(args map[string]interface{}, lenientJustifications bool) (*vex.CreateInput, error) {
+	collectErrors, _ := args["collect_errors"].(bool)
+	var errs []error
+
+	// Parse required fields
+	product, ok := args["product"].(string)
+	if !ok && !collectErrors {
+		return nil, fmt.Errorf("product is required and must be a string")
+	}
+
+	vulnerability, ok := args["vulnerability"].(string)
+	if !ok && !collectErrors {
+		return nil, fmt.Errorf("vulnerability is required and must be a string")
+	}
+
+	status, err := parseEnumArg(args, "status", statusValues)
 	if err != nil {
-		return "", err
+		if !collectErrors {
+			return nil, err
+		}
+		errs = append(errs, err)
+		status, _ = args["status"].(string)
+	}
+	if status == "" && !collectErrors {
+		return nil, fmt.Errorf("status is required and must be a string")
+	}
+
+	// Parse optional fields
+	justification, _ := args["justification"].(string)
+	if !lenientJustifications {
+		if _, err := parseEnumArg(args, "justification", justificationValues); err != nil {
+			if !collectErrors {
+				return nil, err
+			}
+			errs = append(errs, err)
+		}
+	}
+	impactStatement, _ := args["impact_statement"].(string)
+	actionStatement, _ := args["action_statement"].(string)
+	author, _ := args["author"].(string)
+	authorRole, _ := args["author_role"].(string)
+	labels, err := parseLabelsArg(args)
+	if err != nil {
+		if !collectErrors {
+			return nil, err
+		}
+		errs = append(errs, err)
+	}
+	cvss, err := parseCVSSArg(args)
+	if err != nil {
+		if !collectErrors {
+			return nil, err
+		}
+		errs = append(errs, err)
+	}
+	normalizeIDs, _ := args["normalize_ids"].(bool)
+
+	input := &vex.CreateInput{
+		Product:         product,
+		Products:        parseStringArray(args, "products"),
+		Vulnerability:   vulnerability,
+		Status:          status,
+		Justification:   justification,
+		ImpactStatement: impactStatement,
+		ActionStatement: actionStatement,
+		Author:          author,
+		AuthorRole:      authorRole,
+		Labels:          labels,
+		CVSS:            cvss,
+		Notes:           parseStringArray(args, "notes"),
+		CollectErrors:   collectErrors,
+		NormalizeIDs:    normalizeIDs,
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(append(errs, vex.ValidateCreateInput(input))...)
 	}
-	return string(jsonBytes), nil
+	return input, nil
 }
 
 // errorResult creates an error tool result
@@ -0,0 +1,70 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXEqualTool implements the vex_documents_equal MCP tool
+type VEXEqualTool struct {
+	client *vex.Client
+}
+
+// NewVEXEqualTool creates a new VEX equality tool
+func NewVEXEqualTool(client *vex.Client) *VEXEqualTool {
+	return &VEXEqualTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXEqualTool) Name() string {
+	return "vex_documents_equal"
+}
+
+// Description returns the tool description
+func (t *VEXEqualTool) Description() string {
+	return "Compare two VEX documents for semantic equality, e.g. a generated document against a golden file. Document and statement @id, timestamp, and last_updated are ignored and statements are compared in canonical order. Returns whether the documents are equal and the differing fields if not."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXEqualTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"left": {
+				Type:        "object",
+				Description: "First OpenVEX document, e.g. the expected (golden) document.",
+			},
+			"right": {
+				Type:        "object",
+				Description: "Second OpenVEX document, e.g. the freshly generated document.",
+			},
+		},
+		Required: []string{"left", "right"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXEqualTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	left, err := parseDocumentArg(args, "left")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	right, err := parseDocumentArg(args, "right")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	comparison, err := t.client.CompareDocuments(left, right)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	message := "Documents are equal:"
+	if !comparison.Equal {
+		message = fmt.Sprintf("Documents differ in %d field(s):", len(comparison.Differences))
+	}
+	return jsonResult(message, comparison, t.client.MaxOutputBytes()), nil
+}
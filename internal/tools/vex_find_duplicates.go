@@ -0,0 +1,62 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXFindDuplicatesTool implements the find_duplicate_statements MCP tool
+type VEXFindDuplicatesTool struct {
+	client *vex.Client
+}
+
+// NewVEXFindDuplicatesTool creates a new VEX duplicate statements tool
+func NewVEXFindDuplicatesTool(client *vex.Client) *VEXFindDuplicatesTool {
+	return &VEXFindDuplicatesTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXFindDuplicatesTool) Name() string {
+	return "find_duplicate_statements"
+}
+
+// Description returns the tool description
+func (t *VEXFindDuplicatesTool) Description() string {
+	return "Find accidentally duplicated statements within a single VEX document before publishing. Reports groups of statement indices (0-based) that share a vulnerability, product set, status, and justification. Use merge tools to deduplicate across documents."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXFindDuplicatesTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document to check for duplicate statements.",
+			},
+		},
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXFindDuplicatesTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	doc, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	report, err := t.client.FindDuplicateStatements(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	message := "No duplicate statements found:"
+	if report.HasDuplicates {
+		message = fmt.Sprintf("Found %d group(s) of duplicate statements:", len(report.Groups))
+	}
+	return jsonResult(message, report, t.client.MaxOutputBytes()), nil
+}
@@ -0,0 +1,84 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXFlattenSubcomponentsTool implements the flatten_subcomponents MCP tool
+type VEXFlattenSubcomponentsTool struct {
+	client *vex.Client
+}
+
+// NewVEXFlattenSubcomponentsTool creates a new VEX subcomponent flattening tool
+func NewVEXFlattenSubcomponentsTool(client *vex.Client) *VEXFlattenSubcomponentsTool {
+	return &VEXFlattenSubcomponentsTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXFlattenSubcomponentsTool) Name() string {
+	return "flatten_subcomponents"
+}
+
+// Description returns the tool description
+func (t *VEXFlattenSubcomponentsTool) Description() string {
+	return "Rewrite a VEX document for consumers that do not understand subcomponents by promoting each subcomponent to a top-level product. Statuses, justifications, and other statement fields are preserved."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXFlattenSubcomponentsTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: addOutputProperties(map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document whose subcomponents to flatten.",
+			},
+			"mode": {
+				Type:        "string",
+				Description: "'products' replaces each product with its subcomponents in the same statement; 'statements' gives every subcomponent its own copy of the statement.",
+				Enum:        vex.FlattenModes(),
+				Default:     vex.FlattenIntoProducts,
+			},
+		}),
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXFlattenSubcomponentsTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	raw, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	mode, err := parseEnumArg(args, "mode", vex.FlattenModes())
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	doc, err := t.client.FlattenSubcomponents(raw, mode)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	opts, err := parseOutputOptions(args, t.client)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	output, err := opts.format(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: failed to format VEX document: %s", err.Error())), nil
+	}
+
+	return &api.ToolResult{
+		Content: []api.Content{
+			{
+				Type: "text",
+				Text: opts.text(fmt.Sprintf("VEX subcomponents flattened into %d statement(s):", len(doc.Statements)), output),
+			},
+		},
+	}, nil
+}
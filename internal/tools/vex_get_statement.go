@@ -0,0 +1,67 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXGetStatementTool implements the get_vex_statement MCP tool
+type VEXGetStatementTool struct {
+	client *vex.Client
+}
+
+// NewVEXGetStatementTool creates a new VEX statement extraction tool
+func NewVEXGetStatementTool(client *vex.Client) *VEXGetStatementTool {
+	return &VEXGetStatementTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXGetStatementTool) Name() string {
+	return "get_vex_statement"
+}
+
+// Description returns the tool description
+func (t *VEXGetStatementTool) Description() string {
+	return "Extract a single statement from a VEX document by its 0-based index, for editing one statement at a time. Returns just that statement as JSON."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXGetStatementTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document containing the statement.",
+			},
+			"index": {
+				Type:        "integer",
+				Description: "0-based index of the statement to extract.",
+			},
+		},
+		Required: []string{"document", "index"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXGetStatementTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	doc, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	index, err := parseIntArg(args, "index")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	stmt, err := t.client.GetStatement(doc, index)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	return jsonResult(fmt.Sprintf("Statement %d:", index), stmt, t.client.MaxOutputBytes()), nil
+}
@@ -0,0 +1,58 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXHashStatementsTool implements the hash_vex_statements MCP tool
+type VEXHashStatementsTool struct {
+	client *vex.Client
+}
+
+// NewVEXHashStatementsTool creates a new VEX statement hashing tool
+func NewVEXHashStatementsTool(client *vex.Client) *VEXHashStatementsTool {
+	return &VEXHashStatementsTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXHashStatementsTool) Name() string {
+	return "hash_vex_statements"
+}
+
+// Description returns the tool description
+func (t *VEXHashStatementsTool) Description() string {
+	return "Compute a canonical SHA-256 hash for each statement of a VEX document over its vulnerability, sorted products, status, and justification. Timestamps and product order are ignored, so equal assessments hash equally across documents for indexing and deduplication."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXHashStatementsTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document whose statements to hash.",
+			},
+		},
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXHashStatementsTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	doc, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	hashes, err := t.client.HashStatements(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	return jsonResult(fmt.Sprintf("Hashed %d statement(s):", len(hashes)), hashes, t.client.MaxOutputBytes()), nil
+}
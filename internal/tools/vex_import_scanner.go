@@ -0,0 +1,112 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXImportScannerTool implements the import_scanner_report MCP tool
+type VEXImportScannerTool struct {
+	client *vex.Client
+}
+
+// NewVEXImportScannerTool creates a new VEX scanner report import tool
+func NewVEXImportScannerTool(client *vex.Client) *VEXImportScannerTool {
+	return &VEXImportScannerTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXImportScannerTool) Name() string {
+	return "import_scanner_report"
+}
+
+// Description returns the tool description
+func (t *VEXImportScannerTool) Description() string {
+	return fmt.Sprintf("Generate a VEX document from a vulnerability scanner report (Trivy JSON), to start VEX authoring from scan results. Creates one statement per distinct vulnerability and package, with the package PURL as product and the CVE or other identifier as vulnerability, all with the given status (under_investigation by default). Findings whose package has no PURL are skipped and counted. At most %d statements are created.", vex.MaxScannerFindings)
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXImportScannerTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: addOutputProperties(map[string]*api.JSONSchema{
+			"report": {
+				Type:        "object",
+				Description: "Complete scanner report, e.g. the output of 'trivy image --format json'.",
+			},
+			"format": {
+				Type:        "string",
+				Description: "Format of the scanner report.",
+				Enum:        vex.ScannerFormats(),
+				Default:     vex.ScannerFormatTrivy,
+			},
+			"status": {
+				Type:        "string",
+				Description: "Status given to every statement. not_affected and affected need per-finding detail and are not available here; refine statements afterwards as each finding is assessed.",
+				Enum:        vex.ScannerImportStatuses(),
+				Default:     "under_investigation",
+			},
+			"author": {
+				Type:        "string",
+				Description: "Name or identifier of the person or organization creating this VEX document",
+			},
+			"author_role": {
+				Type:        "string",
+				Description: "Role or title of the author (e.g., 'Security Engineer', 'Product Security Team')",
+			},
+		}),
+		Required: []string{"report"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXImportScannerTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	report, ok := args["report"].(map[string]interface{})
+	if !ok {
+		return errorResult("Error: report is required and must be an object"), nil
+	}
+	format, err := parseEnumArg(args, "format", vex.ScannerFormats())
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	status, err := parseEnumArg(args, "status", vex.ScannerImportStatuses())
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	author, _ := args["author"].(string)
+	authorRole, _ := args["author_role"].(string)
+
+	doc, skipped, err := t.client.ImportScannerReport(&vex.ScannerImportInput{
+		Report:     report,
+		Format:     format,
+		Status:     status,
+		Author:     author,
+		AuthorRole: authorRole,
+	})
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	// Format output as JSON
+	opts, err := parseOutputOptions(args, t.client)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	output, err := opts.format(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: failed to format VEX document: %s", err.Error())), nil
+	}
+
+	message := fmt.Sprintf("VEX document imported with %d statement(s), %d finding(s) without a package URL skipped:", len(doc.Statements), skipped)
+	return &api.ToolResult{
+		Content: []api.Content{
+			{
+				Type: "text",
+				Text: opts.text(message, output),
+			},
+		},
+	}, nil
+}
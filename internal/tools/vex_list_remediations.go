@@ -0,0 +1,58 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXRemediationsTool implements the list_remediations MCP tool
+type VEXRemediationsTool struct {
+	client *vex.Client
+}
+
+// NewVEXRemediationsTool creates a new VEX remediations tool
+func NewVEXRemediationsTool(client *vex.Client) *VEXRemediationsTool {
+	return &VEXRemediationsTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXRemediationsTool) Name() string {
+	return "list_remediations"
+}
+
+// Description returns the tool description
+func (t *VEXRemediationsTool) Description() string {
+	return "List the remediation actions of a VEX document to build a patch plan. Returns, for every affected statement, the vulnerability, affected products, and action statement (with its timestamp when present), sorted by vulnerability."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXRemediationsTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document to extract remediation actions from.",
+			},
+		},
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXRemediationsTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	doc, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	remediations, err := t.client.ListRemediations(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	return jsonResult(fmt.Sprintf("Found %d remediation(s):", len(remediations)), remediations, t.client.MaxOutputBytes()), nil
+}
@@ -0,0 +1,59 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXMatrixTool implements the vex_matrix MCP tool
+type VEXMatrixTool struct {
+	client *vex.Client
+}
+
+// NewVEXMatrixTool creates a new VEX status matrix tool
+func NewVEXMatrixTool(client *vex.Client) *VEXMatrixTool {
+	return &VEXMatrixTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXMatrixTool) Name() string {
+	return "vex_matrix"
+}
+
+// Description returns the tool description
+func (t *VEXMatrixTool) Description() string {
+	return "Build a product by vulnerability status grid from a VEX document, for dashboards. Returns the products and vulnerabilities in order of first appearance, and for each product a map of vulnerability to status. When statements overlap, the later one in the document wins."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXMatrixTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document to tabulate.",
+			},
+		},
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXMatrixTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	doc, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	matrix, err := t.client.StatusMatrix(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	message := fmt.Sprintf("Status matrix of %d product(s) by %d vulnerability(ies):", len(matrix.Products), len(matrix.Vulnerabilities))
+	return jsonResult(message, matrix, t.client.MaxOutputBytes()), nil
+}
@@ -3,6 +3,7 @@ package tools
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/rosstaco/vexdoc-mcp/internal/vex"
 	"github.com/rosstaco/vexdoc-mcp/pkg/api"
@@ -23,6 +24,11 @@ func (t *VEXMergeTool) Name() string {
 	return "merge_vex_documents"
 }
 
+// Title returns the human-friendly tool title
+func (t *VEXMergeTool) Title() string {
+	return "Merge VEX Documents"
+}
+
 // Description returns the tool description
 func (t *VEXMergeTool) Description() string {
 	return "Merge and consolidate multiple VEX documents into a unified security assessment report. This tool can merge vulnerability statements from different sources, teams, or vendors into a single authoritative VEX document. Supports filtering by products or vulnerabilities."
@@ -30,50 +36,107 @@ func (t *VEXMergeTool) Description() string {
 
 // InputSchema returns the JSON schema for tool input
 func (t *VEXMergeTool) InputSchema() *api.JSONSchema {
+	properties := addOutputProperties(mergeOptionProperties())
+	properties["documents"] = &api.JSONSchema{
+		Type:        "array",
+		Description: "Collection of VEX documents to merge from different sources (vendors, teams, previous assessments). Each must be a complete OpenVEX-formatted document.",
+		Items: &api.JSONSchema{
+			Type:        "object",
+			Description: "Complete OpenVEX document containing vulnerability assessments. Must include @context for format version, statements array with vulnerability assessments, and document metadata.",
+		},
+	}
+	properties["include_source_hashes"] = &api.JSONSchema{
+		Type:        "boolean",
+		Description: fmt.Sprintf("Record the canonical hash of each input document, with its position and @id, in the merged document's '%s' extension field, so consumers can verify which exact inputs produced the merge. This is an extension, not part of the OpenVEX specification.", vex.SourceHashesExtension),
+		Default:     false,
+	}
+
 	return &api.JSONSchema{
-		Type: "object",
-		Properties: map[string]*api.JSONSchema{
-			"documents": {
-				Type:        "array",
-				Description: "Collection of VEX documents to merge from different sources (vendors, teams, previous assessments). Each must be a complete OpenVEX-formatted document.",
-				Items: &api.JSONSchema{
-					Type:        "object",
-					Description: "Complete OpenVEX document containing vulnerability assessments. Must include @context for format version, statements array with vulnerability assessments, and document metadata.",
-				},
-			},
-			"author": {
-				Type:        "string",
-				Description: "Security analyst, team, or organization responsible for this vulnerability assessment (e.g., security-team@company.com, John Doe, ACME Security Team)",
-			},
-			"author_role": {
+		Type:       "object",
+		Properties: properties,
+		Required:   []string{"documents"},
+	}
+}
+
+// mergeOptionProperties returns the schema for the optional metadata and
+// filter arguments shared by the merge tools
+func mergeOptionProperties() map[string]*api.JSONSchema {
+	return map[string]*api.JSONSchema{
+		"author": {
+			Type:        "string",
+			Description: "Security analyst, team, or organization responsible for this vulnerability assessment (e.g., security-team@company.com, John Doe, ACME Security Team)",
+		},
+		"author_role": {
+			Type:        "string",
+			Description: "Role or title of the person creating the merged document (e.g., 'Security Engineer', 'Vulnerability Manager', 'CISO')",
+		},
+		"id": {
+			Type:        "string",
+			Description: "Custom identifier for the new merged VEX document. If not provided, a unique ID will be automatically generated.",
+		},
+		"labels": labelsProperty(),
+		"validate_result": {
+			Type:        "boolean",
+			Description: "Fail the merge if any merged statement is invalid according to OpenVEX rules. When false, invalid statements are reported as warnings alongside the merged document.",
+			Default:     false,
+		},
+		"max_products": {
+			Type:        "integer",
+			Description: "Fail the merge if the result, after filtering, covers more than this many distinct products. Enforces per-document product scoping; omit for no cap.",
+		},
+		"record_provenance": {
+			Type:        "boolean",
+			Description: fmt.Sprintf("Record this merge (time and source document IDs) in the merged document's '%s' extension field. Provenance carried by the sources is kept and this merge appended, so repeated merges build an audit trail. This is an extension, not part of the OpenVEX specification.", vex.ProvenanceExtension),
+			Default:     false,
+		},
+		"keep_most_severe": {
+			Type:        "boolean",
+			Description: fmt.Sprintf("Resolve statements covering the same vulnerability and product by keeping only the most severe status (affected, then under_investigation, fixed, not_affected; the newest on a tie). The overridden statements are kept, one per product, in the merged document's '%s' extension field for traceability. This is an extension, not part of the OpenVEX specification.", vex.OverriddenExtension),
+			Default:     false,
+		},
+		"group_by_vulnerability": {
+			Type:        "boolean",
+			Description: "Combine statements that share a vulnerability, status, justification, impact statement, and action statement into one statement listing all their products. Statements with differing statuses are never combined.",
+			Default:     false,
+		},
+		"timestamp_strategy": {
+			Type:        "string",
+			Description: "How to set the merged document timestamp: 'now' uses the merge time, 'latest_source' and 'earliest_source' use the latest or earliest source document timestamp.",
+			Enum:        vex.TimestampStrategies(),
+			Default:     vex.TimestampStrategyNow,
+		},
+		"products": {
+			Type:        "array",
+			Description: "Filter merge to only include vulnerability statements for these specific products. Useful for creating product-specific security reports.",
+			Items: &api.JSONSchema{
 				Type:        "string",
-				Description: "Role or title of the person creating the merged document (e.g., 'Security Engineer', 'Vulnerability Manager', 'CISO')",
+				Description: "Product identifier in PURL format",
 			},
-			"id": {
+		},
+		"vulnerabilities": {
+			Type:        "array",
+			Description: "Filter merge to only include statements for these specific vulnerabilities. Useful for creating vulnerability-specific impact reports across multiple products.",
+			Items: &api.JSONSchema{
 				Type:        "string",
-				Description: "Custom identifier for the new merged VEX document. If not provided, a unique ID will be automatically generated.",
-			},
-			"products": {
-				Type:        "array",
-				Description: "Filter merge to only include vulnerability statements for these specific products. Useful for creating product-specific security reports.",
-				Items: &api.JSONSchema{
-					Type:        "string",
-					Description: "Product identifier in PURL format",
-				},
+				Description: "Security vulnerability identifier from CVE, GHSA, or other vulnerability databases",
 			},
-			"vulnerabilities": {
-				Type:        "array",
-				Description: "Filter merge to only include statements for these specific vulnerabilities. Useful for creating vulnerability-specific impact reports across multiple products.",
-				Items: &api.JSONSchema{
-					Type:        "string",
-					Description: "Security vulnerability identifier from CVE, GHSA, or other vulnerability databases",
-				},
+		},
+		"statuses": {
+			Type:        "array",
+			Description: "Filter merge to only include statements with these statuses, e.g. only 'affected' for a remediation report. Combines with the products and vulnerabilities filters; a statement must match all of them.",
+			Items: &api.JSONSchema{
+				Type: "string",
+				Enum: statusValues,
 			},
 		},
-		Required: []string{"documents"},
 	}
 }
 
+// OutputSchema returns the JSON schema for the tool's structured content
+func (t *VEXMergeTool) OutputSchema() *api.JSONSchema {
+	return vexDocumentOutputSchema()
+}
+
 // Execute executes the tool with the given arguments
 func (t *VEXMergeTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
 	// Parse input
@@ -81,27 +144,52 @@ func (t *VEXMergeTool) Execute(ctx context.Context, args map[string]interface{})
 	if err != nil {
 		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
 	}
+	input.IncludeSourceHashes, _ = args["include_source_hashes"].(bool)
 
-	// Merge VEX documents (no context needed with simplified client)
-	doc, err := t.client.MergeDocuments(input)
+	// Merge VEX documents, stopping early if the request is cancelled
+	doc, err := t.client.MergeDocuments(ctx, input)
 	if err != nil {
 		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
 	}
 
 	// Format output as JSON
-	output, err := formatVEXDocument(doc)
+	opts, err := parseOutputOptions(args, t.client)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	output, err := opts.format(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: failed to format VEX document: %s", err.Error())), nil
+	}
+	structured, err := structuredDocument(doc)
 	if err != nil {
 		return errorResult(fmt.Sprintf("Error: failed to format VEX document: %s", err.Error())), nil
 	}
 
-	return &api.ToolResult{
+	return withValidationWarnings(&api.ToolResult{
 		Content: []api.Content{
 			{
 				Type: "text",
-				Text: fmt.Sprintf("VEX documents merged successfully:\n\n%s", output),
+				Text: opts.text("VEX documents merged successfully:", output),
 			},
 		},
-	}, nil
+		StructuredContent: structured,
+	}, doc), nil
+}
+
+// withValidationWarnings appends a content item listing the invalid
+// statements of a merged document, if any
+func withValidationWarnings(result *api.ToolResult, doc *vex.Document) *api.ToolResult {
+	issues := vex.ValidateStatements(doc.Statements)
+	if len(issues) == 0 {
+		return result
+	}
+
+	result.Content = append(result.Content, api.Content{
+		Type: "text",
+		Text: fmt.Sprintf("Validation warnings (%d):\n- %s", len(issues), strings.Join(issues, "\n- ")),
+	})
+	return result
 }
 
 // parseMergeInput parses and validates merge tool arguments
@@ -109,26 +197,22 @@ func parseMergeInput(args map[string]interface{}) (*vex.MergeInput, error) {
 	input := &vex.MergeInput{}
 
 	// Required: documents array
-	docsInterface, ok := args["documents"]
-	if !ok {
-		return nil, fmt.Errorf("documents field is required")
+	docs, err := parseDocumentsArg(args, "documents")
+	if err != nil {
+		return nil, err
 	}
+	input.Documents = docs
 
-	docsArray, ok := docsInterface.([]interface{})
-	if !ok {
-		return nil, fmt.Errorf("documents must be an array")
+	if err := parseMergeOptions(args, input); err != nil {
+		return nil, err
 	}
 
-	// Convert each document to map[string]interface{}
-	for i, docInterface := range docsArray {
-		docMap, ok := docInterface.(map[string]interface{})
-		if !ok {
-			return nil, fmt.Errorf("document %d must be a valid JSON object", i+1)
-		}
-		input.Documents = append(input.Documents, docMap)
-	}
+	return input, nil
+}
 
-	// Optional fields
+// parseMergeOptions parses the optional metadata and filter arguments shared
+// by the merge tools
+func parseMergeOptions(args map[string]interface{}, input *vex.MergeInput) error {
 	if author, ok := args["author"].(string); ok {
 		input.Author = author
 	}
@@ -141,27 +225,34 @@ func parseMergeInput(args map[string]interface{}) (*vex.MergeInput, error) {
 		input.ID = id
 	}
 
-	// Optional products filter
-	if productsInterface, ok := args["products"]; ok {
-		if productsArray, ok := productsInterface.([]interface{}); ok {
-			for _, p := range productsArray {
-				if product, ok := p.(string); ok {
-					input.Products = append(input.Products, product)
-				}
-			}
+	// Optional products and vulnerabilities filters
+	input.Products = parseStringArray(args, "products")
+	input.Vulnerabilities = parseStringArray(args, "vulnerabilities")
+	input.Statuses = parseStringArray(args, "statuses")
+	input.ValidateResult, _ = args["validate_result"].(bool)
+	input.GroupByVulnerability, _ = args["group_by_vulnerability"].(bool)
+	input.RecordProvenance, _ = args["record_provenance"].(bool)
+	input.KeepMostSevere, _ = args["keep_most_severe"].(bool)
+
+	if _, ok := args["max_products"]; ok {
+		maxProducts, err := parseIntArg(args, "max_products")
+		if err != nil {
+			return err
 		}
+		input.MaxProducts = maxProducts
 	}
 
-	// Optional vulnerabilities filter
-	if vulnsInterface, ok := args["vulnerabilities"]; ok {
-		if vulnsArray, ok := vulnsInterface.([]interface{}); ok {
-			for _, v := range vulnsArray {
-				if vuln, ok := v.(string); ok {
-					input.Vulnerabilities = append(input.Vulnerabilities, vuln)
-				}
-			}
-		}
+	labels, err := parseLabelsArg(args)
+	if err != nil {
+		return err
 	}
+	input.Labels = labels
 
-	return input, nil
+	strategy, err := parseEnumArg(args, "timestamp_strategy", vex.TimestampStrategies())
+	if err != nil {
+		return err
+	}
+	input.TimestampStrategy = strategy
+
+	return nil
 }
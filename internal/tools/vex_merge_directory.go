@@ -0,0 +1,117 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXDirectoryMergeTool implements the merge_vex_directory MCP tool
+type VEXDirectoryMergeTool struct {
+	client    *vex.Client
+	directory string
+}
+
+// NewVEXDirectoryMergeTool creates a new VEX directory merge tool reading
+// from directory by default. Access is confined to directory; clients that
+// expose filesystem roots can only narrow it further.
+func NewVEXDirectoryMergeTool(client *vex.Client, directory string) *VEXDirectoryMergeTool {
+	return &VEXDirectoryMergeTool{client: client, directory: directory}
+}
+
+// Name returns the tool name
+func (t *VEXDirectoryMergeTool) Name() string {
+	return "merge_vex_directory"
+}
+
+// Description returns the tool description
+func (t *VEXDirectoryMergeTool) Description() string {
+	return "Merge every VEX document (*.vex.json) in a directory into a single consolidated document. Reads the server's configured directory unless a directory within it is given; when the client exposes filesystem roots, the directory must also be within one of them. Intended for large nightly consolidations that exceed the merge_vex_documents limit. Supports filtering by products or vulnerabilities."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXDirectoryMergeTool) InputSchema() *api.JSONSchema {
+	properties := addOutputProperties(mergeOptionProperties())
+	properties["directory"] = &api.JSONSchema{
+		Type:        "string",
+		Description: "Directory to read *.vex.json documents from. Must be within the server's configured directory and, when the client exposes roots, within one of them. Defaults to the configured directory.",
+	}
+
+	return &api.JSONSchema{
+		Type:       "object",
+		Properties: properties,
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXDirectoryMergeTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	input := &vex.MergeInput{}
+	if err := parseMergeOptions(args, input); err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	dir, err := t.resolveDirectory(ctx, args)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	doc, err := t.client.MergeDirectory(ctx, dir, input)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	// Format output as JSON
+	opts, err := parseOutputOptions(args, t.client)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	output, err := opts.format(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: failed to format VEX document: %s", err.Error())), nil
+	}
+
+	return withValidationWarnings(&api.ToolResult{
+		Content: []api.Content{
+			{
+				Type: "text",
+				Text: opts.text("VEX directory merged successfully:", output),
+			},
+		},
+	}, doc), nil
+}
+
+// resolveDirectory returns the directory to merge, resolved against the
+// client's file root. It must lie within the configured directory and, when
+// the client listed roots, within one of them too; roots never widen access.
+func (t *VEXDirectoryMergeTool) resolveDirectory(ctx context.Context, args map[string]interface{}) (string, error) {
+	if t.directory == "" {
+		return "", fmt.Errorf("no directory is configured")
+	}
+	configured, err := t.client.ResolvePath("directory", t.directory)
+	if err != nil {
+		return "", err
+	}
+
+	dir, _ := args["directory"].(string)
+	if dir == "" {
+		dir = t.directory
+	}
+	if err := vex.ValidateRequired("directory", dir); err != nil {
+		return "", fmt.Errorf("validation error: %w", err)
+	}
+	dir, err = t.client.ResolvePath("directory", dir)
+	if err != nil {
+		return "", err
+	}
+	if err := vex.ValidateWithinDirectories("directory", dir, []string{configured}); err != nil {
+		return "", fmt.Errorf("validation error: %w", err)
+	}
+	if roots := api.RootsFromContext(ctx); len(roots) > 0 {
+		if err := vex.ValidateWithinDirectories("directory", dir, roots); err != nil {
+			return "", fmt.Errorf("validation error: %w", err)
+		}
+	}
+	return dir, nil
+}
@@ -0,0 +1,85 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXMergeMixedTool implements the merge_mixed_formats MCP tool
+type VEXMergeMixedTool struct {
+	client *vex.Client
+}
+
+// NewVEXMergeMixedTool creates a new mixed-format VEX merge tool
+func NewVEXMergeMixedTool(client *vex.Client) *VEXMergeMixedTool {
+	return &VEXMergeMixedTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXMergeMixedTool) Name() string {
+	return "merge_mixed_formats"
+}
+
+// Description returns the tool description
+func (t *VEXMergeMixedTool) Description() string {
+	return "Merge VEX documents given in OpenVEX, CSAF VEX, or CycloneDX VEX format into a single OpenVEX document. Each input's format is detected from its characteristic fields and non-OpenVEX inputs are converted before merging. Reports which inputs were converted. Supports the same options as merge_vex_documents."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXMergeMixedTool) InputSchema() *api.JSONSchema {
+	properties := addOutputProperties(mergeOptionProperties())
+	properties["documents"] = &api.JSONSchema{
+		Type:        "array",
+		Description: "Collection of VEX documents to merge, each a complete OpenVEX, CSAF (document.csaf_version), or CycloneDX (bomFormat) document.",
+		Items: &api.JSONSchema{
+			Type:        "object",
+			Description: "Complete VEX document in OpenVEX, CSAF, or CycloneDX format.",
+		},
+	}
+
+	return &api.JSONSchema{
+		Type:       "object",
+		Properties: properties,
+		Required:   []string{"documents"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXMergeMixedTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	input, err := parseMergeInput(args)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	doc, conversions, err := t.client.MergeMixedFormats(ctx, input)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	opts, err := parseOutputOptions(args, t.client)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	output, err := opts.format(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: failed to format VEX document: %s", err.Error())), nil
+	}
+
+	lines := []string{fmt.Sprintf("VEX documents merged successfully, %d converted:", len(conversions))}
+	for _, conversion := range conversions {
+		lines = append(lines, fmt.Sprintf("- document %d converted from %s", conversion.Document, conversion.Format))
+	}
+
+	return withValidationWarnings(&api.ToolResult{
+		Content: []api.Content{
+			{
+				Type: "text",
+				Text: opts.text(strings.Join(lines, "\n"), output),
+			},
+		},
+	}, doc), nil
+}
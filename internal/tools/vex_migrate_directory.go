@@ -0,0 +1,92 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXDirectoryMigrateTool implements the migrate_vex_directory MCP tool
+type VEXDirectoryMigrateTool struct {
+	client    *vex.Client
+	directory string
+}
+
+// NewVEXDirectoryMigrateTool creates a new VEX directory migration tool for directory
+func NewVEXDirectoryMigrateTool(client *vex.Client, directory string) *VEXDirectoryMigrateTool {
+	return &VEXDirectoryMigrateTool{client: client, directory: directory}
+}
+
+// Name returns the tool name
+func (t *VEXDirectoryMigrateTool) Name() string {
+	return "migrate_vex_directory"
+}
+
+// Description returns the tool description
+func (t *VEXDirectoryMigrateTool) Description() string {
+	return "Migrate every VEX document (*.vex.json) in the server's configured directory to a target OpenVEX @context, including legacy v0.0.1 documents. Migrated documents are written back in place, or every document is written to output_dir. Returns a per-file migration report."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXDirectoryMigrateTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"target_context": {
+				Type:        "string",
+				Description: "OpenVEX @context to migrate documents to. Defaults to the current versioned context.",
+				Enum:        vex.SupportedMigrationContexts(),
+			},
+			"output_dir": {
+				Type:        "string",
+				Description: "Directory, relative to the configured directory, to write migrated documents to instead of rewriting them in place.",
+				Examples:    []interface{}{"migrated"},
+			},
+		},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXDirectoryMigrateTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	targetContext, err := parseEnumArg(args, "target_context", vex.SupportedMigrationContexts())
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	outputDir, _ := args["output_dir"].(string)
+	if err := t.checkRoots(ctx, outputDir); err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	report, err := t.client.MigrateDirectory(t.directory, targetContext, outputDir)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	return jsonResult(fmt.Sprintf("Migrated %d of %d document(s), %d failed:", report.Migrated, len(report.Files), report.Failed), report, t.client.MaxOutputBytes()), nil
+}
+
+// checkRoots checks that the configured directory and the output directory,
+// which documents are written to, lie within one of the client's roots when
+// it listed any; roots never widen access
+func (t *VEXDirectoryMigrateTool) checkRoots(ctx context.Context, outputDir string) error {
+	roots := api.RootsFromContext(ctx)
+	if len(roots) == 0 || t.directory == "" {
+		return nil
+	}
+	dir, err := t.client.ResolvePath("directory", t.directory)
+	if err != nil {
+		return err
+	}
+	if err := vex.ValidateWithinDirectories("directory", dir, roots); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+	if outputDir != "" {
+		if err := vex.ValidateWithinDirectories("output_dir", filepath.Join(dir, outputDir), roots); err != nil {
+			return fmt.Errorf("validation error: %w", err)
+		}
+	}
+	return nil
+}
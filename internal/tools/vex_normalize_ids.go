@@ -0,0 +1,84 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXNormalizeIDsTool implements the normalize_vex_ids MCP tool
+type VEXNormalizeIDsTool struct {
+	client *vex.Client
+}
+
+// NewVEXNormalizeIDsTool creates a new VEX identifier normalization tool
+func NewVEXNormalizeIDsTool(client *vex.Client) *VEXNormalizeIDsTool {
+	return &VEXNormalizeIDsTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXNormalizeIDsTool) Name() string {
+	return "normalize_vex_ids"
+}
+
+// Description returns the tool description
+func (t *VEXNormalizeIDsTool) Description() string {
+	return "Canonicalize the CVE and GHSA identifiers of an existing VEX document, in vulnerability names and aliases, so they match consistently (e.g., cve-2023-1234 becomes CVE-2023-1234). Other identifiers are left untouched. Optionally also sorts the products of each statement for stable diffs."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXNormalizeIDsTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: addOutputProperties(map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document whose identifiers to normalize.",
+			},
+			"sort_products": {
+				Type:        "boolean",
+				Description: "Also sort the products of each statement, and their subcomponents, by identifier, as sort_statement_products does.",
+				Default:     false,
+			},
+		}),
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXNormalizeIDsTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	raw, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	doc, changed, err := t.client.NormalizeIDs(raw)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	message := fmt.Sprintf("VEX identifiers normalized, %d changed:", changed)
+	if sortProducts, _ := args["sort_products"].(bool); sortProducts {
+		reordered := vex.SortProducts(doc.Statements)
+		message = fmt.Sprintf("VEX identifiers normalized, %d changed, %d statement(s) with products reordered:", changed, reordered)
+	}
+
+	opts, err := parseOutputOptions(args, t.client)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	output, err := opts.format(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: failed to format VEX document: %s", err.Error())), nil
+	}
+
+	return &api.ToolResult{
+		Content: []api.Content{
+			{
+				Type: "text",
+				Text: opts.text(message, output),
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,67 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXPatchTool implements the vex_patch MCP tool
+type VEXPatchTool struct {
+	client *vex.Client
+}
+
+// NewVEXPatchTool creates a new VEX patch tool
+func NewVEXPatchTool(client *vex.Client) *VEXPatchTool {
+	return &VEXPatchTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXPatchTool) Name() string {
+	return "vex_patch"
+}
+
+// Description returns the tool description
+func (t *VEXPatchTool) Description() string {
+	return "Compute a compact patch describing the changes from a base VEX document to a target document, for syncing documents between systems. Statements are compared per (vulnerability, product) pair and reported as added, removed, or modified; changed document metadata is included. Apply the patch with apply_vex_patch."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXPatchTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"base": {
+				Type:        "object",
+				Description: "OpenVEX document the patch applies to.",
+			},
+			"target": {
+				Type:        "object",
+				Description: "OpenVEX document the patch produces.",
+			},
+		},
+		Required: []string{"base", "target"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXPatchTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	base, err := parseDocumentArg(args, "base")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	target, err := parseDocumentArg(args, "target")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	patch, err := t.client.DiffDocuments(base, target)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	return jsonResult(fmt.Sprintf("Patch with %d added, %d removed, and %d modified pair(s):",
+		len(patch.Added), len(patch.Removed), len(patch.Modified)), patch, t.client.MaxOutputBytes()), nil
+}
@@ -0,0 +1,82 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXPatchMetadataTool implements the patch_vex_metadata MCP tool
+type VEXPatchMetadataTool struct {
+	client *vex.Client
+}
+
+// NewVEXPatchMetadataTool creates a new VEX metadata patch tool
+func NewVEXPatchMetadataTool(client *vex.Client) *VEXPatchMetadataTool {
+	return &VEXPatchMetadataTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXPatchMetadataTool) Name() string {
+	return "patch_vex_metadata"
+}
+
+// Description returns the tool description
+func (t *VEXPatchMetadataTool) Description() string {
+	return "Apply a JSON Merge Patch (RFC 7386) to the top-level metadata of a VEX document, such as author, role, @id, or labels. Null values remove fields and nested objects are merged. Statements cannot be patched. The patched author, role, and @id are validated before the updated document is returned."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXPatchMetadataTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: addOutputProperties(map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document to patch.",
+			},
+			"patch": {
+				Type:        "object",
+				Description: "JSON Merge Patch applied to the document's top-level fields, e.g. {\"author\": \"Security Team\", \"role\": null}. Must not contain statements.",
+			},
+		}),
+		Required: []string{"document", "patch"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXPatchMetadataTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	raw, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	patch, err := parseDocumentArg(args, "patch")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	doc, err := t.client.PatchMetadata(raw, patch)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	opts, err := parseOutputOptions(args, t.client)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	output, err := opts.format(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: failed to format VEX document: %s", err.Error())), nil
+	}
+
+	return &api.ToolResult{
+		Content: []api.Content{
+			{
+				Type: "text",
+				Text: opts.text("VEX metadata patched:", output),
+			},
+		},
+	}, nil
+}
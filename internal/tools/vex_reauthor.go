@@ -0,0 +1,84 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXReauthorTool implements the reauthor_vex_document MCP tool
+type VEXReauthorTool struct {
+	client *vex.Client
+}
+
+// NewVEXReauthorTool creates a new VEX re-authoring tool
+func NewVEXReauthorTool(client *vex.Client) *VEXReauthorTool {
+	return &VEXReauthorTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXReauthorTool) Name() string {
+	return "reauthor_vex_document"
+}
+
+// Description returns the tool description
+func (t *VEXReauthorTool) Description() string {
+	return "Transfer ownership of a VEX document by rewriting its author everywhere: the document author and the author field of any statement that carries one. Optionally replaces the author role. Refreshes last_updated and returns the updated document with the number of locations changed."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXReauthorTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: addOutputProperties(map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document to re-author.",
+			},
+			"author": {
+				Type:        "string",
+				Description: "New author taking ownership of the document (e.g., security-team@company.com, ACME Security Team)",
+			},
+			"author_role": {
+				Type:        "string",
+				Description: "New role of the author. If omitted, the existing role is kept.",
+			},
+		}),
+		Required: []string{"document", "author"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXReauthorTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	raw, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	author, _ := args["author"].(string)
+	role, _ := args["author_role"].(string)
+
+	doc, updated, err := t.client.Reauthor(raw, author, role)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	opts, err := parseOutputOptions(args, t.client)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	output, err := opts.format(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: failed to format VEX document: %s", err.Error())), nil
+	}
+
+	return &api.ToolResult{
+		Content: []api.Content{
+			{
+				Type: "text",
+				Text: opts.text(fmt.Sprintf("VEX document re-authored, %d location(s) updated:", updated), output),
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,97 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXRemoveStatementTool implements the remove_vex_statement MCP tool
+type VEXRemoveStatementTool struct {
+	client *vex.Client
+}
+
+// NewVEXRemoveStatementTool creates a new VEX statement removal tool
+func NewVEXRemoveStatementTool(client *vex.Client) *VEXRemoveStatementTool {
+	return &VEXRemoveStatementTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXRemoveStatementTool) Name() string {
+	return "remove_vex_statement"
+}
+
+// Description returns the tool description
+func (t *VEXRemoveStatementTool) Description() string {
+	return "Remove stale statements from a VEX document, either the statement at a 0-based index or a vulnerability and product pair. By pair, the product is dropped from each matching statement and statements left without products are removed. Returns the updated document with a refreshed last_updated and the number of statements removed."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXRemoveStatementTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: addOutputProperties(map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document to remove statements from.",
+			},
+			"index": {
+				Type:        "integer",
+				Description: "0-based index of the statement to remove. Cannot be combined with vulnerability and product.",
+			},
+			"vulnerability": {
+				Type:        "string",
+				Description: "Vulnerability identifier of the statements to remove, together with product.",
+			},
+			"product": {
+				Type:        "string",
+				Description: "Product identifier to remove from statements for the vulnerability, together with vulnerability.",
+			},
+		}),
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXRemoveStatementTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	raw, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	input := &vex.RemoveInput{}
+	if _, ok := args["index"]; ok {
+		index, err := parseIntArg(args, "index")
+		if err != nil {
+			return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+		}
+		input.Index = &index
+	}
+	input.Vulnerability, _ = args["vulnerability"].(string)
+	input.Product, _ = args["product"].(string)
+
+	doc, removed, err := t.client.RemoveStatements(raw, input)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	opts, err := parseOutputOptions(args, t.client)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	output, err := opts.format(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: failed to format VEX document: %s", err.Error())), nil
+	}
+
+	return &api.ToolResult{
+		Content: []api.Content{
+			{
+				Type: "text",
+				Text: opts.text(fmt.Sprintf("VEX statements removed, %d removed:", removed), output),
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,90 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXReplaceStatementTool implements the replace_vex_statement MCP tool
+type VEXReplaceStatementTool struct {
+	client *vex.Client
+}
+
+// NewVEXReplaceStatementTool creates a new VEX statement replacement tool
+func NewVEXReplaceStatementTool(client *vex.Client) *VEXReplaceStatementTool {
+	return &VEXReplaceStatementTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXReplaceStatementTool) Name() string {
+	return "replace_vex_statement"
+}
+
+// Description returns the tool description
+func (t *VEXReplaceStatementTool) Description() string {
+	return "Replace the statement at a 0-based index of a VEX document with a new statement, specified with the same fields as create_vex_statement and validated the same way. Returns the updated document with a refreshed last_updated."
+}
+
+// InputSchema returns the JSON schema for tool input. The statement fields
+// are those of create_vex_statement, less the document-level ones.
+func (t *VEXReplaceStatementTool) InputSchema() *api.JSONSchema {
+	schema := NewVEXCreateTool(t.client).InputSchema()
+	for _, name := range []string{"author", "author_role", "labels"} {
+		delete(schema.Properties, name)
+	}
+	schema.Properties["document"] = &api.JSONSchema{
+		Type:        "object",
+		Description: "Complete OpenVEX document containing the statement to replace.",
+	}
+	schema.Properties["index"] = &api.JSONSchema{
+		Type:        "integer",
+		Description: "0-based index of the statement to replace.",
+	}
+	schema.Required = append([]string{"document", "index"}, schema.Required...)
+	return schema
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXReplaceStatementTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	doc, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	index, err := parseIntArg(args, "index")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	input, err := parseCreateInput(args, t.client.LenientJustifications())
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	input.Author, input.AuthorRole, input.Labels = "", "", nil
+
+	updated, err := t.client.ReplaceStatement(doc, index, input)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	opts, err := parseOutputOptions(args, t.client)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	output, err := opts.format(updated)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: failed to format VEX document: %s", err.Error())), nil
+	}
+
+	return &api.ToolResult{
+		Content: []api.Content{
+			{
+				Type: "text",
+				Text: opts.text(fmt.Sprintf("Statement %d replaced successfully:", index), output),
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,69 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXReportMarkdownTool implements the vex_report_markdown MCP tool
+type VEXReportMarkdownTool struct {
+	client *vex.Client
+}
+
+// NewVEXReportMarkdownTool creates a new VEX Markdown report tool
+func NewVEXReportMarkdownTool(client *vex.Client) *VEXReportMarkdownTool {
+	return &VEXReportMarkdownTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXReportMarkdownTool) Name() string {
+	return "vex_report_markdown"
+}
+
+// Description returns the tool description
+func (t *VEXReportMarkdownTool) Description() string {
+	return "Render one or more VEX documents as a human-readable Markdown report for security leads: a status summary table, a section per affected vulnerability with its products and remediation, and the justifications of not_affected statements. Returns the Markdown as text."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXReportMarkdownTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"documents": {
+				Type:        "array",
+				Description: fmt.Sprintf("OpenVEX documents to summarize, up to %d.", vex.MaxMergeDocuments),
+				Items: &api.JSONSchema{
+					Type:        "object",
+					Description: "Complete OpenVEX document.",
+				},
+			},
+		},
+		Required: []string{"documents"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXReportMarkdownTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	docs, err := parseDocumentsArg(args, "documents")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	report, err := t.client.MarkdownReport(docs)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	return &api.ToolResult{
+		Content: []api.Content{
+			{
+				Type: "text",
+				Text: report,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,102 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXScaffoldTool implements the scaffold_vex_document MCP tool
+type VEXScaffoldTool struct {
+	client *vex.Client
+}
+
+// NewVEXScaffoldTool creates a new VEX scaffold tool
+func NewVEXScaffoldTool(client *vex.Client) *VEXScaffoldTool {
+	return &VEXScaffoldTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXScaffoldTool) Name() string {
+	return "scaffold_vex_document"
+}
+
+// Description returns the tool description
+func (t *VEXScaffoldTool) Description() string {
+	return "Generate a VEX document skeleton to jump-start triage: one under_investigation statement per vulnerability for a single product. Refine the statements afterwards as each vulnerability is assessed."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXScaffoldTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: addOutputProperties(map[string]*api.JSONSchema{
+			"product": {
+				Type:        "string",
+				Description: "Software product identifier using PURL (Package URL) format, e.g., pkg:oci/app@sha256:abc",
+				Examples:    []interface{}{"pkg:npm/lodash@4.17.21", "pkg:docker/nginx@1.20.1"},
+			},
+			"vulnerabilities": {
+				Type:        "array",
+				Description: fmt.Sprintf("Vulnerability identifiers to create statements for (at most %d). Repeated identifiers get a single statement.", vex.MaxBatchItems),
+				Items: &api.JSONSchema{
+					Type:        "string",
+					Description: "Security vulnerability identifier from CVE, GHSA, or other vulnerability databases",
+				},
+			},
+			"author": {
+				Type:        "string",
+				Description: "Name or identifier of the person or organization creating this VEX document",
+			},
+			"author_role": {
+				Type:        "string",
+				Description: "Role or title of the author (e.g., 'Security Engineer', 'Product Security Team')",
+			},
+		}),
+		Required: []string{"product", "vulnerabilities"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXScaffoldTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	product, ok := args["product"].(string)
+	if !ok {
+		return errorResult("Error: product is required and must be a string"), nil
+	}
+	if _, ok := args["vulnerabilities"].([]interface{}); !ok {
+		return errorResult("Error: vulnerabilities is required and must be an array"), nil
+	}
+	author, _ := args["author"].(string)
+	authorRole, _ := args["author_role"].(string)
+
+	doc, err := t.client.ScaffoldDocument(&vex.ScaffoldInput{
+		Product:         product,
+		Vulnerabilities: parseStringArray(args, "vulnerabilities"),
+		Author:          author,
+		AuthorRole:      authorRole,
+	})
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	// Format output as JSON
+	opts, err := parseOutputOptions(args, t.client)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	output, err := opts.format(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: failed to format VEX document: %s", err.Error())), nil
+	}
+
+	return &api.ToolResult{
+		Content: []api.Content{
+			{
+				Type: "text",
+				Text: opts.text(fmt.Sprintf("VEX document scaffolded with %d statement(s):", len(doc.Statements)), output),
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,74 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXSortProductsTool implements the sort_statement_products MCP tool
+type VEXSortProductsTool struct {
+	client *vex.Client
+}
+
+// NewVEXSortProductsTool creates a new VEX product ordering tool
+func NewVEXSortProductsTool(client *vex.Client) *VEXSortProductsTool {
+	return &VEXSortProductsTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXSortProductsTool) Name() string {
+	return "sort_statement_products"
+}
+
+// Description returns the tool description
+func (t *VEXSortProductsTool) Description() string {
+	return "Canonicalize the product ordering of a VEX document for stable diffs: the products of each statement, and the subcomponents of each product, are sorted by identifier. Returns the updated document and the number of statements reordered."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXSortProductsTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: addOutputProperties(map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document whose products to sort.",
+			},
+		}),
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXSortProductsTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	raw, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	doc, changed, err := t.client.SortStatementProducts(raw)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	opts, err := parseOutputOptions(args, t.client)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	output, err := opts.format(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: failed to format VEX document: %s", err.Error())), nil
+	}
+
+	return &api.ToolResult{
+		Content: []api.Content{
+			{
+				Type: "text",
+				Text: opts.text(fmt.Sprintf("VEX products sorted, %d statement(s) reordered:", changed), output),
+			},
+		},
+	}, nil
+}
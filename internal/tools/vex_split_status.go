@@ -0,0 +1,84 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXSplitStatusTool implements the split_by_status MCP tool
+type VEXSplitStatusTool struct {
+	client *vex.Client
+}
+
+// NewVEXSplitStatusTool creates a new VEX split by status tool
+func NewVEXSplitStatusTool(client *vex.Client) *VEXSplitStatusTool {
+	return &VEXSplitStatusTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXSplitStatusTool) Name() string {
+	return "split_by_status"
+}
+
+// Description returns the tool description
+func (t *VEXSplitStatusTool) Description() string {
+	return "Split a VEX document, such as a merge result, into one document per status present, each containing only that status's statements, for routing to different teams (e.g., affected to remediation, not_affected to informational). Each document keeps the source metadata and gets the source @id suffixed with its status. Returns the number of documents produced followed by each document."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXSplitStatusTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: addOutputProperties(map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document to split by status.",
+			},
+		}),
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXSplitStatusTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	raw, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	parts, err := t.client.SplitByStatus(raw)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	opts, err := parseOutputOptions(args, t.client)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	statuses := make([]string, 0, len(parts))
+	content := make([]api.Content, 0, len(parts)+1)
+	for _, part := range parts {
+		output, err := opts.format(part.Document)
+		if err != nil {
+			return errorResult(fmt.Sprintf("Error: failed to format VEX document: %s", err.Error())), nil
+		}
+		statuses = append(statuses, part.Status)
+		content = append(content, api.Content{
+			Type: "text",
+			Text: opts.text(fmt.Sprintf("Status %s, %d statement(s):", part.Status, len(part.Document.Statements)), output),
+		})
+	}
+
+	summary := fmt.Sprintf("VEX document split into %d document(s) by status", len(parts))
+	if len(statuses) > 0 {
+		summary += ": " + strings.Join(statuses, ", ")
+	}
+	if !opts.jsonOnly {
+		content = append([]api.Content{{Type: "text", Text: summary}}, content...)
+	}
+	return &api.ToolResult{Content: content}, nil
+}
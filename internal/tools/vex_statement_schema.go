@@ -0,0 +1,41 @@
+package tools
+
+import (
+	"context"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXStatementSchemaTool implements the get_statement_schema MCP tool
+type VEXStatementSchemaTool struct {
+	client *vex.Client
+}
+
+// NewVEXStatementSchemaTool creates a new VEX statement schema tool
+func NewVEXStatementSchemaTool(client *vex.Client) *VEXStatementSchemaTool {
+	return &VEXStatementSchemaTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXStatementSchemaTool) Name() string {
+	return "get_statement_schema"
+}
+
+// Description returns the tool description
+func (t *VEXStatementSchemaTool) Description() string {
+	return "Return the JSON Schema of a single VEX statement as accepted by create_vex_statement, batch_create_vex_statements and replace_vex_statement: product, vulnerability, status, justification and the other statement fields, with their allowed enum values. Use it to author statements against the exact schema instead of prose."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXStatementSchemaTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type:       "object",
+		Properties: map[string]*api.JSONSchema{},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXStatementSchemaTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	return jsonResult("VEX statement JSON Schema:", statementSchema(t.client), t.client.MaxOutputBytes()), nil
+}
@@ -0,0 +1,58 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXStatusHistogramTool implements the status_histogram MCP tool
+type VEXStatusHistogramTool struct {
+	client *vex.Client
+}
+
+// NewVEXStatusHistogramTool creates a new VEX status histogram tool
+func NewVEXStatusHistogramTool(client *vex.Client) *VEXStatusHistogramTool {
+	return &VEXStatusHistogramTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXStatusHistogramTool) Name() string {
+	return "status_histogram"
+}
+
+// Description returns the tool description
+func (t *VEXStatusHistogramTool) Description() string {
+	return "Count the statements of a VEX document per status (not_affected, affected, fixed, under_investigation) for quick triage. Returns the counts, the total, and has_affected, which is true when any statement is affected."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXStatusHistogramTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document to count statements of.",
+			},
+		},
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXStatusHistogramTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	doc, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	histogram, err := t.client.StatusHistogram(doc)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	return jsonResult(fmt.Sprintf("Counted %d statement(s):", histogram.Total), histogram, t.client.MaxOutputBytes()), nil
+}
@@ -0,0 +1,74 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// VEXValidateTool implements the validate_vex_document MCP tool
+type VEXValidateTool struct {
+	client *vex.Client
+}
+
+// NewVEXValidateTool creates a new VEX validate tool
+func NewVEXValidateTool(client *vex.Client) *VEXValidateTool {
+	return &VEXValidateTool{client: client}
+}
+
+// Name returns the tool name
+func (t *VEXValidateTool) Name() string {
+	return "validate_vex_document"
+}
+
+// Description returns the tool description
+func (t *VEXValidateTool) Description() string {
+	return "Validate a complete VEX document: its @context, structure, and every statement's status rules. Set version to require the @context and fields of a specific OpenVEX version for consumers pinned to it. A missing author is reported as a warning unless the server requires one."
+}
+
+// InputSchema returns the JSON schema for tool input
+func (t *VEXValidateTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"document": {
+				Type:        "object",
+				Description: "Complete OpenVEX document to validate.",
+			},
+			"version": {
+				Type:        "string",
+				Description: "OpenVEX specification version the document must conform to. The @context must then be that version's locator (e.g., https://openvex.dev/ns/v0.2.0). Any OpenVEX context is accepted when omitted.",
+				Enum:        vex.SupportedSpecVersions(),
+			},
+		},
+		Required: []string{"document"},
+	}
+}
+
+// Execute executes the tool with the given arguments
+func (t *VEXValidateTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	doc, err := parseDocumentArg(args, "document")
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+	version, err := parseEnumArg(args, "version", vex.SupportedSpecVersions())
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	report, err := t.client.ValidateDocument(doc, version)
+	if err != nil {
+		return errorResult(fmt.Sprintf("Error: %s", err.Error())), nil
+	}
+
+	message := "VEX document is valid:"
+	if len(report.Warnings) > 0 {
+		message = fmt.Sprintf("VEX document is valid with %d warning(s):", len(report.Warnings))
+	}
+	if !report.Valid {
+		message = fmt.Sprintf("VEX document is invalid with %d error(s):", len(report.Errors))
+	}
+	return jsonResult(message, report, t.client.MaxOutputBytes()), nil
+}
@@ -0,0 +1,38 @@
+package vex
+
+import (
+	"strings"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// ActionsReport lists the affected statements of a document that lack an
+// action statement
+type ActionsReport struct {
+	Complete bool  `json:"complete"`
+	Affected int   `json:"affected"`
+	Missing  []int `json:"missing"`
+}
+
+// CheckActionsPresent reports the indices of affected statements without a
+// non-blank action statement. This is advisory: documents are not rejected,
+// unlike the checks applied when creating a statement.
+func (c *Client) CheckActionsPresent(raw map[string]interface{}) (*ActionsReport, error) {
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ActionsReport{Missing: []int{}}
+	for i, stmt := range doc.Statements {
+		if stmt.Status != vexlib.StatusAffected {
+			continue
+		}
+		report.Affected++
+		if strings.TrimSpace(stmt.ActionStatement) == "" {
+			report.Missing = append(report.Missing, i)
+		}
+	}
+	report.Complete = len(report.Missing) == 0
+	return report, nil
+}
@@ -0,0 +1,62 @@
+package vex
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckActionsPresent(t *testing.T) {
+	client := NewClient("test-author")
+
+	tests := []struct {
+		name         string
+		doc          string
+		wantMissing  []int
+		wantAffected int
+	}{
+		{
+			name: "mixed affected statements",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "affected", "action_statement": "Upgrade to 1.0.1"},
+					{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "affected"},
+					{"vulnerability": {"name": "CVE-2023-0003"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"},
+					{"vulnerability": {"name": "CVE-2023-0004"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "affected", "action_statement": "   "}
+				]
+			}`,
+			wantMissing:  []int{1, 3},
+			wantAffected: 3,
+		},
+		{
+			name: "all affected statements complete",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "affected", "action_statement": "Upgrade to 1.0.1"},
+					{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "under_investigation"}
+				]
+			}`,
+			wantMissing:  []int{},
+			wantAffected: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := client.CheckActionsPresent(decodeDocument(t, tt.doc))
+			if err != nil {
+				t.Fatalf("CheckActionsPresent() error = %v", err)
+			}
+			if !reflect.DeepEqual(report.Missing, tt.wantMissing) {
+				t.Errorf("CheckActionsPresent() missing = %v, want %v", report.Missing, tt.wantMissing)
+			}
+			if report.Affected != tt.wantAffected {
+				t.Errorf("CheckActionsPresent() affected = %d, want %d", report.Affected, tt.wantAffected)
+			}
+			if report.Complete != (len(tt.wantMissing) == 0) {
+				t.Errorf("CheckActionsPresent() complete = %v", report.Complete)
+			}
+		})
+	}
+}
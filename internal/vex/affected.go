@@ -0,0 +1,101 @@
+package vex
+
+import (
+	"fmt"
+	"sort"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// AffectedProduct is a product marked affected by a vulnerability in one of
+// the queried documents
+type AffectedProduct struct {
+	Product         string `json:"product"`
+	Vulnerability   string `json:"vulnerability"`
+	Document        int    `json:"document"`
+	DocumentID      string `json:"document_id,omitempty"`
+	ActionStatement string `json:"action_statement,omitempty"`
+}
+
+// AffectedInput represents the input for an affected products query
+type AffectedInput struct {
+	Documents     []map[string]interface{}
+	Vulnerability string
+	AliasMap      map[string]string // Maps alias IDs (e.g. GHSA) to a canonical ID (e.g. CVE)
+}
+
+// AffectedProducts returns the products whose statements mark them affected
+// by a vulnerability across documents. Vulnerability names and aliases are
+// resolved through AliasMap before comparison.
+func (c *Client) AffectedProducts(input *AffectedInput) ([]AffectedProduct, error) {
+	if err := ValidateDocumentListCount(len(input.Documents)); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	if err := ValidateRequired("vulnerability", input.Vulnerability); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	if err := ValidateStringLength("vulnerability", input.Vulnerability, MaxStringLength); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	for alias, canonical := range input.AliasMap {
+		if err := ValidateStringLength("alias_map key", alias, MaxIDLength); err != nil {
+			return nil, fmt.Errorf("validation error: %w", err)
+		}
+		if err := ValidateStringLength("alias_map value", canonical, MaxIDLength); err != nil {
+			return nil, fmt.Errorf("validation error: %w", err)
+		}
+	}
+
+	docs, err := parseDocuments(input.Documents)
+	if err != nil {
+		return nil, err
+	}
+
+	resolve := func(id string) string {
+		if canonical, ok := input.AliasMap[id]; ok {
+			return canonical
+		}
+		return id
+	}
+	target := resolve(input.Vulnerability)
+
+	affected := []AffectedProduct{}
+	for i, doc := range docs {
+		for _, stmt := range doc.Statements {
+			if stmt.Status != vexlib.StatusAffected || !matchesVulnerability(stmt.Vulnerability, target, resolve) {
+				continue
+			}
+			for _, product := range productIDs(stmt.Products) {
+				affected = append(affected, AffectedProduct{
+					Product:         product,
+					Vulnerability:   string(stmt.Vulnerability.Name),
+					Document:        i + 1,
+					DocumentID:      doc.ID,
+					ActionStatement: stmt.ActionStatement,
+				})
+			}
+		}
+	}
+
+	sort.SliceStable(affected, func(i, j int) bool {
+		if affected[i].Product != affected[j].Product {
+			return affected[i].Product < affected[j].Product
+		}
+		return affected[i].Document < affected[j].Document
+	})
+	return affected, nil
+}
+
+// matchesVulnerability reports whether a statement's vulnerability name or
+// any of its aliases resolves to the target ID
+func matchesVulnerability(vuln vexlib.Vulnerability, target string, resolve func(string) string) bool {
+	if resolve(string(vuln.Name)) == target {
+		return true
+	}
+	for _, alias := range vuln.Aliases {
+		if resolve(string(alias)) == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package vex
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAffectedProducts(t *testing.T) {
+	client := NewClient("test-author")
+
+	doc1 := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"@id": "doc1",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/lodash@4.17.20"}], "status": "affected", "action_statement": "Upgrade lodash"},
+			{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "fixed"},
+			{"vulnerability": {"name": "CVE-2023-5678"}, "products": [{"@id": "pkg:npm/axios@0.21.0"}], "status": "affected", "action_statement": "Upgrade axios"}
+		]
+	}`)
+	doc2 := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"@id": "doc2",
+		"statements": [
+			{"vulnerability": {"name": "GHSA-jfh8-c2jp-5v3q"}, "products": [{"@id": "pkg:docker/app@1.0.0"}], "status": "affected", "action_statement": "Rebuild image"},
+			{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:docker/app@2.0.0"}], "status": "not_affected", "justification": "component_not_present"}
+		]
+	}`)
+
+	tests := []struct {
+		name         string
+		aliasMap     map[string]string
+		wantProducts []string
+	}{
+		{
+			name:         "exact name only",
+			wantProducts: []string{"pkg:npm/lodash@4.17.20"},
+		},
+		{
+			name:         "aliases resolved through alias map",
+			aliasMap:     map[string]string{"GHSA-jfh8-c2jp-5v3q": "CVE-2023-1234"},
+			wantProducts: []string{"pkg:docker/app@1.0.0", "pkg:npm/lodash@4.17.20"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			affected, err := client.AffectedProducts(&AffectedInput{
+				Documents:     []map[string]interface{}{doc1, doc2},
+				Vulnerability: "CVE-2023-1234",
+				AliasMap:      tt.aliasMap,
+			})
+			if err != nil {
+				t.Fatalf("AffectedProducts() error = %v", err)
+			}
+			if len(affected) != len(tt.wantProducts) {
+				t.Fatalf("AffectedProducts() = %+v, want products %v", affected, tt.wantProducts)
+			}
+			for i, want := range tt.wantProducts {
+				if affected[i].Product != want {
+					t.Errorf("product %d = %v, want %v", i, affected[i].Product, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAffectedProducts_ValidationErrors(t *testing.T) {
+	client := NewClient("test-author")
+
+	tests := []struct {
+		name            string
+		input           *AffectedInput
+		wantErrContains string
+	}{
+		{
+			name:            "no documents",
+			input:           &AffectedInput{Vulnerability: "CVE-2023-1234"},
+			wantErrContains: "at least one document",
+		},
+		{
+			name:            "missing vulnerability",
+			input:           &AffectedInput{Documents: []map[string]interface{}{{}}},
+			wantErrContains: "vulnerability is required",
+		},
+		{
+			name: "unparseable document",
+			input: &AffectedInput{
+				Documents:     []map[string]interface{}{{"statements": "invalid"}},
+				Vulnerability: "CVE-2023-1234",
+			},
+			wantErrContains: "document 1: failed to parse document",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.AffectedProducts(tt.input)
+			if err == nil {
+				t.Fatal("AffectedProducts() expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.wantErrContains) {
+				t.Errorf("AffectedProducts() error = %v, want to contain %v", err.Error(), tt.wantErrContains)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package vex
+
+import (
+	"sort"
+	"strings"
+)
+
+// AliasConflict is a set of identifiers linked through statement aliases
+// that contains more than one identifier from the same namespace, e.g. one
+// CVE aliased to two different GHSAs
+type AliasConflict struct {
+	Identifiers []string            `json:"identifiers"`
+	Conflicting map[string][]string `json:"conflicting"`
+	Statements  []int               `json:"statements"`
+}
+
+// AliasConsistencyReport is the result of an alias consistency check
+type AliasConsistencyReport struct {
+	Consistent bool            `json:"consistent"`
+	Conflicts  []AliasConflict `json:"conflicts"`
+}
+
+// CheckAliasConsistency links every statement's vulnerability name with its
+// aliases and reports linked identifier sets holding two identifiers of the
+// same namespace (the prefix before the first dash, e.g. CVE or GHSA).
+// Listing the same identifiers in reverse order is consistent.
+func (c *Client) CheckAliasConsistency(raw map[string]interface{}) (*AliasConsistencyReport, error) {
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	// Union-find over identifiers
+	parent := map[string]string{}
+	var find func(string) string
+	find = func(id string) string {
+		if _, ok := parent[id]; !ok {
+			parent[id] = id
+		}
+		if parent[id] != id {
+			parent[id] = find(parent[id])
+		}
+		return parent[id]
+	}
+
+	for _, stmt := range doc.Statements {
+		name := string(stmt.Vulnerability.Name)
+		if name == "" {
+			continue
+		}
+		root := find(name)
+		for _, alias := range stmt.Vulnerability.Aliases {
+			if aliasRoot := find(string(alias)); aliasRoot != root {
+				parent[aliasRoot] = root
+			}
+		}
+	}
+
+	groups := map[string][]string{}
+	for id := range parent {
+		root := find(id)
+		groups[root] = append(groups[root], id)
+	}
+	statements := map[string][]int{}
+	for i, stmt := range doc.Statements {
+		if name := string(stmt.Vulnerability.Name); name != "" {
+			root := find(name)
+			statements[root] = append(statements[root], i)
+		}
+	}
+
+	report := &AliasConsistencyReport{Conflicts: []AliasConflict{}}
+	for root, ids := range groups {
+		byNamespace := map[string][]string{}
+		for _, id := range ids {
+			namespace := aliasNamespace(id)
+			byNamespace[namespace] = append(byNamespace[namespace], id)
+		}
+
+		conflicting := map[string][]string{}
+		for namespace, nsIDs := range byNamespace {
+			if len(nsIDs) > 1 {
+				sort.Strings(nsIDs)
+				conflicting[namespace] = nsIDs
+			}
+		}
+		if len(conflicting) == 0 {
+			continue
+		}
+
+		sort.Strings(ids)
+		report.Conflicts = append(report.Conflicts, AliasConflict{
+			Identifiers: ids,
+			Conflicting: conflicting,
+			Statements:  statements[root],
+		})
+	}
+	sort.Slice(report.Conflicts, func(i, j int) bool {
+		return report.Conflicts[i].Identifiers[0] < report.Conflicts[j].Identifiers[0]
+	})
+
+	report.Consistent = len(report.Conflicts) == 0
+	return report, nil
+}
+
+// aliasNamespace returns the upper-cased prefix of a vulnerability
+// identifier, e.g. GHSA for GHSA-xxxx-xxxx-xxxx
+func aliasNamespace(id string) string {
+	namespace, _, _ := strings.Cut(id, "-")
+	return strings.ToUpper(namespace)
+}
@@ -0,0 +1,79 @@
+package vex
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckAliasConsistency(t *testing.T) {
+	client := NewClient("test-author")
+
+	tests := []struct {
+		name          string
+		doc           string
+		wantConflicts []AliasConflict
+	}{
+		{
+			name: "consistent including reversed aliases",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0001", "aliases": ["GHSA-aaaa-bbbb-cccc"]}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"},
+					{"vulnerability": {"name": "GHSA-aaaa-bbbb-cccc", "aliases": ["CVE-2023-0001"]}, "products": [{"@id": "pkg:npm/b@1.0.0"}], "status": "fixed"},
+					{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"}
+				]
+			}`,
+			wantConflicts: []AliasConflict{},
+		},
+		{
+			name: "CVE aliased to two GHSAs",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0001", "aliases": ["GHSA-aaaa-bbbb-cccc"]}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"},
+					{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"},
+					{"vulnerability": {"name": "CVE-2023-0001", "aliases": ["GHSA-xxxx-yyyy-zzzz"]}, "products": [{"@id": "pkg:npm/b@1.0.0"}], "status": "fixed"}
+				]
+			}`,
+			wantConflicts: []AliasConflict{
+				{
+					Identifiers: []string{"CVE-2023-0001", "GHSA-aaaa-bbbb-cccc", "GHSA-xxxx-yyyy-zzzz"},
+					Conflicting: map[string][]string{"GHSA": {"GHSA-aaaa-bbbb-cccc", "GHSA-xxxx-yyyy-zzzz"}},
+					Statements:  []int{0, 2},
+				},
+			},
+		},
+		{
+			name: "GHSA linking two CVEs",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0001", "aliases": ["GHSA-aaaa-bbbb-cccc"]}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"},
+					{"vulnerability": {"name": "GHSA-aaaa-bbbb-cccc", "aliases": ["CVE-2023-0009"]}, "products": [{"@id": "pkg:npm/b@1.0.0"}], "status": "fixed"}
+				]
+			}`,
+			wantConflicts: []AliasConflict{
+				{
+					Identifiers: []string{"CVE-2023-0001", "CVE-2023-0009", "GHSA-aaaa-bbbb-cccc"},
+					Conflicting: map[string][]string{"CVE": {"CVE-2023-0001", "CVE-2023-0009"}},
+					Statements:  []int{0, 1},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := client.CheckAliasConsistency(decodeDocument(t, tt.doc))
+			if err != nil {
+				t.Fatalf("CheckAliasConsistency() error = %v", err)
+			}
+			if !reflect.DeepEqual(report.Conflicts, tt.wantConflicts) {
+				t.Errorf("CheckAliasConsistency() conflicts = %+v, want %+v", report.Conflicts, tt.wantConflicts)
+			}
+			if report.Consistent != (len(tt.wantConflicts) == 0) {
+				t.Errorf("CheckAliasConsistency() consistent = %v, want %v", report.Consistent, len(tt.wantConflicts) == 0)
+			}
+		})
+	}
+}
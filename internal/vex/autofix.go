@@ -0,0 +1,104 @@
+package vex
+
+import (
+	"fmt"
+	"strings"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// statusSynonyms maps common near-miss statuses, already normalized to snake
+// case, to their canonical value
+var statusSynonyms = map[string]vexlib.Status{
+	"unaffected":     vexlib.StatusNotAffected,
+	"not_vulnerable": vexlib.StatusNotAffected,
+	"vulnerable":     vexlib.StatusAffected,
+	"exploitable":    vexlib.StatusAffected,
+	"patched":        vexlib.StatusFixed,
+	"resolved":       vexlib.StatusFixed,
+	"investigating":  vexlib.StatusUnderInvestigation,
+	"in_triage":      vexlib.StatusUnderInvestigation,
+	"triage":         vexlib.StatusUnderInvestigation,
+}
+
+// Fix records one change made by Autofix
+type Fix struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// normalizeStatus maps a status variant such as "Not Affected" or
+// "patched" to its canonical value, returning false when it matches no
+// known status
+func normalizeStatus(status string) (vexlib.Status, bool) {
+	normalized := snakeCase(status)
+	if s := vexlib.Status(normalized); s.Valid() {
+		return s, true
+	}
+	if s, ok := statusSynonyms[normalized]; ok {
+		return s, true
+	}
+	return "", false
+}
+
+// Autofix corrects common mistakes in a document: it trims whitespace,
+// canonicalizes CVE and GHSA identifiers, and maps near-miss statuses and
+// justifications to their canonical values. Values it cannot map are left
+// for validation to report. It returns the corrected document and every
+// change made. Document and statement extension fields are preserved.
+func (c *Client) Autofix(raw map[string]interface{}) (*Document, []Fix, error) {
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fixes := []Fix{}
+	fix := func(field string, value *string, fixed string) {
+		if fixed != *value {
+			fixes = append(fixes, Fix{Field: field, From: *value, To: fixed})
+			*value = fixed
+		}
+	}
+
+	fix("author", &doc.Author, strings.TrimSpace(doc.Author))
+	fix("role", &doc.AuthorRole, strings.TrimSpace(doc.AuthorRole))
+	for i := range doc.Statements {
+		stmt := &doc.Statements[i]
+		field := func(name string) string { return fmt.Sprintf("statements[%d].%s", i, name) }
+
+		name := string(stmt.Vulnerability.Name)
+		fix(field("vulnerability.name"), &name, NormalizeVulnerabilityID(strings.TrimSpace(name)))
+		stmt.Vulnerability.Name = vexlib.VulnerabilityID(name)
+		for j := range stmt.Vulnerability.Aliases {
+			alias := string(stmt.Vulnerability.Aliases[j])
+			fix(field(fmt.Sprintf("vulnerability.aliases[%d]", j)), &alias, NormalizeVulnerabilityID(strings.TrimSpace(alias)))
+			stmt.Vulnerability.Aliases[j] = vexlib.VulnerabilityID(alias)
+		}
+
+		for j := range stmt.Products {
+			id := &stmt.Products[j].Component.ID
+			fix(field(fmt.Sprintf("products[%d].@id", j)), id, strings.TrimSpace(*id))
+		}
+
+		status := string(stmt.Status)
+		if s, ok := normalizeStatus(status); ok {
+			fix(field("status"), &status, string(s))
+			stmt.Status = vexlib.Status(status)
+		}
+		if stmt.Justification != "" {
+			justification := string(stmt.Justification)
+			if j, ok := normalizeJustification(justification); ok {
+				fix(field("justification"), &justification, string(j))
+				stmt.Justification = vexlib.Justification(justification)
+			}
+		}
+
+		fix(field("status_notes"), &stmt.StatusNotes, strings.TrimSpace(stmt.StatusNotes))
+		fix(field("impact_statement"), &stmt.ImpactStatement, strings.TrimSpace(stmt.ImpactStatement))
+		fix(field("action_statement"), &stmt.ActionStatement, strings.TrimSpace(stmt.ActionStatement))
+	}
+
+	result := documentWithExtensions(doc, raw)
+	return result, fixes, nil
+}
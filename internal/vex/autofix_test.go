@@ -0,0 +1,76 @@
+package vex
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAutofix(t *testing.T) {
+	client := NewClient("test-author")
+	doc := `{
+		"@context": "https://openvex.dev/ns",
+		"author": " security-team ",
+		"labels": {"team": "platform"},
+		"statements": [
+			{
+				"vulnerability": {"name": "cve-2023-0001 ", "aliases": ["ghsa-JFH8-C2JP-5V3Q"]},
+				"products": [{"@id": " pkg:npm/a@1.0.0"}],
+				"status": "Not Affected",
+				"justification": "componentNotPresent"
+			},
+			{
+				"vulnerability": {"name": "CVE-2023-0002"},
+				"products": [{"@id": "pkg:npm/a@1.0.0"}],
+				"status": "patched",
+				"action_statement": "Upgrade\n",
+				"cvss": {"score": 7.5},
+				"notes": ["backported"]
+			},
+			{
+				"vulnerability": {"name": "CVE-2023-0003"},
+				"products": [{"@id": "pkg:npm/a@1.0.0"}],
+				"status": "bogus"
+			}
+		]
+	}`
+
+	result, fixes, err := client.Autofix(decodeDocument(t, doc))
+	if err != nil {
+		t.Fatalf("Autofix() error = %v", err)
+	}
+
+	want := []Fix{
+		{Field: "author", From: " security-team ", To: "security-team"},
+		{Field: "statements[0].vulnerability.name", From: "cve-2023-0001 ", To: "CVE-2023-0001"},
+		{Field: "statements[0].vulnerability.aliases[0]", From: "ghsa-JFH8-C2JP-5V3Q", To: "GHSA-jfh8-c2jp-5v3q"},
+		{Field: "statements[0].products[0].@id", From: " pkg:npm/a@1.0.0", To: "pkg:npm/a@1.0.0"},
+		{Field: "statements[0].status", From: "Not Affected", To: "not_affected"},
+		{Field: "statements[0].justification", From: "componentNotPresent", To: "component_not_present"},
+		{Field: "statements[1].status", From: "patched", To: "fixed"},
+		{Field: "statements[1].action_statement", From: "Upgrade\n", To: "Upgrade"},
+	}
+	if !reflect.DeepEqual(fixes, want) {
+		t.Errorf("Autofix() fixes = %+v, want %+v", fixes, want)
+	}
+
+	if result.Statements[0].Status != "not_affected" || result.Statements[1].Status != "fixed" {
+		t.Errorf("Autofix() statuses = %s, %s", result.Statements[0].Status, result.Statements[1].Status)
+	}
+	if result.Statements[2].Status != "bogus" {
+		t.Errorf("Autofix() changed unmappable status to %s", result.Statements[2].Status)
+	}
+	if result.Extensions[LabelsExtension] == nil {
+		t.Error("Autofix() dropped document labels")
+	}
+	if result.StatementExtensions[1][CVSSExtension] == nil || result.StatementExtensions[1][NotesExtension] == nil {
+		t.Errorf("Autofix() dropped statement extensions, got %v", result.StatementExtensions)
+	}
+
+	_, fixes, err = client.Autofix(decodeDocument(t, `{"@context": "https://openvex.dev/ns", "statements": []}`))
+	if err != nil {
+		t.Fatalf("Autofix() error = %v", err)
+	}
+	if len(fixes) != 0 {
+		t.Errorf("Autofix() fixes = %v, want none", fixes)
+	}
+}
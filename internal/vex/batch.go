@@ -0,0 +1,43 @@
+package vex
+
+import "fmt"
+
+// BatchItemResult is the outcome of a single batch item, identified by its
+// index in the request
+type BatchItemResult struct {
+	Index    int       `json:"index"`
+	Document *Document `json:"document,omitempty"`
+	Error    string    `json:"error,omitempty"`
+}
+
+// BatchResult separates the successful items of a batch from the failed ones
+type BatchResult struct {
+	Succeeded []BatchItemResult `json:"succeeded"`
+	Failed    []BatchItemResult `json:"failed"`
+}
+
+// CreateDocuments creates one document per input. By default the first
+// failing item aborts the batch; with continueOnError every item is processed
+// and failures are reported per item instead.
+func (c *Client) CreateDocuments(inputs []*CreateInput, continueOnError bool) (*BatchResult, error) {
+	if err := ValidateBatchCount(len(inputs)); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	result := &BatchResult{
+		Succeeded: []BatchItemResult{},
+		Failed:    []BatchItemResult{},
+	}
+	for i, input := range inputs {
+		doc, err := c.CreateDocument(input)
+		if err != nil {
+			if !continueOnError {
+				return nil, fmt.Errorf("item %d: %w", i, err)
+			}
+			result.Failed = append(result.Failed, BatchItemResult{Index: i, Error: err.Error()})
+			continue
+		}
+		result.Succeeded = append(result.Succeeded, BatchItemResult{Index: i, Document: doc})
+	}
+	return result, nil
+}
@@ -0,0 +1,69 @@
+package vex
+
+import (
+	"strings"
+	"testing"
+)
+
+func batchInputs() []*CreateInput {
+	return []*CreateInput{
+		{Product: "pkg:npm/lodash@4.17.21", Vulnerability: "CVE-2023-1234", Status: "fixed"},
+		{Product: "pkg:npm/axios@0.21.0", Vulnerability: "CVE-2023-5678", Status: "bogus"},
+		{Product: "pkg:npm/express@4.18.0", Vulnerability: "CVE-2023-9999", Status: "affected", ActionStatement: "Upgrade"},
+		{Product: "pkg:npm/react@18.0.0;rm", Vulnerability: "CVE-2023-0001", Status: "fixed"},
+	}
+}
+
+func TestCreateDocuments_ContinueOnError(t *testing.T) {
+	client := NewClient("test-author")
+
+	result, err := client.CreateDocuments(batchInputs(), true)
+	if err != nil {
+		t.Fatalf("CreateDocuments() error = %v", err)
+	}
+
+	if len(result.Succeeded) != 2 || len(result.Failed) != 2 {
+		t.Fatalf("CreateDocuments() succeeded = %d, failed = %d, want 2 and 2", len(result.Succeeded), len(result.Failed))
+	}
+	if result.Succeeded[0].Index != 0 || result.Succeeded[1].Index != 2 {
+		t.Errorf("unexpected success indices %d, %d", result.Succeeded[0].Index, result.Succeeded[1].Index)
+	}
+	if result.Failed[0].Index != 1 || result.Failed[1].Index != 3 {
+		t.Errorf("unexpected failure indices %d, %d", result.Failed[0].Index, result.Failed[1].Index)
+	}
+	for _, item := range result.Succeeded {
+		if item.Document == nil || item.Error != "" {
+			t.Errorf("success %d should carry a document and no error", item.Index)
+		}
+	}
+	for _, item := range result.Failed {
+		if item.Document != nil || item.Error == "" {
+			t.Errorf("failure %d should carry an error and no document", item.Index)
+		}
+	}
+}
+
+func TestCreateDocuments_AbortOnError(t *testing.T) {
+	client := NewClient("test-author")
+
+	_, err := client.CreateDocuments(batchInputs(), false)
+	if err == nil {
+		t.Fatal("CreateDocuments() expected error")
+	}
+	if !strings.Contains(err.Error(), "item 1") {
+		t.Errorf("error should identify the failing item, got %v", err)
+	}
+}
+
+func TestCreateDocuments_BatchSize(t *testing.T) {
+	client := NewClient("test-author")
+
+	if _, err := client.CreateDocuments(nil, true); err == nil {
+		t.Error("CreateDocuments() expected error for empty batch")
+	}
+
+	inputs := make([]*CreateInput, MaxBatchItems+1)
+	if _, err := client.CreateDocuments(inputs, true); err == nil {
+		t.Error("CreateDocuments() expected error for oversized batch")
+	}
+}
@@ -0,0 +1,31 @@
+package vex
+
+import "fmt"
+
+// BudgetReport compares a document's statement count against a budget
+type BudgetReport struct {
+	WithinBudget bool `json:"within_budget"`
+	Statements   int  `json:"statements"`
+	Max          int  `json:"max"`
+	Excess       int  `json:"excess"`
+}
+
+// CheckStatementBudget reports whether a document has at most max statements
+// and, if not, by how many it exceeds the budget
+func (c *Client) CheckStatementBudget(raw map[string]interface{}, max int) (*BudgetReport, error) {
+	if max < 0 {
+		return nil, fmt.Errorf("max must not be negative, got %d", max)
+	}
+
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &BudgetReport{Statements: len(doc.Statements), Max: max}
+	if report.Statements > max {
+		report.Excess = report.Statements - max
+	}
+	report.WithinBudget = report.Excess == 0
+	return report, nil
+}
@@ -0,0 +1,58 @@
+package vex
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckStatementBudget(t *testing.T) {
+	client := NewClient("test-author")
+	doc := `{
+		"@context": "https://openvex.dev/ns",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"},
+			{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"},
+			{"vulnerability": {"name": "CVE-2023-0003"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"}
+		]
+	}`
+
+	tests := []struct {
+		name            string
+		max             int
+		wantWithin      bool
+		wantExcess      int
+		wantErrContains string
+	}{
+		{name: "under budget", max: 5, wantWithin: true},
+		{name: "at budget", max: 3, wantWithin: true},
+		{name: "over budget", max: 1, wantExcess: 2},
+		{name: "negative max", max: -1, wantErrContains: "max must not be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := client.CheckStatementBudget(decodeDocument(t, doc), tt.max)
+			if tt.wantErrContains != "" {
+				if err == nil {
+					t.Fatal("CheckStatementBudget() expected error")
+				}
+				if !strings.Contains(err.Error(), tt.wantErrContains) {
+					t.Errorf("CheckStatementBudget() error = %v, want to contain %v", err, tt.wantErrContains)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CheckStatementBudget() error = %v", err)
+			}
+			if report.WithinBudget != tt.wantWithin {
+				t.Errorf("CheckStatementBudget() within = %v, want %v", report.WithinBudget, tt.wantWithin)
+			}
+			if report.Excess != tt.wantExcess {
+				t.Errorf("CheckStatementBudget() excess = %d, want %d", report.Excess, tt.wantExcess)
+			}
+			if report.Statements != 3 {
+				t.Errorf("CheckStatementBudget() statements = %d, want 3", report.Statements)
+			}
+		})
+	}
+}
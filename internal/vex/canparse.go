@@ -0,0 +1,96 @@
+package vex
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// parseContextBytes is how many bytes either side of a parse error offset
+// are quoted in ParseCheck.Context
+const parseContextBytes = 20
+
+// ParseCheck is the result of checking that go-vex can parse a document
+type ParseCheck struct {
+	Parseable bool   `json:"parseable"`
+	Stable    bool   `json:"stable"`
+	Error     string `json:"error,omitempty"`
+	Offset    int64  `json:"offset,omitempty"`
+	Context   string `json:"context,omitempty"`
+}
+
+// CanParse reports whether go-vex parses data and whether serializing the
+// parsed document yields the input again, compared as canonical JSON so key
+// order and whitespace do not matter. It does no semantic validation. On a
+// JSON syntax or type error the byte offset and the text around it are
+// included.
+func (c *Client) CanParse(data []byte) *ParseCheck {
+	doc, err := vexlib.Parse(data)
+	if err != nil {
+		check := &ParseCheck{Error: err.Error()}
+		if offset, ok := jsonErrorOffset(err); ok {
+			check.Offset = offset
+			check.Context = offsetContext(data, offset)
+		}
+		return check
+	}
+
+	serialized, err := json.Marshal(doc)
+	if err != nil {
+		return &ParseCheck{Parseable: true, Error: fmt.Sprintf("serializing parsed document: %v", err)}
+	}
+	if _, err := vexlib.Parse(serialized); err != nil {
+		return &ParseCheck{Parseable: true, Error: fmt.Sprintf("parsing serialized document: %v", err)}
+	}
+	input, err := canonicalJSON(data)
+	if err != nil {
+		return &ParseCheck{Parseable: true, Error: fmt.Sprintf("canonicalizing input: %v", err)}
+	}
+	output, err := canonicalJSON(serialized)
+	if err != nil {
+		return &ParseCheck{Parseable: true, Error: fmt.Sprintf("canonicalizing serialized document: %v", err)}
+	}
+	return &ParseCheck{Parseable: true, Stable: bytes.Equal(input, output)}
+}
+
+// canonicalJSON re-encodes JSON data with sorted keys and no insignificant
+// whitespace
+func canonicalJSON(data []byte) ([]byte, error) {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
+
+// jsonErrorOffset returns the input offset of a JSON syntax or type error
+func jsonErrorOffset(err error) (int64, bool) {
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return syntaxErr.Offset, true
+	}
+	var typeErr *json.UnmarshalTypeError
+	if errors.As(err, &typeErr) {
+		return typeErr.Offset, true
+	}
+	return 0, false
+}
+
+// offsetContext returns the text of data around offset
+func offsetContext(data []byte, offset int64) string {
+	start := offset - parseContextBytes
+	if start < 0 {
+		start = 0
+	}
+	end := offset + parseContextBytes
+	if end > int64(len(data)) {
+		end = int64(len(data))
+	}
+	if start > end {
+		start = end
+	}
+	return string(data[start:end])
+}
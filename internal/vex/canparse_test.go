@@ -0,0 +1,103 @@
+package vex
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCanParse(t *testing.T) {
+	client := NewClient("test-author")
+
+	tests := []struct {
+		name            string
+		data            string
+		wantParseable   bool
+		wantStable      bool
+		wantErrContains string
+		wantContext     string
+	}{
+		{
+			name: "parseable document",
+			data: `{
+				"@context": "https://openvex.dev/ns/v0.2.0",
+				"@id": "doc1",
+				"author": "Security Team",
+				"timestamp": "2023-01-01T00:00:00Z",
+				"version": 1,
+				"statements": [{
+					"vulnerability": {"name": "CVE-2023-1234"},
+					"products": [{"@id": "pkg:npm/lodash@4.17.21"}],
+					"status": "fixed"
+				}]
+			}`,
+			wantParseable: true,
+			wantStable:    true,
+		},
+		{
+			name: "unknown field dropped",
+			data: `{
+				"@context": "https://openvex.dev/ns/v0.2.0",
+				"@id": "doc1",
+				"author": "Security Team",
+				"timestamp": "2023-01-01T00:00:00Z",
+				"version": 1,
+				"labels": {"team": "payments"},
+				"statements": [{
+					"vulnerability": {"name": "CVE-2023-1234"},
+					"products": [{"@id": "pkg:npm/lodash@4.17.21"}],
+					"status": "fixed"
+				}]
+			}`,
+			wantParseable: true,
+			wantStable:    false,
+		},
+		{
+			name:            "syntax error",
+			data:            `{"@context": "https://openvex.dev/ns", "statements": [}`,
+			wantErrContains: "invalid character",
+			wantContext:     `"statements": [}`,
+		},
+		{
+			name:            "wrong field type",
+			data:            `{"@context": "https://openvex.dev/ns", "version": "one"}`,
+			wantErrContains: "cannot unmarshal string",
+			wantContext:     `"version": "one"`,
+		},
+		{
+			name:            "unstable without timestamp",
+			data:            `{"@context": "https://openvex.dev/ns", "@id": "doc1"}`,
+			wantParseable:   true,
+			wantErrContains: "parsing serialized document",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := client.CanParse([]byte(tt.data))
+			if check.Parseable != tt.wantParseable {
+				t.Errorf("CanParse() parseable = %v, want %v (error %q)", check.Parseable, tt.wantParseable, check.Error)
+			}
+			if check.Stable != tt.wantStable {
+				t.Errorf("CanParse() stable = %v, want %v", check.Stable, tt.wantStable)
+			}
+			if tt.wantErrContains == "" {
+				if check.Error != "" {
+					t.Errorf("CanParse() error = %v, want none", check.Error)
+				}
+				return
+			}
+			if !strings.Contains(check.Error, tt.wantErrContains) {
+				t.Errorf("CanParse() error = %v, want to contain %v", check.Error, tt.wantErrContains)
+			}
+			if tt.wantContext == "" {
+				return
+			}
+			if check.Offset == 0 {
+				t.Error("CanParse() offset = 0, want error offset")
+			}
+			if !strings.Contains(check.Context, tt.wantContext) {
+				t.Errorf("CanParse() context = %q, want to contain %q", check.Context, tt.wantContext)
+			}
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package vex
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"strings"
 	"time"
 
 	vexlib "github.com/openvex/go-vex/pkg/vex"
@@ -10,38 +13,86 @@ import (
 
 // Client handles VEX operations using the native go-vex library
 type Client struct {
-	defaultAuthor string
+	defaultAuthor     string
+	maxDirectoryFiles int
+	fileRoot          string
+	idTemplate        *IDTemplate
+	logger            *slog.Logger
+
+	lenientJustifications bool
+	allowedContexts       []string
+	requireAuthor         bool
+	maxOutputBytes        int
+	generator             Generator
+}
+
+// Option configures optional Client behavior
+type Option func(*Client)
+
+// WithMaxDirectoryFiles overrides the maximum number of files MergeDirectory and MigrateDirectory will read
+func WithMaxDirectoryFiles(max int) Option {
+	return func(c *Client) {
+		if max > 0 {
+			c.maxDirectoryFiles = max
+		}
+	}
 }
 
 // NewClient creates a new VEX client
-func NewClient(defaultAuthor string) *Client {
+func NewClient(defaultAuthor string, opts ...Option) *Client {
 	if defaultAuthor == "" {
 		defaultAuthor = "vexdoc-mcp-server"
 	}
-	return &Client{
-		defaultAuthor: defaultAuthor,
+	c := &Client{
+		defaultAuthor:     defaultAuthor,
+		maxDirectoryFiles: MaxDirectoryFiles,
+		maxOutputBytes:    DefaultMaxOutputBytes,
+		generator:         Generator{Name: DefaultGeneratorName},
+		logger:            slog.Default(),
+	}
+	for _, opt := range opts {
+		opt(c)
 	}
+	return c
 }
 
 // CreateInput represents the input for creating a VEX statement
 type CreateInput struct {
 	Product         string
+	Products        []string // Additional products covered by the same statement
 	Vulnerability   string
 	Status          string
 	Justification   string
 	ImpactStatement string
 	ActionStatement string
 	Author          string
+	AuthorRole      string
+	Labels          map[string]string // Stored in the labels extension field
+	CVSS            *CVSS             // Stored in the statement's cvss extension field
+	Notes           []string          // Stored in the statement's notes extension field
+	CollectErrors   bool              // Report every invalid field instead of only the first
+	NormalizeIDs    bool              // Canonicalize CVE and GHSA vulnerability identifiers
 }
 
 // MergeInput represents the input for merging VEX documents
 type MergeInput struct {
-	Documents       []map[string]interface{}
-	Author          string
-	AuthorRole      string
-	ID              string
-	Products        []string
-	Vulnerabilities []string
+	Documents        []map[string]interface{}
+	Author           string
+	AuthorRole       string
+	ID               string
+	Products         []string
+	Vulnerabilities  []string
+	Statuses         []string
+	Labels           map[string]string // Added to any labels carried by the source documents
+	ValidateResult   bool              // Fail when the merged document contains invalid statements
+	MaxProducts      int               // Fail when the result covers more distinct products; 0 means no cap
+	RecordProvenance bool              // Append this merge to the provenance chain carried by the sources
+
+	GroupByVulnerability bool     // Combine products of otherwise identical statements
+	TimestampStrategy    string   // now (default), latest_source, or earliest_source
+	DocumentPriority     []string // Source document IDs, most authoritative first; ConsolidateLatest only
+	IncludeSourceHashes  bool     // Record the canonical hash of each source document; MergeDocuments only
+	KeepMostSevere       bool     // Keep one statement per vulnerability and product, recording the overridden ones
 }
 
 // CreateStatement creates a new VEX statement following the vexctl pattern
@@ -54,49 +105,33 @@ func (c *Client) CreateStatement(
 	actionStatement string,
 	author string,
 ) (*vexlib.VEX, error) {
-	// Security boundary checks (DoS prevention, defense in depth)
-	if err := ValidateRequired("product", product); err != nil {
-		return nil, fmt.Errorf("validation error: %w", err)
-	}
-	if err := ValidateStringLength("product", product, MaxStringLength); err != nil {
-		return nil, fmt.Errorf("validation error: %w", err)
-	}
-	if err := ValidateDangerousChars("product", product); err != nil {
-		return nil, fmt.Errorf("validation error: %w", err)
-	}
-
-	if err := ValidateRequired("vulnerability", vulnerability); err != nil {
-		return nil, fmt.Errorf("validation error: %w", err)
-	}
-	if err := ValidateStringLength("vulnerability", vulnerability, MaxStringLength); err != nil {
-		return nil, fmt.Errorf("validation error: %w", err)
+	doc, err := c.CreateDocument(&CreateInput{
+		Product:         product,
+		Vulnerability:   vulnerability,
+		Status:          status,
+		Justification:   justification,
+		ImpactStatement: impactStatement,
+		ActionStatement: actionStatement,
+		Author:          author,
+	})
+	if err != nil {
+		return nil, err
 	}
+	return doc.VEX, nil
+}
 
-	if err := ValidateRequired("status", status); err != nil {
-		return nil, fmt.Errorf("validation error: %w", err)
+// CreateDocument creates a new single-statement VEX document from input
+func (c *Client) CreateDocument(input *CreateInput) (*Document, error) {
+	doc, err := c.createDocument(input)
+	if err != nil {
+		c.logRejection("create", err)
 	}
+	return doc, err
+}
 
-	// Optional fields - only check length/chars if provided
-	if err := ValidateStringLength("justification", justification, MaxStringLength); err != nil {
-		return nil, fmt.Errorf("validation error: %w", err)
-	}
-	if err := ValidateStringLength("impact_statement", impactStatement, MaxStringLength); err != nil {
-		return nil, fmt.Errorf("validation error: %w", err)
-	}
-	if err := ValidateDangerousChars("impact_statement", impactStatement); err != nil {
-		return nil, fmt.Errorf("validation error: %w", err)
-	}
-	if err := ValidateStringLength("action_statement", actionStatement, MaxStringLength); err != nil {
-		return nil, fmt.Errorf("validation error: %w", err)
-	}
-	if err := ValidateDangerousChars("action_statement", actionStatement); err != nil {
-		return nil, fmt.Errorf("validation error: %w", err)
-	}
-	if err := ValidateStringLength("author", author, MaxAuthorLength); err != nil {
-		return nil, fmt.Errorf("validation error: %w", err)
-	}
-	if err := ValidateDangerousChars("author", author); err != nil {
-		return nil, fmt.Errorf("validation error: %w", err)
+func (c *Client) createDocument(input *CreateInput) (*Document, error) {
+	if err := ValidateCreateInput(input); err != nil {
+		return nil, err
 	}
 
 	// Create new VEX document
@@ -105,35 +140,44 @@ func (c *Client) CreateStatement(
 
 	// Set metadata
 	doc.Context = vexlib.Context
-	doc.ID = fmt.Sprintf("vex-%d", now.Unix())
-	doc.Author = c.getAuthor(author)
+	doc.ID = c.generateID(now)
+	doc.Author = c.getAuthor(input.Author)
+	doc.AuthorRole = input.AuthorRole
 	doc.Version = 1
 	doc.Timestamp = &now
 
 	// Parse status - let go-vex handle invalid values
-	vexStatus, err := parseStatus(status)
+	vexStatus, err := parseStatus(input.Status)
 	if err != nil {
 		return nil, err
 	}
 
+	vulnerability := input.Vulnerability
+	if input.NormalizeIDs {
+		vulnerability = NormalizeVulnerabilityID(vulnerability)
+	}
+
 	// Create statement
 	statement := vexlib.Statement{
 		Vulnerability: vexlib.Vulnerability{
 			Name: vexlib.VulnerabilityID(vulnerability),
 		},
-		Products: []vexlib.Product{
-			{
-				Component: vexlib.Component{
-					ID: product,
-				},
-			},
-		},
 		Status: vexStatus,
 	}
+	for _, product := range append([]string{input.Product}, input.Products...) {
+		if product == "" {
+			continue
+		}
+		statement.Products = append(statement.Products, vexlib.Product{
+			Component: vexlib.Component{
+				ID: product,
+			},
+		})
+	}
 
 	// Add justification if provided (for not_affected status)
-	if justification != "" {
-		just, err := parseJustification(justification)
+	if input.Justification != "" {
+		just, err := parseJustification(input.Justification, c.lenientJustifications)
 		if err != nil {
 			return nil, err
 		}
@@ -141,15 +185,27 @@ func (c *Client) CreateStatement(
 	}
 
 	// Add impact statement if provided
-	if impactStatement != "" {
-		statement.ImpactStatement = impactStatement
+	if input.ImpactStatement != "" {
+		statement.ImpactStatement = input.ImpactStatement
 	}
 
 	// Add action statement if provided
-	if actionStatement != "" {
-		statement.ActionStatement = actionStatement
+	if input.ActionStatement != "" {
+		statement.ActionStatement = input.ActionStatement
 	}
 
+	// Spell out what not_affected needs before go-vex's generic check
+	if reason := justificationMismatch(&statement); reason != "" {
+		field := "status"
+		if statement.Justification != "" {
+			field = "justification"
+		}
+		return nil, &ValidationError{Field: field, Reason: reason}
+	}
+
+	// Drop repeated products so the generated document stays clean
+	statement.Products = dedupeProducts(statement.Products)
+
 	// Add statement to document
 	doc.Statements = append(doc.Statements, statement)
 
@@ -158,111 +214,302 @@ func (c *Client) CreateStatement(
 		return nil, fmt.Errorf("statement validation failed: %w", err)
 	}
 
-	return &doc, nil
+	result := NewDocument(&doc)
+	result.SetExtension(LabelsExtension, input.Labels)
+	if input.CVSS != nil {
+		result.SetStatementExtension(0, CVSSExtension, input.CVSS)
+	}
+	if len(input.Notes) > 0 {
+		result.SetStatementExtension(0, NotesExtension, input.Notes)
+	}
+	return result, nil
 }
 
-// MergeDocuments merges multiple VEX documents using the native library
-func (c *Client) MergeDocuments(input *MergeInput) (*vexlib.VEX, error) {
+// MergeDocuments merges multiple VEX documents using the native library. It
+// stops with ctx's error once ctx is done, checking between documents.
+func (c *Client) MergeDocuments(ctx context.Context, input *MergeInput) (*Document, error) {
+	doc, err := c.mergeDocuments(ctx, input)
+	if err != nil {
+		c.logRejection("merge", err)
+	}
+	return doc, err
+}
+
+func (c *Client) mergeDocuments(ctx context.Context, input *MergeInput) (*Document, error) {
 	// Security boundary checks
 	if err := ValidateDocumentCount(len(input.Documents)); err != nil {
 		return nil, fmt.Errorf("validation error: %w", err)
 	}
+	if err := validateMergeMetadata(input); err != nil {
+		return nil, err
+	}
+	if err := validateNoDocumentPriority(input); err != nil {
+		return nil, err
+	}
+
+	if err := c.checkDocumentStructure(input.Documents); err != nil {
+		return nil, err
+	}
+
+	// Parse documents from JSON
+	docs, err := parseDocumentsContext(ctx, input.Documents)
+	if err != nil {
+		return nil, err
+	}
+
+	// Carry labels through the merge; later documents win on conflicts
+	labels := map[string]string{}
+	for _, docData := range input.Documents {
+		for key, value := range documentLabels(docData) {
+			labels[key] = value
+		}
+	}
+
+	var hashes []SourceHash
+	if input.IncludeSourceHashes {
+		if hashes, err = sourceHashes(docs); err != nil {
+			return nil, err
+		}
+	}
+
+	// Merge documents using the library
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	merged, err := vexlib.MergeDocuments(docs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge documents: %w", err)
+	}
+
+	var provenance []ProvenanceEvent
+	if input.RecordProvenance {
+		provenance, err = mergeProvenance(input.Documents, documentIDs(docs), time.Now())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	doc, err := c.finalizeMerge(merged, input, labels, documentTimestamps(docs), provenance)
+	if err != nil {
+		return nil, err
+	}
+	if input.IncludeSourceHashes {
+		doc.SetExtension(SourceHashesExtension, hashes)
+	}
+	return doc, nil
+}
+
+// finalizeMerge applies custom metadata and filters to a merged document.
+// labels are those carried from the source documents, sources their
+// timestamps, used by the source timestamp strategies, and provenance the
+// chain recorded when input.RecordProvenance is set.
+func (c *Client) finalizeMerge(merged *vexlib.VEX, input *MergeInput, labels map[string]string, sources []*time.Time, provenance []ProvenanceEvent) (*Document, error) {
+	// Apply custom metadata if provided, otherwise use the configured ID
+	// template in place of the deterministic merged ID
+	if input.ID != "" {
+		merged.ID = input.ID
+	} else if c.idTemplate != nil {
+		merged.ID = c.idTemplate.Generate(time.Now())
+	}
+	if input.Author != "" {
+		merged.Author = input.Author
+	}
+	if input.AuthorRole != "" {
+		merged.AuthorRole = input.AuthorRole
+	}
+
+	// Filter by products if specified
+	if len(input.Products) > 0 {
+		merged = c.filterByProducts(merged, input.Products)
+	}
+
+	// Filter by vulnerabilities if specified
+	if len(input.Vulnerabilities) > 0 {
+		merged = c.filterByVulnerabilities(merged, input.Vulnerabilities)
+	}
+
+	// Filter by statuses if specified
+	if len(input.Statuses) > 0 {
+		merged = c.filterByStatuses(merged, input.Statuses)
+	}
+
+	var overridden []vexlib.Statement
+	if input.KeepMostSevere {
+		merged.Statements, overridden = keepMostSevere(merged.Statements)
+	}
+
+	if input.GroupByVulnerability {
+		merged.Statements = groupByVulnerability(merged.Statements)
+	}
+
+	// Enforce per-document product scoping on the filtered result
+	if input.MaxProducts > 0 {
+		if count := CountProducts(merged.Statements); count > input.MaxProducts {
+			return nil, fmt.Errorf("merged document covers %d distinct products, exceeding max_products of %d",
+				count, input.MaxProducts)
+		}
+	}
+
+	// Update timestamp
+	timestamp := mergeTimestamp(input.TimestampStrategy, sources, time.Now())
+	merged.Timestamp = &timestamp
+
+	for key, value := range input.Labels {
+		labels[key] = value
+	}
+	// Source documents may contain invalid statements that only surface once merged
+	if input.ValidateResult {
+		if issues := ValidateStatements(merged.Statements); len(issues) > 0 {
+			return nil, fmt.Errorf("merged document contains %d invalid statement(s): %s",
+				len(issues), strings.Join(issues, "; "))
+		}
+	}
+
+	doc := NewDocument(merged)
+	doc.SetExtension(LabelsExtension, labels)
+	if input.RecordProvenance {
+		doc.SetExtension(ProvenanceExtension, provenance)
+	}
+	if input.KeepMostSevere {
+		doc.SetExtension(OverriddenExtension, overridden)
+	}
+	return doc, nil
+}
+
+// ValidateStatements runs go-vex domain validation on every statement and
+// returns a description of each failure
+func ValidateStatements(statements []vexlib.Statement) []string {
+	var issues []string
+	for i := range statements {
+		if err := statements[i].Validate(); err != nil {
+			issues = append(issues, fmt.Sprintf("statement %d (%s): %s",
+				i, statements[i].Vulnerability.Name, err.Error()))
+		}
+	}
+	return issues
+}
+
+// validateMergeMetadata applies security boundary checks to the merge
+// metadata and filter fields shared by every merge entry point
+func validateMergeMetadata(input *MergeInput) error {
 	if err := ValidateStringLength("author", input.Author, MaxAuthorLength); err != nil {
-		return nil, fmt.Errorf("validation error: %w", err)
+		return fmt.Errorf("validation error: %w", err)
 	}
 	if err := ValidateDangerousChars("author", input.Author); err != nil {
-		return nil, fmt.Errorf("validation error: %w", err)
+		return fmt.Errorf("validation error: %w", err)
 	}
 	if err := ValidateStringLength("author_role", input.AuthorRole, MaxAuthorLength); err != nil {
-		return nil, fmt.Errorf("validation error: %w", err)
+		return fmt.Errorf("validation error: %w", err)
 	}
 	if err := ValidateDangerousChars("author_role", input.AuthorRole); err != nil {
-		return nil, fmt.Errorf("validation error: %w", err)
+		return fmt.Errorf("validation error: %w", err)
 	}
 	if err := ValidateStringLength("id", input.ID, MaxIDLength); err != nil {
-		return nil, fmt.Errorf("validation error: %w", err)
+		return fmt.Errorf("validation error: %w", err)
 	}
 	if err := ValidateDangerousChars("id", input.ID); err != nil {
-		return nil, fmt.Errorf("validation error: %w", err)
+		return fmt.Errorf("validation error: %w", err)
 	}
 
 	// Validate products list
 	for i, product := range input.Products {
 		if err := ValidateStringLength(fmt.Sprintf("products[%d]", i), product, MaxStringLength); err != nil {
-			return nil, fmt.Errorf("validation error: %w", err)
+			return fmt.Errorf("validation error: %w", err)
 		}
 		if err := ValidateDangerousChars(fmt.Sprintf("products[%d]", i), product); err != nil {
-			return nil, fmt.Errorf("validation error: %w", err)
+			return fmt.Errorf("validation error: %w", err)
 		}
 	}
 
 	// Validate vulnerabilities list
 	for i, vuln := range input.Vulnerabilities {
 		if err := ValidateStringLength(fmt.Sprintf("vulnerabilities[%d]", i), vuln, MaxStringLength); err != nil {
-			return nil, fmt.Errorf("validation error: %w", err)
+			return fmt.Errorf("validation error: %w", err)
 		}
 	}
 
-	// Validate each document has basic structure
-	for i, doc := range input.Documents {
-		if _, hasContext := doc["@context"]; !hasContext {
-			return nil, fmt.Errorf("document %d must be a valid VEX document with @context", i+1)
-		}
-		if _, hasStatements := doc["statements"]; !hasStatements {
-			return nil, fmt.Errorf("document %d must be a valid VEX document with statements", i+1)
+	// Validate statuses list
+	for i, status := range input.Statuses {
+		if _, err := parseStatus(status); err != nil {
+			return fmt.Errorf("validation error: %w", &ValidationError{
+				Field:  fmt.Sprintf("statuses[%d]", i),
+				Reason: fmt.Sprintf("must be one of: %s", strings.Join(vexlib.Statuses(), ", ")),
+			})
 		}
 	}
 
-	// Parse documents from JSON
-	var docs []*vexlib.VEX
-	for i, docData := range input.Documents {
-		// Convert map to JSON bytes
-		jsonBytes, err := json.Marshal(docData)
-		if err != nil {
-			return nil, fmt.Errorf("failed to marshal document %d: %w", i+1, err)
-		}
+	if input.MaxProducts < 0 {
+		return fmt.Errorf("validation error: %w", &ValidationError{
+			Field:  "max_products",
+			Reason: "must not be negative",
+		})
+	}
 
-		// Parse VEX document - let go-vex validate the structure
-		doc, err := vexlib.Parse(jsonBytes)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse document %d: %w", i+1, err)
+	if err := ValidateLabels(input.Labels); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+	if err := validateTimestampStrategy(input.TimestampStrategy); err != nil {
+		return fmt.Errorf("validation error: %w", err)
+	}
+	if len(input.DocumentPriority) > MaxMergeDocuments {
+		return fmt.Errorf("validation error: %w", &ValidationError{
+			Field:  "document_priority",
+			Reason: fmt.Sprintf("lists more than %d documents", MaxMergeDocuments),
+		})
+	}
+	for _, id := range input.DocumentPriority {
+		if err := ValidateStringLength("document_priority", id, MaxIDLength); err != nil {
+			return fmt.Errorf("validation error: %w", err)
 		}
-
-		docs = append(docs, doc)
 	}
 
-	// Merge documents using the library
-	merged, err := vexlib.MergeDocuments(docs)
-	if err != nil {
-		return nil, fmt.Errorf("failed to merge documents: %w", err)
+	return nil
+}
+
+// validateNoDocumentPriority rejects a document priority on merges that
+// would otherwise ignore it; only ConsolidateLatest ranks documents
+func validateNoDocumentPriority(input *MergeInput) error {
+	if len(input.DocumentPriority) > 0 {
+		return fmt.Errorf("validation error: %w", &ValidationError{
+			Field:  "document_priority",
+			Reason: "is only supported when consolidating documents",
+		})
 	}
+	return nil
+}
 
-	// Apply custom metadata if provided
-	if input.ID != "" {
-		merged.ID = input.ID
+// validateTimestampStrategy checks an optional merge timestamp strategy
+func validateTimestampStrategy(strategy string) error {
+	if strategy == "" {
+		return nil
 	}
-	if input.Author != "" {
-		merged.Author = input.Author
+	for _, supported := range TimestampStrategies() {
+		if strategy == supported {
+			return nil
+		}
 	}
-	if input.AuthorRole != "" {
-		merged.AuthorRole = input.AuthorRole
+	return &ValidationError{
+		Field:  "timestamp_strategy",
+		Reason: fmt.Sprintf("must be one of: %s", strings.Join(TimestampStrategies(), ", ")),
 	}
+}
 
-	// Filter by products if specified
-	if len(input.Products) > 0 {
-		merged = c.filterByProducts(merged, input.Products)
+// documentTimestamps returns the timestamps of docs
+func documentTimestamps(docs []*vexlib.VEX) []*time.Time {
+	timestamps := make([]*time.Time, 0, len(docs))
+	for _, doc := range docs {
+		timestamps = append(timestamps, doc.Timestamp)
 	}
+	return timestamps
+}
 
-	// Filter by vulnerabilities if specified
-	if len(input.Vulnerabilities) > 0 {
-		merged = c.filterByVulnerabilities(merged, input.Vulnerabilities)
+// generateID returns a new document ID from the configured template, falling
+// back to the default vex-{unix} form
+func (c *Client) generateID(now time.Time) string {
+	if c.idTemplate != nil {
+		return c.idTemplate.Generate(now)
 	}
-
-	// Update timestamp
-	now := time.Now()
-	merged.Timestamp = &now
-
-	return merged, nil
+	return fmt.Sprintf("%s-%d", DefaultIDPrefix, now.Unix())
 }
 
 // getAuthor returns the author or default
@@ -312,6 +559,51 @@ func (c *Client) filterByVulnerabilities(doc *vexlib.VEX, vulnerabilities []stri
 	return doc
 }
 
+// filterByStatuses filters statements to only include specified statuses
+func (c *Client) filterByStatuses(doc *vexlib.VEX, statuses []string) *vexlib.VEX {
+	var filtered []vexlib.Statement
+	statusSet := make(map[string]bool)
+	for _, s := range statuses {
+		statusSet[s] = true
+	}
+
+	for _, stmt := range doc.Statements {
+		if statusSet[string(stmt.Status)] {
+			filtered = append(filtered, stmt)
+		}
+	}
+
+	doc.Statements = filtered
+	return doc
+}
+
+// CountProducts returns the number of distinct product identifiers covered
+// by statements
+func CountProducts(statements []vexlib.Statement) int {
+	seen := map[string]bool{}
+	for _, stmt := range statements {
+		for _, p := range stmt.Products {
+			seen[p.Component.ID] = true
+		}
+	}
+	return len(seen)
+}
+
+// dedupeProducts removes products repeating an earlier Component.ID, keeping
+// the first occurrence
+func dedupeProducts(products []vexlib.Product) []vexlib.Product {
+	seen := make(map[string]bool, len(products))
+	deduped := make([]vexlib.Product, 0, len(products))
+	for _, p := range products {
+		if seen[p.Component.ID] {
+			continue
+		}
+		seen[p.Component.ID] = true
+		deduped = append(deduped, p)
+	}
+	return deduped
+}
+
 // parseStatus converts string status to vex.Status
 func parseStatus(status string) (vexlib.Status, error) {
 	switch status {
@@ -328,8 +620,15 @@ func parseStatus(status string) (vexlib.Status, error) {
 	}
 }
 
-// parseJustification converts string justification to vex.Justification
-func parseJustification(justification string) (vexlib.Justification, error) {
+// parseJustification converts string justification to vex.Justification.
+// When lenient, case, separator, and synonym variants are also accepted.
+func parseJustification(justification string, lenient bool) (vexlib.Justification, error) {
+	if lenient {
+		if j, ok := normalizeJustification(justification); ok {
+			return j, nil
+		}
+	}
+
 	switch justification {
 	case "component_not_present":
 		return vexlib.ComponentNotPresent, nil
@@ -345,3 +644,60 @@ func parseJustification(justification string) (vexlib.Justification, error) {
 		return "", fmt.Errorf("invalid justification: %s", justification)
 	}
 }
+
+// ValidateCreateInput applies the security boundary checks (DoS prevention,
+// defense in depth) to create input. It stops at the first invalid field
+// unless input.CollectErrors is set, in which case every invalid field is
+// reported in one joined error.
+func ValidateCreateInput(input *CreateInput) error {
+	checks := []func() error{
+		func() error { return ValidateRequired("product", input.Product) },
+		func() error { return ValidateStringLength("product", input.Product, MaxStringLength) },
+		func() error { return ValidateDangerousChars("product", input.Product) },
+	}
+	for i, product := range input.Products {
+		name := fmt.Sprintf("products[%d]", i)
+		checks = append(checks,
+			func() error { return ValidateStringLength(name, product, MaxStringLength) },
+			func() error { return ValidateDangerousChars(name, product) },
+		)
+	}
+	checks = append(checks,
+		func() error { return ValidateRequired("vulnerability", input.Vulnerability) },
+		func() error { return ValidateStringLength("vulnerability", input.Vulnerability, MaxStringLength) },
+		func() error { return ValidateRequired("status", input.Status) },
+
+		// Optional fields - only check length/chars if provided
+		func() error { return ValidateStringLength("justification", input.Justification, MaxStringLength) },
+		func() error { return ValidateStringLength("impact_statement", input.ImpactStatement, MaxStringLength) },
+		func() error { return ValidateDangerousChars("impact_statement", input.ImpactStatement) },
+		func() error { return ValidateStringLength("action_statement", input.ActionStatement, MaxStringLength) },
+		func() error { return ValidateDangerousChars("action_statement", input.ActionStatement) },
+		func() error { return ValidateStringLength("author", input.Author, MaxAuthorLength) },
+		func() error { return ValidateDangerousChars("author", input.Author) },
+		func() error { return ValidateStringLength("author_role", input.AuthorRole, MaxAuthorRoleLength) },
+		func() error { return ValidateDangerousChars("author_role", input.AuthorRole) },
+		func() error { return ValidateLabels(input.Labels) },
+		func() error {
+			if input.CVSS == nil {
+				return nil
+			}
+			return input.CVSS.Validate()
+		},
+		func() error { return ValidateNotes(input.Notes) },
+	)
+
+	var errs []error
+	for _, check := range checks {
+		if err := check(); err != nil {
+			if !input.CollectErrors {
+				return fmt.Errorf("validation error: %w", err)
+			}
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("validation error: %w", errors.Join(errs...))
+	}
+	return nil
+}
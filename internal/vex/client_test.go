@@ -1,9 +1,13 @@
 package vex
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	vexlib "github.com/openvex/go-vex/pkg/vex"
 )
@@ -245,7 +249,15 @@ func TestCreateStatement_ValidationErrors(t *testing.T) {
 			product:         "pkg:npm/lodash@4.17.21",
 			vulnerability:   "CVE-2023-1234",
 			status:          "not_affected",
-			wantErrContains: "either justification or impact statement",
+			wantErrContains: "status not_affected requires either a 'justification' (one of: component_not_present, vulnerable_code_not_present, vulnerable_code_not_in_execute_path, vulnerable_code_cannot_be_controlled_by_adversary, inline_mitigations_already_exist) or a non-empty 'impact_statement'",
+		},
+		{
+			name:            "not_affected with blank impact",
+			product:         "pkg:npm/lodash@4.17.21",
+			vulnerability:   "CVE-2023-1234",
+			status:          "not_affected",
+			impactStatement: "   ",
+			wantErrContains: "non-empty 'impact_statement'",
 		},
 	}
 
@@ -316,7 +328,7 @@ func TestMergeDocuments_Success(t *testing.T) {
 		ID:        "merged-doc",
 	}
 
-	merged, err := client.MergeDocuments(input)
+	merged, err := client.MergeDocuments(context.Background(), input)
 	if err != nil {
 		t.Fatalf("MergeDocuments() error = %v", err)
 	}
@@ -333,6 +345,25 @@ func TestMergeDocuments_Success(t *testing.T) {
 	}
 }
 
+func TestMergeDocuments_Cancelled(t *testing.T) {
+	client := NewClient("test-author")
+	doc := func(id string) map[string]interface{} {
+		return decodeDocument(t, fmt.Sprintf(`{
+			"@context": "https://openvex.dev/ns",
+			"@id": "%s",
+			"timestamp": "2023-01-01T00:00:00Z",
+			"statements": [{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"}]
+		}`, id))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := client.MergeDocuments(ctx, &MergeInput{Documents: []map[string]interface{}{doc("doc1"), doc("doc2")}})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("MergeDocuments() error = %v, want %v", err, context.Canceled)
+	}
+}
+
 func TestMergeDocuments_WithFilters(t *testing.T) {
 	client := NewClient("test-author")
 
@@ -367,7 +398,7 @@ func TestMergeDocuments_WithFilters(t *testing.T) {
 			Products:  []string{"pkg:npm/lodash@4.17.21"},
 		}
 
-		merged, err := client.MergeDocuments(input)
+		merged, err := client.MergeDocuments(context.Background(), input)
 		if err != nil {
 			t.Fatalf("MergeDocuments() error = %v", err)
 		}
@@ -389,7 +420,7 @@ func TestMergeDocuments_WithFilters(t *testing.T) {
 			Vulnerabilities: []string{"CVE-2023-1234"},
 		}
 
-		merged, err := client.MergeDocuments(input)
+		merged, err := client.MergeDocuments(context.Background(), input)
 		if err != nil {
 			t.Fatalf("MergeDocuments() error = %v", err)
 		}
@@ -454,11 +485,22 @@ func TestMergeDocuments_ValidationErrors(t *testing.T) {
 			},
 			wantErrContains: "exceeds maximum length",
 		},
+		{
+			name: "document priority",
+			input: &MergeInput{
+				Documents: []map[string]interface{}{
+					{"@context": "https://openvex.dev/ns", "statements": []interface{}{}},
+					{"@context": "https://openvex.dev/ns", "statements": []interface{}{}},
+				},
+				DocumentPriority: []string{"vendor"},
+			},
+			wantErrContains: "document_priority is only supported when consolidating documents",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := client.MergeDocuments(tt.input)
+			_, err := client.MergeDocuments(context.Background(), tt.input)
 			if err == nil {
 				t.Fatal("MergeDocuments() expected error, got nil")
 			}
@@ -567,7 +609,7 @@ func TestParseJustification(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := parseJustification(tt.justification)
+			got, err := parseJustification(tt.justification, false)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("parseJustification() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -578,3 +620,599 @@ func TestParseJustification(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateDocument_DedupesProducts(t *testing.T) {
+	client := NewClient("test-author")
+
+	doc, err := client.CreateDocument(&CreateInput{
+		Product:       "pkg:npm/lodash@4.17.21",
+		Products:      []string{"pkg:npm/lodash@4.17.21", "pkg:npm/express@4.18.0", "pkg:npm/express@4.18.0"},
+		Vulnerability: "CVE-2023-1234",
+		Status:        "fixed",
+	})
+	if err != nil {
+		t.Fatalf("CreateDocument() error = %v", err)
+	}
+
+	products := doc.Statements[0].Products
+	if len(products) != 2 {
+		t.Fatalf("Products length = %v, want 2", len(products))
+	}
+	if products[0].Component.ID != "pkg:npm/lodash@4.17.21" || products[1].Component.ID != "pkg:npm/express@4.18.0" {
+		t.Errorf("Products = %v, want lodash then express", products)
+	}
+}
+
+func TestMergeDocuments_ValidateResult(t *testing.T) {
+	client := NewClient("test-author")
+
+	valid := map[string]interface{}{
+		"@context":  "https://openvex.dev/ns",
+		"@id":       "doc1",
+		"timestamp": "2023-01-01T00:00:00Z",
+		"statements": []interface{}{
+			map[string]interface{}{
+				"vulnerability": map[string]interface{}{"name": "CVE-2023-1234"},
+				"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+				"status":        "fixed",
+			},
+		},
+	}
+	// affected statements require an action statement
+	invalid := map[string]interface{}{
+		"@context":  "https://openvex.dev/ns",
+		"@id":       "doc2",
+		"timestamp": "2023-01-02T00:00:00Z",
+		"statements": []interface{}{
+			map[string]interface{}{
+				"vulnerability": map[string]interface{}{"name": "CVE-2023-5678"},
+				"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/express@4.18.0"}},
+				"status":        "affected",
+			},
+		},
+	}
+
+	merged, err := client.MergeDocuments(context.Background(), &MergeInput{Documents: []map[string]interface{}{valid, invalid}})
+	if err != nil {
+		t.Fatalf("MergeDocuments() without validate_result error = %v", err)
+	}
+	issues := ValidateStatements(merged.Statements)
+	if len(issues) != 1 || !strings.Contains(issues[0], "CVE-2023-5678") {
+		t.Errorf("ValidateStatements() = %v, want one issue for CVE-2023-5678", issues)
+	}
+
+	_, err = client.MergeDocuments(context.Background(), &MergeInput{
+		Documents:      []map[string]interface{}{valid, invalid},
+		ValidateResult: true,
+	})
+	if err == nil {
+		t.Fatal("MergeDocuments() with validate_result expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "1 invalid statement") || !strings.Contains(err.Error(), "action statement") {
+		t.Errorf("MergeDocuments() error = %v, want invalid statement details", err)
+	}
+
+	if _, err := client.MergeDocuments(context.Background(), &MergeInput{
+		Documents:      []map[string]interface{}{valid, valid},
+		ValidateResult: true,
+	}); err != nil {
+		t.Errorf("MergeDocuments() of valid documents with validate_result error = %v", err)
+	}
+}
+
+func TestCreateDocument_AuthorRole(t *testing.T) {
+	client := NewClient("test-author")
+
+	doc, err := client.CreateDocument(&CreateInput{
+		Product:       "pkg:npm/lodash@4.17.21",
+		Vulnerability: "CVE-2023-1234",
+		Status:        "fixed",
+		AuthorRole:    "Security Engineer",
+	})
+	if err != nil {
+		t.Fatalf("CreateDocument() error = %v", err)
+	}
+	if doc.AuthorRole != "Security Engineer" {
+		t.Errorf("AuthorRole = %v, want Security Engineer", doc.AuthorRole)
+	}
+
+	_, err = client.CreateDocument(&CreateInput{
+		Product:       "pkg:npm/lodash@4.17.21",
+		Vulnerability: "CVE-2023-1234",
+		Status:        "fixed",
+		AuthorRole:    strings.Repeat("a", MaxAuthorRoleLength+1),
+	})
+	if err == nil || !strings.Contains(err.Error(), "author_role exceeds maximum length") {
+		t.Errorf("CreateDocument() error = %v, want author_role length error", err)
+	}
+}
+
+func TestParseJustification_Lenient(t *testing.T) {
+	tests := []struct {
+		input string
+		want  vexlib.Justification
+	}{
+		{"component-not-present", vexlib.ComponentNotPresent},
+		{"componentNotPresent", vexlib.ComponentNotPresent},
+		{"Component Not Present", vexlib.ComponentNotPresent},
+		{"VULNERABLE_CODE_NOT_PRESENT", vexlib.VulnerableCodeNotPresent},
+		{"not-reachable", vexlib.VulnerableCodeNotInExecutePath},
+		{"vulnerableCodeNotInExecutionPath", vexlib.VulnerableCodeNotInExecutePath},
+		{"inline mitigations exist", vexlib.InlineMitigationsAlreadyExist},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseJustification(tt.input, true)
+			if err != nil {
+				t.Fatalf("parseJustification() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("parseJustification() = %v, want %v", got, tt.want)
+			}
+
+			if _, err := parseJustification(tt.input, false); err == nil {
+				t.Error("strict parseJustification() should reject variants")
+			}
+		})
+	}
+
+	if _, err := parseJustification("because-we-said-so", true); err == nil {
+		t.Error("lenient parseJustification() should reject unknown values")
+	}
+}
+
+func TestCreateDocument_LenientJustifications(t *testing.T) {
+	input := &CreateInput{
+		Product:       "pkg:npm/lodash@4.17.21",
+		Vulnerability: "CVE-2023-1234",
+		Status:        "not_affected",
+		Justification: "component-not-present",
+	}
+
+	if _, err := NewClient("test-author").CreateDocument(input); err == nil {
+		t.Error("CreateDocument() should reject variants by default")
+	}
+
+	doc, err := NewClient("test-author", WithLenientJustifications(true)).CreateDocument(input)
+	if err != nil {
+		t.Fatalf("CreateDocument() error = %v", err)
+	}
+	if got := doc.Statements[0].Justification; got != vexlib.ComponentNotPresent {
+		t.Errorf("Justification = %v, want %v", got, vexlib.ComponentNotPresent)
+	}
+}
+
+func TestMergeDocuments_GroupByVulnerability(t *testing.T) {
+	client := NewClient("test-author")
+
+	doc1 := map[string]interface{}{
+		"@context":  "https://openvex.dev/ns",
+		"@id":       "doc1",
+		"timestamp": "2023-01-01T00:00:00Z",
+		"statements": []interface{}{
+			map[string]interface{}{
+				"vulnerability": map[string]interface{}{"name": "CVE-2023-1234"},
+				"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/a@1.0.0"}},
+				"status":        "fixed",
+			},
+			map[string]interface{}{
+				"vulnerability": map[string]interface{}{"name": "CVE-2023-1234"},
+				"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/c@1.0.0"}},
+				"status":        "under_investigation",
+			},
+		},
+	}
+	doc2 := map[string]interface{}{
+		"@context":  "https://openvex.dev/ns",
+		"@id":       "doc2",
+		"timestamp": "2023-02-01T00:00:00Z",
+		"statements": []interface{}{
+			map[string]interface{}{
+				"vulnerability": map[string]interface{}{"name": "CVE-2023-1234"},
+				"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/b@1.0.0"}, map[string]interface{}{"@id": "pkg:npm/a@1.0.0"}},
+				"status":        "fixed",
+			},
+		},
+	}
+
+	merged, err := client.MergeDocuments(context.Background(), &MergeInput{
+		Documents:            []map[string]interface{}{doc1, doc2},
+		GroupByVulnerability: true,
+	})
+	if err != nil {
+		t.Fatalf("MergeDocuments() error = %v", err)
+	}
+
+	if len(merged.Statements) != 2 {
+		t.Fatalf("MergeDocuments() statements = %d, want 2", len(merged.Statements))
+	}
+	byStatus := map[string][]string{}
+	for _, stmt := range merged.Statements {
+		for _, p := range stmt.Products {
+			byStatus[string(stmt.Status)] = append(byStatus[string(stmt.Status)], p.Component.ID)
+		}
+	}
+	if got := strings.Join(byStatus["fixed"], ","); got != "pkg:npm/a@1.0.0,pkg:npm/b@1.0.0" {
+		t.Errorf("fixed products = %v, want a and b once each", got)
+	}
+	if got := strings.Join(byStatus["under_investigation"], ","); got != "pkg:npm/c@1.0.0" {
+		t.Errorf("under_investigation products = %v, want only c", got)
+	}
+}
+
+func TestMergeDocuments_AllowedContexts(t *testing.T) {
+	doc := func(id, context string) map[string]interface{} {
+		return map[string]interface{}{
+			"@context":  context,
+			"@id":       id,
+			"timestamp": "2023-01-01T00:00:00Z",
+			"statements": []interface{}{
+				map[string]interface{}{
+					"vulnerability": map[string]interface{}{"name": "CVE-2023-1234"},
+					"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+					"status":        "fixed",
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		opts            []Option
+		contexts        []string
+		wantErrContains string
+	}{
+		{
+			name:     "any context by default",
+			contexts: []string{"https://openvex.dev/ns", "https://openvex.dev/ns/v0.2.0"},
+		},
+		{
+			name:     "allowed contexts",
+			opts:     []Option{WithAllowedContexts("https://openvex.dev/ns", "https://openvex.dev/ns/v0.2.0")},
+			contexts: []string{"https://openvex.dev/ns", "https://openvex.dev/ns/v0.2.0"},
+		},
+		{
+			name:            "disallowed context",
+			opts:            []Option{WithAllowedContexts("https://openvex.dev/ns/v0.2.0")},
+			contexts:        []string{"https://openvex.dev/ns/v0.2.0", "https://example.com/untrusted"},
+			wantErrContains: `document 2 @context "https://example.com/untrusted" is not allowed: must be one of https://openvex.dev/ns/v0.2.0`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient("test-author", tt.opts...)
+			docs := make([]map[string]interface{}, 0, len(tt.contexts))
+			for i, context := range tt.contexts {
+				docs = append(docs, doc(fmt.Sprintf("doc%d", i+1), context))
+			}
+
+			// Every merge built on the supplied documents enforces the allowlist
+			merges := map[string]func(*MergeInput) (*Document, error){
+				"MergeDocuments": func(input *MergeInput) (*Document, error) {
+					return client.MergeDocuments(context.Background(), input)
+				},
+				"ConsolidateLatest": client.ConsolidateLatest,
+			}
+			for name, merge := range merges {
+				_, err := merge(&MergeInput{Documents: docs})
+				if tt.wantErrContains == "" {
+					if err != nil {
+						t.Fatalf("%s() error = %v", name, err)
+					}
+					continue
+				}
+				if err == nil {
+					t.Fatalf("%s() expected error, got nil", name)
+				}
+				if !strings.Contains(err.Error(), tt.wantErrContains) {
+					t.Errorf("%s() error = %v, want to contain %v", name, err, tt.wantErrContains)
+				}
+			}
+		})
+	}
+}
+
+func TestMergeDocuments_TimestampStrategy(t *testing.T) {
+	doc := func(id, timestamp string) map[string]interface{} {
+		return map[string]interface{}{
+			"@context":  "https://openvex.dev/ns",
+			"@id":       id,
+			"timestamp": timestamp,
+			"statements": []interface{}{
+				map[string]interface{}{
+					"vulnerability": map[string]interface{}{"name": "CVE-2023-1234"},
+					"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+					"status":        "fixed",
+				},
+			},
+		}
+	}
+	docs := []map[string]interface{}{
+		doc("doc1", "2023-02-01T00:00:00Z"),
+		doc("doc2", "2023-03-01T00:00:00Z"),
+		doc("doc3", "2023-01-01T00:00:00Z"),
+	}
+
+	tests := []struct {
+		name            string
+		strategy        string
+		want            string
+		wantErrContains string
+	}{
+		{name: "default uses merge time", strategy: ""},
+		{name: "now uses merge time", strategy: TimestampStrategyNow},
+		{name: "latest source", strategy: TimestampStrategyLatestSource, want: "2023-03-01T00:00:00Z"},
+		{name: "earliest source", strategy: TimestampStrategyEarliestSource, want: "2023-01-01T00:00:00Z"},
+		{name: "unknown strategy", strategy: "oldest", wantErrContains: "timestamp_strategy must be one of: now, latest_source, earliest_source"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient("test-author")
+			before := time.Now()
+			merged, err := client.MergeDocuments(context.Background(), &MergeInput{Documents: docs, TimestampStrategy: tt.strategy})
+			if tt.wantErrContains != "" {
+				if err == nil {
+					t.Fatal("MergeDocuments() expected error, got nil")
+				}
+				if !strings.Contains(err.Error(), tt.wantErrContains) {
+					t.Errorf("MergeDocuments() error = %v, want to contain %v", err, tt.wantErrContains)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("MergeDocuments() error = %v", err)
+			}
+
+			if tt.want == "" {
+				if merged.Timestamp.Before(before) {
+					t.Errorf("MergeDocuments() timestamp = %v, want merge time", merged.Timestamp)
+				}
+				return
+			}
+			if got := merged.Timestamp.UTC().Format(time.RFC3339); got != tt.want {
+				t.Errorf("MergeDocuments() timestamp = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateDocument_CollectErrors(t *testing.T) {
+	client := NewClient("test-author")
+	input := &CreateInput{
+		Product:         "pkg:npm/test;rm -rf",
+		Vulnerability:   "",
+		Status:          "fixed",
+		ActionStatement: strings.Repeat("a", MaxStringLength+1),
+		Author:          "$(whoami)",
+	}
+
+	// Fail fast by default
+	_, err := client.CreateDocument(input)
+	if err == nil {
+		t.Fatal("CreateDocument() expected error, got nil")
+	}
+	if got := strings.Count(err.Error(), "\n") + 1; got != 1 {
+		t.Errorf("CreateDocument() reported %d errors by default, want 1: %v", got, err)
+	}
+
+	input.CollectErrors = true
+	_, err = client.CreateDocument(input)
+	if err == nil {
+		t.Fatal("CreateDocument() expected error, got nil")
+	}
+	for _, want := range []string{
+		"product contains potentially dangerous characters",
+		"vulnerability is required",
+		"action_statement exceeds maximum length",
+		"author contains potentially dangerous characters",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("CreateDocument() error = %v, want to contain %v", err, want)
+		}
+	}
+	if !strings.HasPrefix(err.Error(), "validation error: ") {
+		t.Errorf("CreateDocument() error = %v, want validation error prefix", err)
+	}
+}
+
+// benchmarkDocuments returns count decoded documents with statementsPerDoc
+// statements each, spread over a handful of products
+func benchmarkDocuments(b *testing.B, count, statementsPerDoc int) []map[string]interface{} {
+	b.Helper()
+	docs := make([]map[string]interface{}, 0, count)
+	for d := 0; d < count; d++ {
+		statements := make([]interface{}, 0, statementsPerDoc)
+		for s := 0; s < statementsPerDoc; s++ {
+			statements = append(statements, map[string]interface{}{
+				"vulnerability": map[string]interface{}{"name": fmt.Sprintf("CVE-2023-%04d", d*statementsPerDoc+s)},
+				"products":      []interface{}{map[string]interface{}{"@id": fmt.Sprintf("pkg:npm/pkg-%d@1.0.0", s%5)}},
+				"status":        "fixed",
+			})
+		}
+		docs = append(docs, map[string]interface{}{
+			"@context":   "https://openvex.dev/ns",
+			"@id":        fmt.Sprintf("https://example.com/vex/%d", d),
+			"author":     "bench-author",
+			"version":    1,
+			"timestamp":  "2023-01-01T00:00:00Z",
+			"statements": statements,
+		})
+	}
+	return docs
+}
+
+func BenchmarkMergeDocuments(b *testing.B) {
+	client := NewClient("bench-author")
+
+	benchmarks := []struct {
+		name  string
+		input *MergeInput
+	}{
+		{
+			name:  "small",
+			input: &MergeInput{Documents: benchmarkDocuments(b, 2, 5)},
+		},
+		{
+			name:  "medium",
+			input: &MergeInput{Documents: benchmarkDocuments(b, 20, 10)},
+		},
+		{
+			name: "filtered",
+			input: &MergeInput{
+				Documents:       benchmarkDocuments(b, 20, 10),
+				Products:        []string{"pkg:npm/pkg-1@1.0.0"},
+				Vulnerabilities: []string{"CVE-2023-0001", "CVE-2023-0101"},
+			},
+		},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := client.MergeDocuments(context.Background(), bm.input); err != nil {
+					b.Fatalf("MergeDocuments() error = %v", err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkCreateStatement(b *testing.B) {
+	client := NewClient("bench-author")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, err := client.CreateStatement(
+			"pkg:npm/lodash@4.17.21",
+			"CVE-2023-1234",
+			"not_affected",
+			"component_not_present",
+			"",
+			"",
+			"",
+		)
+		if err != nil {
+			b.Fatalf("CreateStatement() error = %v", err)
+		}
+	}
+}
+
+func TestMergeDocuments_StatusFilter(t *testing.T) {
+	client := NewClient("test-author")
+
+	var docMap map[string]interface{}
+	json.Unmarshal([]byte(`{
+		"@context": "https://openvex.dev/ns",
+		"@id": "doc1",
+		"author": "author1",
+		"version": 1,
+		"timestamp": "2023-01-01T00:00:00Z",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "affected", "action_statement": "Upgrade"},
+			{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "fixed"},
+			{"vulnerability": {"name": "CVE-2023-0003"}, "products": [{"@id": "pkg:npm/express@4.18.0"}], "status": "affected", "action_statement": "Upgrade"},
+			{"vulnerability": {"name": "CVE-2023-0004"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "under_investigation"}
+		]
+	}`), &docMap)
+
+	var otherMap map[string]interface{}
+	json.Unmarshal([]byte(`{
+		"@context": "https://openvex.dev/ns",
+		"@id": "doc2",
+		"author": "author2",
+		"version": 1,
+		"timestamp": "2023-01-02T00:00:00Z",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0005"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "fixed"}
+		]
+	}`), &otherMap)
+
+	merged, err := client.MergeDocuments(context.Background(), &MergeInput{
+		Documents: []map[string]interface{}{docMap, otherMap},
+		Products:  []string{"pkg:npm/lodash@4.17.21"},
+		Statuses:  []string{"affected", "under_investigation"},
+	})
+	if err != nil {
+		t.Fatalf("MergeDocuments() error = %v", err)
+	}
+
+	var got []string
+	for _, stmt := range merged.Statements {
+		got = append(got, string(stmt.Vulnerability.Name))
+	}
+	if want := "CVE-2023-0001,CVE-2023-0004"; strings.Join(got, ",") != want {
+		t.Errorf("filtered vulnerabilities = %v, want %s", got, want)
+	}
+
+	_, err = client.MergeDocuments(context.Background(), &MergeInput{
+		Documents: []map[string]interface{}{docMap, otherMap},
+		Statuses:  []string{"affected", "resolved"},
+	})
+	if err == nil {
+		t.Fatal("MergeDocuments() expected error for unknown status")
+	}
+	if !strings.Contains(err.Error(), "statuses[1] must be one of") {
+		t.Errorf("MergeDocuments() error = %v, want to contain %v", err, "statuses[1] must be one of")
+	}
+}
+
+func TestMergeDocuments_MaxProducts(t *testing.T) {
+	client := NewClient("test-author")
+
+	doc1 := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"@id": "doc1",
+		"timestamp": "2023-01-01T00:00:00Z",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}, {"@id": "pkg:npm/express@4.18.0"}], "status": "fixed"},
+			{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "fixed"}
+		]
+	}`)
+	doc2 := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"@id": "doc2",
+		"timestamp": "2023-01-02T00:00:00Z",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0003"}, "products": [{"@id": "pkg:npm/react@18.2.0"}], "status": "fixed"}
+		]
+	}`)
+
+	tests := []struct {
+		name            string
+		maxProducts     int
+		products        []string
+		wantErrContains string
+	}{
+		{name: "no cap", maxProducts: 0},
+		{name: "at cap", maxProducts: 3},
+		{name: "over cap", maxProducts: 2, wantErrContains: "covers 3 distinct products, exceeding max_products of 2"},
+		{name: "within cap after filtering", maxProducts: 1, products: []string{"pkg:npm/react@18.2.0"}},
+		{name: "negative", maxProducts: -1, wantErrContains: "max_products must not be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.MergeDocuments(context.Background(), &MergeInput{
+				Documents:   []map[string]interface{}{doc1, doc2},
+				Products:    tt.products,
+				MaxProducts: tt.maxProducts,
+			})
+			if tt.wantErrContains == "" {
+				if err != nil {
+					t.Errorf("MergeDocuments() error = %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("MergeDocuments() expected error")
+			}
+			if !strings.Contains(err.Error(), tt.wantErrContains) {
+				t.Errorf("MergeDocuments() error = %v, want to contain %v", err, tt.wantErrContains)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package vex
+
+import (
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// OverriddenExtension is the extension field holding the statements dropped
+// when a merge keeps only the most severe status per vulnerability and product
+const OverriddenExtension = "overridden_statements"
+
+// statusSeverity orders statuses from least to most severe, so an unresolved
+// exposure is never hidden by a more reassuring statement
+var statusSeverity = map[vexlib.Status]int{
+	vexlib.StatusNotAffected:        0,
+	vexlib.StatusFixed:              1,
+	vexlib.StatusUnderInvestigation: 2,
+	vexlib.StatusAffected:           3,
+}
+
+// keepMostSevere resolves statements covering the same (vulnerability,
+// product) pair by keeping the one with the most severe status, the newest
+// on equal severity. A statement keeps the products it wins; the products it
+// loses are returned as overridden statements, one per product, so the
+// information resolution discards stays traceable.
+func keepMostSevere(statements []vexlib.Statement) (kept, overridden []vexlib.Statement) {
+	pairKey := func(stmt *vexlib.Statement, product vexlib.Product) string {
+		return string(stmt.Vulnerability.Name) + "\x00" + product.Component.ID
+	}
+	beats := func(candidate, current *vexlib.Statement) bool {
+		if statusSeverity[candidate.Status] != statusSeverity[current.Status] {
+			return statusSeverity[candidate.Status] > statusSeverity[current.Status]
+		}
+		return candidate.Timestamp != nil &&
+			(current.Timestamp == nil || candidate.Timestamp.After(*current.Timestamp))
+	}
+
+	winners := map[string]int{}
+	for i := range statements {
+		for _, product := range statements[i].Products {
+			key := pairKey(&statements[i], product)
+			if current, seen := winners[key]; !seen || beats(&statements[i], &statements[current]) {
+				winners[key] = i
+			}
+		}
+	}
+
+	kept = make([]vexlib.Statement, 0, len(statements))
+	overridden = []vexlib.Statement{}
+	for i, stmt := range statements {
+		if len(stmt.Products) == 0 {
+			kept = append(kept, stmt)
+			continue
+		}
+		var won []vexlib.Product
+		for _, product := range stmt.Products {
+			if winners[pairKey(&stmt, product)] == i {
+				won = append(won, product)
+				continue
+			}
+			lost := stmt
+			lost.Products = []vexlib.Product{product}
+			overridden = append(overridden, lost)
+		}
+		if len(won) > 0 {
+			stmt.Products = won
+			kept = append(kept, stmt)
+		}
+	}
+	return kept, overridden
+}
@@ -0,0 +1,91 @@
+package vex
+
+import (
+	"context"
+	"testing"
+)
+
+func TestMergeDocuments_KeepMostSevere(t *testing.T) {
+	client := NewClient("test-author")
+
+	vendor := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"@id": "vendor",
+		"timestamp": "2023-01-01T00:00:00Z",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}, {"@id": "pkg:npm/b@1.0.0"}], "status": "not_affected", "justification": "component_not_present"},
+			{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"}
+		]
+	}`)
+	internal := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"@id": "internal",
+		"timestamp": "2023-02-01T00:00:00Z",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "affected", "action_statement": "Upgrade"},
+			{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"}
+		]
+	}`)
+
+	merged, err := client.MergeDocuments(context.Background(), &MergeInput{
+		Documents:      []map[string]interface{}{vendor, internal},
+		KeepMostSevere: true,
+	})
+	if err != nil {
+		t.Fatalf("MergeDocuments() error = %v", err)
+	}
+
+	// One statement remains per (vulnerability, product) pair
+	kept := map[string]string{}
+	for _, stmt := range merged.Statements {
+		for _, product := range stmt.Products {
+			key := string(stmt.Vulnerability.Name) + " " + product.Component.ID
+			if _, dup := kept[key]; dup {
+				t.Errorf("%s is covered by more than one statement", key)
+			}
+			kept[key] = string(stmt.Status)
+		}
+	}
+	want := map[string]string{
+		"CVE-2023-0001 pkg:npm/a@1.0.0": "affected",
+		"CVE-2023-0001 pkg:npm/b@1.0.0": "not_affected",
+		"CVE-2023-0002 pkg:npm/a@1.0.0": "fixed",
+	}
+	for key, status := range want {
+		if kept[key] != status {
+			t.Errorf("%s status = %q, want %q", key, kept[key], status)
+		}
+	}
+
+	// The overridden statements are retained in the output metadata
+	overridden, ok := toRaw(t, merged)[OverriddenExtension].([]interface{})
+	if !ok || len(overridden) != 2 {
+		t.Fatalf("%s = %v, want 2 statements", OverriddenExtension, overridden)
+	}
+	statuses := map[string]bool{}
+	for _, raw := range overridden {
+		stmt := raw.(map[string]interface{})
+		products := stmt["products"].([]interface{})
+		if len(products) != 1 || products[0].(map[string]interface{})["@id"] != "pkg:npm/a@1.0.0" {
+			t.Errorf("overridden statement products = %v, want only pkg:npm/a@1.0.0", products)
+		}
+		statuses[stmt["vulnerability"].(map[string]interface{})["name"].(string)+" "+stmt["status"].(string)] = true
+	}
+	for _, want := range []string{"CVE-2023-0001 not_affected", "CVE-2023-0002 fixed"} {
+		if !statuses[want] {
+			t.Errorf("overridden statements should include %s, got %v", want, statuses)
+		}
+	}
+
+	// Without the option every statement is kept and nothing recorded
+	plain, err := client.MergeDocuments(context.Background(), &MergeInput{Documents: []map[string]interface{}{vendor, internal}})
+	if err != nil {
+		t.Fatalf("MergeDocuments() error = %v", err)
+	}
+	if len(plain.Statements) != 4 {
+		t.Errorf("plain merge kept %d statements, want 4", len(plain.Statements))
+	}
+	if _, ok := plain.Extensions[OverriddenExtension]; ok {
+		t.Error("Overridden statements should only be recorded when requested")
+	}
+}
@@ -0,0 +1,131 @@
+package vex
+
+import (
+	"fmt"
+	"time"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// ConsolidateLatest combines the statements of several documents, keeping
+// only the newest statement for each (vulnerability, product) pair. Statements
+// covering several products are split into one statement per product. On
+// equal timestamps the statement from the later document wins. When
+// input.DocumentPriority is set, a statement from a higher-priority document
+// wins regardless of timestamps; documents not listed rank below all listed
+// ones.
+func (c *Client) ConsolidateLatest(input *MergeInput) (*Document, error) {
+	if err := ValidateDocumentListCount(len(input.Documents)); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	if err := validateMergeMetadata(input); err != nil {
+		return nil, err
+	}
+	if err := c.checkDocumentStructure(input.Documents); err != nil {
+		return nil, err
+	}
+
+	docs, err := parseDocuments(input.Documents)
+	if err != nil {
+		return nil, err
+	}
+
+	ranks, err := documentRanks(docs, input.DocumentPriority)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := map[string]vexlib.Statement{}
+	winnerRanks := map[string]int{}
+	var keys []string
+	docIDs := make([]string, 0, len(docs))
+	labels := map[string]string{}
+	for i, doc := range docs {
+		if doc.ID == "" {
+			docIDs = append(docIDs, fmt.Sprintf("document-%d", i+1))
+		} else {
+			docIDs = append(docIDs, doc.ID)
+		}
+		for key, value := range documentLabels(input.Documents[i]) {
+			labels[key] = value
+		}
+
+		for _, stmt := range doc.Statements {
+			// Cascade the document timestamp to timeless statements, as go-vex does
+			if stmt.Timestamp == nil {
+				if doc.Timestamp == nil {
+					return nil, fmt.Errorf("document %d has a statement without a timestamp and no document timestamp", i+1)
+				}
+				stmt.Timestamp = doc.Timestamp
+			}
+
+			products := stmt.Products
+			if len(products) == 0 {
+				products = []vexlib.Product{{}}
+			}
+			for _, product := range products {
+				candidate := stmt
+				if product.Component.ID != "" {
+					candidate.Products = []vexlib.Product{product}
+				}
+
+				key := string(stmt.Vulnerability.Name) + "\x00" + product.Component.ID
+				current, seen := latest[key]
+				if !seen {
+					keys = append(keys, key)
+				} else if ranks[i] > winnerRanks[key] ||
+					(ranks[i] == winnerRanks[key] && candidate.Timestamp.Before(*current.Timestamp)) {
+					continue
+				}
+				latest[key] = candidate
+				winnerRanks[key] = ranks[i]
+			}
+		}
+	}
+
+	consolidated := vexlib.New()
+	consolidated.ID = mergedDocumentID(docIDs)
+	for _, key := range keys {
+		consolidated.Statements = append(consolidated.Statements, latest[key])
+	}
+	vexlib.SortStatements(consolidated.Statements, *consolidated.Timestamp)
+
+	var provenance []ProvenanceEvent
+	if input.RecordProvenance {
+		provenance, err = mergeProvenance(input.Documents, docIDs, time.Now())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c.finalizeMerge(&consolidated, input, labels, documentTimestamps(docs), provenance)
+}
+
+// documentRanks returns the rank of each document in priority, 0 being the
+// most authoritative. Unlisted documents share the lowest rank.
+func documentRanks(docs []*vexlib.VEX, priority []string) ([]int, error) {
+	positions := make(map[string]int, len(priority))
+	for i, id := range priority {
+		if _, ok := positions[id]; !ok {
+			positions[id] = i
+		}
+	}
+
+	matched := map[string]bool{}
+	ranks := make([]int, len(docs))
+	for i, doc := range docs {
+		position, ok := positions[doc.ID]
+		if !ok || doc.ID == "" {
+			ranks[i] = len(priority)
+			continue
+		}
+		ranks[i] = position
+		matched[doc.ID] = true
+	}
+	for _, id := range priority {
+		if !matched[id] {
+			return nil, fmt.Errorf("document_priority %q does not match the @id of any document", id)
+		}
+	}
+	return ranks, nil
+}
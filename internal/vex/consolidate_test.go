@@ -0,0 +1,177 @@
+package vex
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestConsolidateLatest(t *testing.T) {
+	client := NewClient("test-author")
+
+	older := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"@id": "older",
+		"timestamp": "2023-01-01T00:00:00Z",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}, {"@id": "pkg:npm/axios@1.0.0"}], "status": "under_investigation"},
+			{"vulnerability": {"name": "CVE-2023-5678"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "fixed", "timestamp": "2023-06-01T00:00:00Z"}
+		]
+	}`)
+	newer := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"@id": "newer",
+		"timestamp": "2023-03-01T00:00:00Z",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "fixed"},
+			{"vulnerability": {"name": "CVE-2023-5678"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "affected", "action_statement": "Upgrade"}
+		]
+	}`)
+
+	doc, err := client.ConsolidateLatest(&MergeInput{Documents: []map[string]interface{}{older, newer}})
+	if err != nil {
+		t.Fatalf("ConsolidateLatest() error = %v", err)
+	}
+
+	got := map[string]string{}
+	for _, stmt := range doc.Statements {
+		if len(stmt.Products) != 1 {
+			t.Fatalf("statement should cover exactly one product, got %d", len(stmt.Products))
+		}
+		got[string(stmt.Vulnerability.Name)+" "+stmt.Products[0].Component.ID] = string(stmt.Status)
+	}
+	want := map[string]string{
+		// Newer document timestamp beats the older cascaded one
+		"CVE-2023-1234 pkg:npm/lodash@4.17.21": "fixed",
+		// Only present in the older document
+		"CVE-2023-1234 pkg:npm/axios@1.0.0": "under_investigation",
+		// The older document's explicit statement timestamp is newest
+		"CVE-2023-5678 pkg:npm/lodash@4.17.21": "fixed",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("ConsolidateLatest() statements = %v, want %v", got, want)
+	}
+	for key, status := range want {
+		if got[key] != status {
+			t.Errorf("statement %s status = %v, want %v", key, got[key], status)
+		}
+	}
+}
+
+func TestConsolidateLatest_Errors(t *testing.T) {
+	client := NewClient("test-author")
+
+	tests := []struct {
+		name            string
+		input           *MergeInput
+		wantErrContains string
+	}{
+		{
+			name:            "no documents",
+			input:           &MergeInput{},
+			wantErrContains: "at least one document is required",
+		},
+		{
+			name: "missing timestamps",
+			input: &MergeInput{Documents: []map[string]interface{}{decodeDocument(t, `{
+				"@context": "https://openvex.dev/ns",
+				"statements": [{"vulnerability": {"name": "CVE-2023-1234"}, "status": "fixed"}]
+			}`)}},
+			wantErrContains: "without a timestamp",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.ConsolidateLatest(tt.input)
+			if err == nil {
+				t.Fatal("ConsolidateLatest() expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.wantErrContains) {
+				t.Errorf("ConsolidateLatest() error = %v, want to contain %v", err.Error(), tt.wantErrContains)
+			}
+		})
+	}
+}
+
+func TestConsolidateLatest_DocumentPriority(t *testing.T) {
+	client := NewClient("test-author")
+
+	vendor := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"@id": "vendor",
+		"timestamp": "2023-01-01T00:00:00Z",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "not_affected", "justification": "vulnerable_code_not_present"}
+		]
+	}`)
+	scanner := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"@id": "scanner",
+		"timestamp": "2023-06-01T00:00:00Z",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "affected", "action_statement": "Upgrade"},
+			{"vulnerability": {"name": "CVE-2023-5678"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "fixed"}
+		]
+	}`)
+
+	tests := []struct {
+		name            string
+		priority        []string
+		want            map[string]string
+		wantErrContains string
+	}{
+		{
+			name: "latest wins without priority",
+			want: map[string]string{"CVE-2023-1234": "affected", "CVE-2023-5678": "fixed"},
+		},
+		{
+			name:     "priority overrides timestamp order",
+			priority: []string{"vendor", "scanner"},
+			want:     map[string]string{"CVE-2023-1234": "not_affected", "CVE-2023-5678": "fixed"},
+		},
+		{
+			name:     "unlisted documents rank lowest",
+			priority: []string{"vendor"},
+			want:     map[string]string{"CVE-2023-1234": "not_affected", "CVE-2023-5678": "fixed"},
+		},
+		{
+			name:            "unknown document",
+			priority:        []string{"vendor", "distro"},
+			wantErrContains: `document_priority "distro" does not match the @id of any document`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := client.ConsolidateLatest(&MergeInput{
+				Documents:        []map[string]interface{}{vendor, scanner},
+				DocumentPriority: tt.priority,
+			})
+			if tt.wantErrContains != "" {
+				if err == nil {
+					t.Fatal("ConsolidateLatest() expected error, got nil")
+				}
+				if !strings.Contains(err.Error(), tt.wantErrContains) {
+					t.Errorf("ConsolidateLatest() error = %v, want to contain %v", err, tt.wantErrContains)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ConsolidateLatest() error = %v", err)
+			}
+
+			got := map[string]string{}
+			for _, stmt := range doc.Statements {
+				got[string(stmt.Vulnerability.Name)] = string(stmt.Status)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("ConsolidateLatest() statements = %v, want %v", got, tt.want)
+			}
+			for vuln, status := range tt.want {
+				if got[vuln] != status {
+					t.Errorf("statement %s status = %v, want %v", vuln, got[vuln], status)
+				}
+			}
+		})
+	}
+}
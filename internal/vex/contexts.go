@@ -0,0 +1,53 @@
+package vex
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WithAllowedContexts restricts the @context URIs accepted in documents
+// supplied for merging. Without any, every document with an @context is
+// accepted.
+func WithAllowedContexts(contexts ...string) Option {
+	return func(c *Client) {
+		for _, context := range contexts {
+			if context = strings.TrimSpace(context); context != "" {
+				c.allowedContexts = append(c.allowedContexts, context)
+			}
+		}
+	}
+}
+
+// checkAllowedContext rejects a context missing from the allowlist. name
+// identifies the document in the error.
+func (c *Client) checkAllowedContext(name string, context interface{}) error {
+	if len(c.allowedContexts) == 0 {
+		return nil
+	}
+	value, _ := context.(string)
+	for _, allowed := range c.allowedContexts {
+		if value == allowed {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s @context %q is not allowed: must be one of %s",
+		name, value, strings.Join(c.allowedContexts, ", "))
+}
+
+// checkDocumentStructure validates that each document supplied for merging
+// has basic structure and an allowed @context
+func (c *Client) checkDocumentStructure(documents []map[string]interface{}) error {
+	for i, doc := range documents {
+		context, hasContext := doc["@context"]
+		if !hasContext {
+			return fmt.Errorf("document %d must be a valid VEX document with @context", i+1)
+		}
+		if err := c.checkAllowedContext(fmt.Sprintf("document %d", i+1), context); err != nil {
+			return err
+		}
+		if _, hasStatements := doc["statements"]; !hasStatements {
+			return fmt.Errorf("document %d must be a valid VEX document with statements", i+1)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package vex
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// CVSSExtension is the statement extension field holding a CVSS severity.
+// It is not part of the OpenVEX specification.
+const CVSSExtension = "cvss"
+
+// CVSS vector formats accepted for the cvss extension: v3.0/v3.1 and v4.0
+// base metrics, optionally followed by further metrics
+var (
+	cvss3Pattern = regexp.MustCompile(`^CVSS:3\.[01]/AV:[NALP]/AC:[LH]/PR:[NLH]/UI:[NR]/S:[UC]/C:[NLH]/I:[NLH]/A:[NLH](/[A-Za-z]+:[A-Za-z])*$`)
+	cvss4Pattern = regexp.MustCompile(`^CVSS:4\.0/AV:[NALP]/AC:[LH]/AT:[NP]/PR:[NLH]/UI:[NPA]/VC:[HLN]/VI:[HLN]/VA:[HLN]/SC:[HLN]/SI:[HLN]/SA:[HLN](/[A-Za-z]+:[A-Za-z])*$`)
+)
+
+// CVSS is a severity attached to a statement, given as a vector or a score
+type CVSS struct {
+	Vector string   `json:"vector,omitempty"`
+	Score  *float64 `json:"score,omitempty"`
+}
+
+// ParseCVSS parses and validates a CVSS vector or a numeric base score
+func ParseCVSS(value string) (*CVSS, error) {
+	value = strings.TrimSpace(value)
+	cvss := &CVSS{Vector: value}
+	if score, err := strconv.ParseFloat(value, 64); err == nil {
+		cvss = &CVSS{Score: &score}
+	}
+	if err := cvss.Validate(); err != nil {
+		return nil, err
+	}
+	return cvss, nil
+}
+
+// Validate checks the score range and vector format
+func (c *CVSS) Validate() error {
+	if c.Score != nil && (*c.Score < 0 || *c.Score > 10) {
+		return &ValidationError{Field: "cvss", Reason: "score must be between 0.0 and 10.0"}
+	}
+	if c.Vector != "" {
+		if len(c.Vector) > MaxStringLength {
+			return &ValidationError{Field: "cvss", Reason: fmt.Sprintf("exceeds maximum length of %d characters", MaxStringLength)}
+		}
+		if !cvss3Pattern.MatchString(c.Vector) && !cvss4Pattern.MatchString(c.Vector) {
+			return &ValidationError{Field: "cvss", Reason: "must be a CVSS v3.x or v4.0 vector (e.g., CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H) or a score"}
+		}
+	}
+	if c.Score == nil && c.Vector == "" {
+		return &ValidationError{Field: "cvss", Reason: "is empty"}
+	}
+	return nil
+}
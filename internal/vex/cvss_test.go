@@ -0,0 +1,96 @@
+package vex
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseCVSS(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		wantScore float64
+		wantErr   bool
+	}{
+		{name: "v3.1 vector", value: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"},
+		{name: "v3.0 vector with temporal metrics", value: "CVSS:3.0/AV:L/AC:H/PR:L/UI:R/S:C/C:L/I:N/A:N/E:P/RL:O"},
+		{name: "v4.0 vector", value: "CVSS:4.0/AV:N/AC:L/AT:N/PR:N/UI:N/VC:H/VI:H/VA:H/SC:N/SI:N/SA:N"},
+		{name: "score", value: "7.5", wantScore: 7.5},
+		{name: "score out of range", value: "11", wantErr: true},
+		{name: "unknown version", value: "CVSS:2.0/AV:N/AC:L/Au:N/C:P/I:P/A:P", wantErr: true},
+		{name: "missing metric", value: "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H", wantErr: true},
+		{name: "garbage", value: "critical", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cvss, err := ParseCVSS(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCVSS() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if tt.wantScore != 0 {
+				if cvss.Score == nil || *cvss.Score != tt.wantScore {
+					t.Errorf("ParseCVSS() score = %v, want %v", cvss.Score, tt.wantScore)
+				}
+			} else if cvss.Vector != tt.value {
+				t.Errorf("ParseCVSS() vector = %v, want %v", cvss.Vector, tt.value)
+			}
+		})
+	}
+}
+
+func TestCreateDocument_CVSSRoundTrip(t *testing.T) {
+	client := NewClient("test-author")
+	vector := "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"
+
+	doc, err := client.CreateDocument(&CreateInput{
+		Product:         "pkg:npm/lodash@4.17.20",
+		Vulnerability:   "CVE-2023-1234",
+		Status:          "affected",
+		ActionStatement: "Upgrade",
+		CVSS:            &CVSS{Vector: vector},
+	})
+	if err != nil {
+		t.Fatalf("CreateDocument() error = %v", err)
+	}
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var decoded struct {
+		Statements []struct {
+			Status string `json:"status"`
+			CVSS   CVSS   `json:"cvss"`
+		} `json:"statements"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if len(decoded.Statements) != 1 || decoded.Statements[0].CVSS.Vector != vector {
+		t.Fatalf("cvss extension should round-trip, got %s", data)
+	}
+	if decoded.Statements[0].Status != "affected" {
+		t.Errorf("standard statement fields should be preserved, got %s", data)
+	}
+
+	// The extension must not break parsing by standard OpenVEX consumers
+	var raw map[string]interface{}
+	json.Unmarshal(data, &raw)
+	if _, err := parseDocument(raw); err != nil {
+		t.Errorf("document with cvss extension should parse, got %v", err)
+	}
+
+	_, err = client.CreateDocument(&CreateInput{
+		Product:       "pkg:npm/lodash@4.17.20",
+		Vulnerability: "CVE-2023-1234",
+		Status:        "fixed",
+		CVSS:          &CVSS{Vector: "high"},
+	})
+	if err == nil {
+		t.Error("CreateDocument() should reject an invalid CVSS vector")
+	}
+}
@@ -0,0 +1,176 @@
+package vex
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// DirectoryDocumentPattern matches the files read by MergeDirectory
+const DirectoryDocumentPattern = "*.vex.json"
+
+// MergeDirectory merges every document matching DirectoryDocumentPattern in dir.
+// Documents are parsed one at a time and their statements folded into a single
+// accumulator, so only the merged statements are held in memory rather than
+// every parsed document. input.Documents is ignored. The merge stops before
+// the next file once ctx is done.
+func (c *Client) MergeDirectory(ctx context.Context, dir string, input *MergeInput) (*Document, error) {
+	doc, err := c.mergeDirectory(ctx, dir, input)
+	if err != nil {
+		c.logRejection("merge_directory", err)
+	}
+	return doc, err
+}
+
+func (c *Client) mergeDirectory(ctx context.Context, dir string, input *MergeInput) (*Document, error) {
+	if err := ValidateRequired("directory", dir); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	if err := validateMergeMetadata(input); err != nil {
+		return nil, err
+	}
+	if err := validateNoDocumentPriority(input); err != nil {
+		return nil, err
+	}
+	dir, err := c.ResolvePath("directory", dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, DirectoryDocumentPattern))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list directory: %w", err)
+	}
+	if err := ValidateDirectoryFileCount(len(files), c.maxDirectoryFiles); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	sort.Strings(files)
+
+	var statements []vexlib.Statement
+	labels := map[string]string{}
+	sources := make([]map[string]interface{}, 0, len(files))
+	docIDs := make([]string, 0, len(files))
+	timestamps := make([]*time.Time, 0, len(files))
+	for _, path := range files {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// A file may be a symlink out of the file root
+		if _, err := c.ResolvePath(filepath.Base(path), path); err != nil {
+			return nil, err
+		}
+		doc, err := readDocumentFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.checkAllowedContext(filepath.Base(path), doc.Context); err != nil {
+			return nil, err
+		}
+
+		// Later documents win on label conflicts, as in MergeDocuments
+		for key, value := range stringLabels(doc.Labels) {
+			labels[key] = value
+		}
+		source := map[string]interface{}{}
+		if doc.Provenance != nil {
+			source[ProvenanceExtension] = doc.Provenance
+		}
+		sources = append(sources, source)
+		timestamps = append(timestamps, doc.Timestamp)
+		if doc.ID == "" {
+			docIDs = append(docIDs, filepath.Base(path))
+		} else {
+			docIDs = append(docIDs, doc.ID)
+		}
+
+		for _, stmt := range doc.Statements {
+			// Cascade the document timestamp to timeless statements, as go-vex does
+			if stmt.Timestamp == nil {
+				if doc.Timestamp == nil {
+					return nil, fmt.Errorf("document %s has a statement without a timestamp and no document timestamp", filepath.Base(path))
+				}
+				stmt.Timestamp = doc.Timestamp
+			}
+			statements = append(statements, stmt)
+		}
+	}
+
+	merged := vexlib.New()
+	merged.ID = mergedDocumentID(docIDs)
+	merged.Statements = statements
+	vexlib.SortStatements(merged.Statements, *merged.Timestamp)
+
+	var provenance []ProvenanceEvent
+	if input.RecordProvenance {
+		if provenance, err = mergeProvenance(sources, docIDs, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.finalizeMerge(&merged, input, labels, timestamps, provenance)
+}
+
+// documentFile is a VEX document read from disk along with its provenance
+// and labels extensions, which decoding into vexlib.VEX alone would drop
+type documentFile struct {
+	vexlib.VEX
+	Provenance interface{} `json:"provenance,omitempty"`
+	Labels     interface{} `json:"labels,omitempty"`
+}
+
+// readDocumentFile decodes a single VEX document from disk. The file is
+// streamed into the document rather than read into memory first, as
+// vexlib.Parse would require, and files over MaxDocumentFileBytes are
+// rejected before decoding.
+func readDocumentFile(path string) (*documentFile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", filepath.Base(path), err)
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", filepath.Base(path), err)
+	}
+	if err := ValidateDocumentFileSize(filepath.Base(path), info.Size()); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	// The limit also covers files that grow after the size check
+	decoder := json.NewDecoder(io.LimitReader(file, MaxDocumentFileBytes))
+	doc := &documentFile{}
+	if err := decoder.Decode(doc); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", filepath.Base(path), err)
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse %s: unexpected data after the document", filepath.Base(path))
+	}
+	if doc.Context == "" {
+		return nil, fmt.Errorf("%s must be a valid VEX document with @context", filepath.Base(path))
+	}
+	if err := validateSubcomponents(&doc.VEX); err != nil {
+		return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+	}
+
+	return doc, nil
+}
+
+// mergedDocumentID computes a deterministic ID from the source document IDs,
+// matching the scheme go-vex uses for merged documents
+func mergedDocumentID(ids []string) string {
+	sorted := append([]string(nil), ids...)
+	sort.Strings(sorted)
+	sum := sha256.Sum256([]byte(strings.Join(sorted, ":")))
+	return fmt.Sprintf("merged-vex-%x", sum)
+}
@@ -0,0 +1,353 @@
+package vex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// writeDirectoryDocument writes a single-statement VEX document into dir
+func writeDirectoryDocument(t *testing.T, dir, name, vuln, product string) {
+	t.Helper()
+	doc := fmt.Sprintf(`{
+		"@context": "https://openvex.dev/ns",
+		"@id": "%s",
+		"author": "service-team",
+		"version": 1,
+		"timestamp": "2023-01-01T00:00:00Z",
+		"statements": [
+			{
+				"vulnerability": {"name": "%s"},
+				"products": [{"@id": "%s"}],
+				"status": "fixed"
+			}
+		]
+	}`, name, vuln, product)
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(doc), 0o600); err != nil {
+		t.Fatalf("failed to write %s: %v", name, err)
+	}
+}
+
+func TestMergeDirectory_Success(t *testing.T) {
+	dir := t.TempDir()
+	for i := 0; i < 25; i++ {
+		writeDirectoryDocument(t, dir, fmt.Sprintf("svc-%02d.vex.json", i),
+			fmt.Sprintf("CVE-2023-%04d", i), fmt.Sprintf("pkg:npm/svc-%02d@1.0.0", i))
+	}
+	// Files not matching the pattern are ignored
+	if err := os.WriteFile(filepath.Join(dir, "notes.json"), []byte("not json"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	client := NewClient("test-author")
+	merged, err := client.MergeDirectory(context.Background(), dir, &MergeInput{Author: "nightly", ID: "nightly-merge"})
+	if err != nil {
+		t.Fatalf("MergeDirectory() error = %v", err)
+	}
+
+	if len(merged.Statements) != 25 {
+		t.Errorf("Statements length = %v, want 25", len(merged.Statements))
+	}
+	if merged.ID != "nightly-merge" {
+		t.Errorf("ID = %v, want nightly-merge", merged.ID)
+	}
+	if merged.Author != "nightly" {
+		t.Errorf("Author = %v, want nightly", merged.Author)
+	}
+	for _, stmt := range merged.Statements {
+		if stmt.Timestamp == nil {
+			t.Error("statement timestamp should cascade from its document")
+		}
+	}
+}
+
+func TestMergeDirectory_WithFilters(t *testing.T) {
+	dir := t.TempDir()
+	writeDirectoryDocument(t, dir, "a.vex.json", "CVE-2023-0001", "pkg:npm/a@1.0.0")
+	writeDirectoryDocument(t, dir, "b.vex.json", "CVE-2023-0002", "pkg:npm/b@1.0.0")
+
+	client := NewClient("test-author")
+	merged, err := client.MergeDirectory(context.Background(), dir, &MergeInput{Products: []string{"pkg:npm/b@1.0.0"}})
+	if err != nil {
+		t.Fatalf("MergeDirectory() error = %v", err)
+	}
+	if len(merged.Statements) != 1 {
+		t.Fatalf("Statements length = %v, want 1", len(merged.Statements))
+	}
+	if got := merged.Statements[0].Products[0].Component.ID; got != "pkg:npm/b@1.0.0" {
+		t.Errorf("Unexpected product in filtered result: %v", got)
+	}
+}
+
+func TestMergeDirectory_SubcomponentLimit(t *testing.T) {
+	write := func(t *testing.T, count int) string {
+		t.Helper()
+		subcomponents := make([]map[string]string, 0, count)
+		for i := 0; i < count; i++ {
+			subcomponents = append(subcomponents, map[string]string{"@id": fmt.Sprintf("pkg:npm/dep%d@1.0.0", i)})
+		}
+		doc, err := json.Marshal(map[string]interface{}{
+			"@context":  "https://openvex.dev/ns",
+			"timestamp": "2023-01-01T00:00:00Z",
+			"statements": []interface{}{map[string]interface{}{
+				"vulnerability": map[string]string{"name": "CVE-2023-1234"},
+				"products":      []interface{}{map[string]interface{}{"@id": "pkg:oci/app", "subcomponents": subcomponents}},
+				"status":        "fixed",
+			}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		dir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(dir, "app.vex.json"), doc, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return dir
+	}
+
+	client := NewClient("test-author")
+	if _, err := client.MergeDirectory(context.Background(), write(t, MaxSubcomponents), &MergeInput{}); err != nil {
+		t.Fatalf("MergeDirectory() at the limit error = %v", err)
+	}
+
+	_, err := client.MergeDirectory(context.Background(), write(t, MaxSubcomponents+1), &MergeInput{})
+	want := fmt.Sprintf("app.vex.json: statement 0 pkg:oci/app: product has %d subcomponents, maximum is %d", MaxSubcomponents+1, MaxSubcomponents)
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("MergeDirectory() error = %v, want to contain %v", err, want)
+	}
+}
+
+func TestMergeDirectory_Cancelled(t *testing.T) {
+	dir := t.TempDir()
+	writeDirectoryDocument(t, dir, "a.vex.json", "CVE-2023-0001", "pkg:npm/a@1.0.0")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	client := NewClient("test-author")
+	if _, err := client.MergeDirectory(ctx, dir, &MergeInput{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("MergeDirectory() error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestMergeDirectory_Errors(t *testing.T) {
+	capped := t.TempDir()
+	for i := 0; i < 3; i++ {
+		writeDirectoryDocument(t, capped, fmt.Sprintf("doc-%d.vex.json", i), "CVE-2023-0001", "pkg:npm/a@1.0.0")
+	}
+
+	invalid := t.TempDir()
+	if err := os.WriteFile(filepath.Join(invalid, "bad.vex.json"), []byte("{"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	untrusted := t.TempDir()
+	writeDirectoryDocument(t, untrusted, "a.vex.json", "CVE-2023-0001", "pkg:npm/a@1.0.0")
+
+	tests := []struct {
+		name            string
+		client          *Client
+		dir             string
+		input           MergeInput
+		wantErrContains string
+	}{
+		{
+			name:            "empty directory",
+			client:          NewClient("test-author"),
+			dir:             t.TempDir(),
+			wantErrContains: "no VEX documents",
+		},
+		{
+			name:            "file cap exceeded",
+			client:          NewClient("test-author", WithMaxDirectoryFiles(2)),
+			dir:             capped,
+			wantErrContains: "maximum is 2",
+		},
+		{
+			name:            "unparseable document",
+			client:          NewClient("test-author"),
+			dir:             invalid,
+			wantErrContains: "bad.vex.json",
+		},
+		{
+			name:            "context not allowed",
+			client:          NewClient("test-author", WithAllowedContexts("https://openvex.dev/ns/v0.2.0")),
+			dir:             untrusted,
+			wantErrContains: `a.vex.json @context "https://openvex.dev/ns" is not allowed`,
+		},
+		{
+			name:            "document priority",
+			client:          NewClient("test-author"),
+			dir:             untrusted,
+			input:           MergeInput{DocumentPriority: []string{"a.vex.json"}},
+			wantErrContains: "document_priority is only supported when consolidating documents",
+		},
+		{
+			name:            "missing directory",
+			client:          NewClient("test-author"),
+			dir:             "",
+			wantErrContains: "directory is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.client.MergeDirectory(context.Background(), tt.dir, &tt.input)
+			if err == nil {
+				t.Fatal("MergeDirectory() expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.wantErrContains) {
+				t.Errorf("MergeDirectory() error = %v, want to contain %v", err.Error(), tt.wantErrContains)
+			}
+		})
+	}
+}
+
+func TestMergeDirectory_Labels(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"a.vex.json": `{"@context": "https://openvex.dev/ns", "@id": "a", "timestamp": "2023-01-01T00:00:00Z", "labels": {"team": "platform", "env": "staging"}, "statements": []}`,
+		"b.vex.json": `{"@context": "https://openvex.dev/ns", "@id": "b", "timestamp": "2023-01-01T00:00:00Z", "labels": {"env": "prod", "tier": 1}, "statements": []}`,
+	}
+	for name, doc := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(doc), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	client := NewClient("test-author")
+	merged, err := client.MergeDirectory(context.Background(), dir, &MergeInput{Labels: map[string]string{"source": "nightly"}})
+	if err != nil {
+		t.Fatalf("MergeDirectory() error = %v", err)
+	}
+
+	// Later files win on conflicts and non-string labels are skipped
+	want := map[string]interface{}{"team": "platform", "env": "prod", "source": "nightly"}
+	if got := toRaw(t, merged)["labels"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("labels = %v, want %v", got, want)
+	}
+}
+
+func TestMergeDirectory_Provenance(t *testing.T) {
+	dir := t.TempDir()
+	client := NewClient("test-author")
+
+	// A file that is itself the result of an earlier merge
+	earlier, err := client.MergeDocuments(context.Background(), &MergeInput{
+		Documents: []map[string]interface{}{
+			decodeDocument(t, `{"@context": "https://openvex.dev/ns", "@id": "first", "timestamp": "2023-01-01T00:00:00Z", "statements": [{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"}]}`),
+			decodeDocument(t, `{"@context": "https://openvex.dev/ns", "@id": "second", "timestamp": "2023-01-01T00:00:00Z", "statements": [{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"}]}`),
+		},
+		ID:               "earlier-merge",
+		RecordProvenance: true,
+	})
+	if err != nil {
+		t.Fatalf("MergeDocuments() error = %v", err)
+	}
+	data, err := json.Marshal(earlier)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "a-merged.vex.json"), data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	writeDirectoryDocument(t, dir, "b-svc.vex.json", "CVE-2023-0003", "pkg:npm/b@1.0.0")
+
+	merged, err := client.MergeDirectory(context.Background(), dir, &MergeInput{RecordProvenance: true})
+	if err != nil {
+		t.Fatalf("MergeDirectory() error = %v", err)
+	}
+	events, err := documentProvenance(toRaw(t, merged))
+	if err != nil {
+		t.Fatalf("documentProvenance() error = %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("provenance = %+v, want the file's event followed by this merge", events)
+	}
+	if want := []string{"first", "second"}; !reflect.DeepEqual(events[0].Sources, want) {
+		t.Errorf("inherited event sources = %v, want %v", events[0].Sources, want)
+	}
+	if want := []string{"earlier-merge", "b-svc.vex.json"}; !reflect.DeepEqual(events[1].Sources, want) {
+		t.Errorf("merge event sources = %v, want %v", events[1].Sources, want)
+	}
+}
+
+func TestReadDocumentFile_Large(t *testing.T) {
+	const statements = 20000
+
+	var b strings.Builder
+	b.WriteString(`{"@context": "https://openvex.dev/ns", "@id": "large", "author": "service-team", "version": 1, "timestamp": "2023-01-01T00:00:00Z", "statements": [`)
+	for i := 0; i < statements; i++ {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		fmt.Fprintf(&b, `{"vulnerability": {"name": "CVE-2023-%05d"}, "products": [{"@id": "pkg:npm/component-%d@1.0.0"}], "status": "not_affected", "justification": "vulnerable_code_not_in_execute_path", "impact_statement": "The vulnerable function is never called by this component."}`, i, i)
+	}
+	b.WriteString("]}")
+	if b.Len() < 4<<20 {
+		t.Fatalf("generated document is %d bytes, want a multi-megabyte document", b.Len())
+	}
+
+	path := filepath.Join(t.TempDir(), "large.vex.json")
+	if err := os.WriteFile(path, []byte(b.String()), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := readDocumentFile(path)
+	if err != nil {
+		t.Fatalf("readDocumentFile() error = %v", err)
+	}
+	if len(doc.Statements) != statements {
+		t.Errorf("readDocumentFile() statements = %d, want %d", len(doc.Statements), statements)
+	}
+	if last := doc.Statements[statements-1].Vulnerability.Name; last != "CVE-2023-19999" {
+		t.Errorf("readDocumentFile() last vulnerability = %s, want CVE-2023-19999", last)
+	}
+}
+
+func TestReadDocumentFile_Errors(t *testing.T) {
+	dir := t.TempDir()
+
+	oversized := filepath.Join(dir, "oversized.vex.json")
+	file, err := os.Create(oversized)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A sparse file reports the size without writing it
+	if err := file.Truncate(MaxDocumentFileBytes + 1); err != nil {
+		t.Fatal(err)
+	}
+	file.Close()
+
+	trailing := filepath.Join(dir, "trailing.vex.json")
+	if err := os.WriteFile(trailing, []byte(`{"@context": "https://openvex.dev/ns", "statements": []} {}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name            string
+		path            string
+		wantErrContains string
+	}{
+		{name: "oversized", path: oversized, wantErrContains: fmt.Sprintf("maximum is %d", MaxDocumentFileBytes)},
+		{name: "trailing data", path: trailing, wantErrContains: "unexpected data after the document"},
+		{name: "missing", path: filepath.Join(dir, "missing.vex.json"), wantErrContains: "failed to read missing.vex.json"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := readDocumentFile(tt.path)
+			if err == nil {
+				t.Fatal("readDocumentFile() expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.wantErrContains) {
+				t.Errorf("readDocumentFile() error = %v, want to contain %v", err.Error(), tt.wantErrContains)
+			}
+		})
+	}
+}
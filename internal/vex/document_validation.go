@@ -0,0 +1,110 @@
+package vex
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// ValidationReport is the result of validating a complete document
+type ValidationReport struct {
+	Valid    bool     `json:"valid"`
+	Context  string   `json:"context,omitempty"`
+	Version  string   `json:"version,omitempty"`
+	Errors   []string `json:"errors"`
+	Warnings []string `json:"warnings"`
+}
+
+// WithRequireAuthor makes ValidateDocument treat a document without an
+// author as invalid instead of only warning about it
+func WithRequireAuthor(require bool) Option {
+	return func(c *Client) {
+		c.requireAuthor = require
+	}
+}
+
+// versionRequirements lists the top-level fields each supported OpenVEX
+// version requires beyond what go-vex enforces
+var versionRequirements = map[string][]string{
+	vexlib.SpecVersion: {"@id", "author", "timestamp", "version"},
+}
+
+// SupportedSpecVersions returns the OpenVEX versions ValidateDocument can pin to
+func SupportedSpecVersions() []string {
+	versions := make([]string, 0, len(versionRequirements))
+	for version := range versionRequirements {
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+// ValidateDocument checks a document's context and statements. When version
+// is set, the @context must be that version's locator and the document must
+// carry the fields that version requires. Problems with the document are
+// reported in the result; an error is returned only for an unsupported version.
+// A missing author is a warning unless the client requires one.
+func (c *Client) ValidateDocument(raw map[string]interface{}, version string) (*ValidationReport, error) {
+	version = strings.TrimPrefix(version, "v")
+	if version != "" {
+		if _, ok := versionRequirements[version]; !ok {
+			return nil, fmt.Errorf("unsupported OpenVEX version %q: supported versions are %s",
+				version, strings.Join(SupportedSpecVersions(), ", "))
+		}
+	}
+
+	report := &ValidationReport{Version: version, Errors: []string{}, Warnings: []string{}}
+	context, _ := raw["@context"].(string)
+	report.Context = context
+
+	switch {
+	case context == "":
+		report.Errors = append(report.Errors, "@context is required")
+	case !strings.HasPrefix(context, vexlib.Context):
+		report.Errors = append(report.Errors, fmt.Sprintf("@context %q is not an OpenVEX context", context))
+	case version != "" && context != versionLocator(version):
+		report.Errors = append(report.Errors, fmt.Sprintf("@context %q does not match OpenVEX version %s, expected %q",
+			context, version, versionLocator(version)))
+	}
+
+	for _, field := range versionRequirements[version] {
+		if value, ok := raw[field]; !ok || value == "" || value == nil {
+			report.Errors = append(report.Errors, fmt.Sprintf("%s is required by OpenVEX %s", field, version))
+		}
+	}
+
+	if author, _ := raw["author"].(string); strings.TrimSpace(author) == "" && !requiresField(version, "author") {
+		if c.requireAuthor {
+			report.Errors = append(report.Errors, "author is required")
+		} else {
+			report.Warnings = append(report.Warnings, "author is empty; downstream systems may require one")
+		}
+	}
+
+	doc, err := parseDocument(raw)
+	if err != nil {
+		report.Errors = append(report.Errors, err.Error())
+	} else {
+		report.Errors = append(report.Errors, ValidateStatements(doc.Statements)...)
+	}
+
+	report.Valid = len(report.Errors) == 0
+	return report, nil
+}
+
+// requiresField reports whether an OpenVEX version requires a top-level field
+func requiresField(version, field string) bool {
+	for _, required := range versionRequirements[version] {
+		if required == field {
+			return true
+		}
+	}
+	return false
+}
+
+// versionLocator returns the @context locator of an OpenVEX version
+func versionLocator(version string) string {
+	return fmt.Sprintf("%s/v%s", vexlib.Context, version)
+}
@@ -0,0 +1,172 @@
+package vex
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateDocument(t *testing.T) {
+	client := NewClient("test-author")
+
+	document := func(context string) map[string]interface{} {
+		return map[string]interface{}{
+			"@context":  context,
+			"@id":       "doc1",
+			"author":    "Security Team",
+			"timestamp": "2023-01-01T00:00:00Z",
+			"version":   float64(1),
+			"statements": []interface{}{
+				map[string]interface{}{
+					"vulnerability": map[string]interface{}{"name": "CVE-2023-1234"},
+					"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+					"status":        "fixed",
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		doc             map[string]interface{}
+		version         string
+		wantValid       bool
+		wantErrContains string
+	}{
+		{
+			name:      "unversioned context without pinned version",
+			doc:       document("https://openvex.dev/ns"),
+			wantValid: true,
+		},
+		{
+			name:      "matching context",
+			doc:       document("https://openvex.dev/ns/v0.2.0"),
+			version:   "0.2.0",
+			wantValid: true,
+		},
+		{
+			name:      "version with v prefix",
+			doc:       document("https://openvex.dev/ns/v0.2.0"),
+			version:   "v0.2.0",
+			wantValid: true,
+		},
+		{
+			name:            "mismatching context",
+			doc:             document("https://openvex.dev/ns"),
+			version:         "0.2.0",
+			wantErrContains: `does not match OpenVEX version 0.2.0, expected "https://openvex.dev/ns/v0.2.0"`,
+		},
+		{
+			name:            "foreign context",
+			doc:             document("https://example.com/ns"),
+			wantErrContains: "is not an OpenVEX context",
+		},
+		{
+			name: "missing field required by version",
+			doc: func() map[string]interface{} {
+				doc := document("https://openvex.dev/ns/v0.2.0")
+				delete(doc, "author")
+				return doc
+			}(),
+			version:         "0.2.0",
+			wantErrContains: "author is required by OpenVEX 0.2.0",
+		},
+		{
+			name: "invalid statement",
+			doc: func() map[string]interface{} {
+				doc := document("https://openvex.dev/ns")
+				doc["statements"].([]interface{})[0].(map[string]interface{})["status"] = "not_affected"
+				return doc
+			}(),
+			wantErrContains: "statement 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := client.ValidateDocument(tt.doc, tt.version)
+			if err != nil {
+				t.Fatalf("ValidateDocument() error = %v", err)
+			}
+			if report.Valid != tt.wantValid {
+				t.Errorf("ValidateDocument() valid = %v, want %v (errors %v)", report.Valid, tt.wantValid, report.Errors)
+			}
+			if tt.wantErrContains != "" && !strings.Contains(strings.Join(report.Errors, "\n"), tt.wantErrContains) {
+				t.Errorf("ValidateDocument() errors = %v, want to contain %v", report.Errors, tt.wantErrContains)
+			}
+		})
+	}
+}
+
+func TestValidateDocument_UnsupportedVersion(t *testing.T) {
+	client := NewClient("test-author")
+
+	_, err := client.ValidateDocument(map[string]interface{}{}, "9.9.9")
+	if err == nil || !strings.Contains(err.Error(), "supported versions are 0.2.0") {
+		t.Errorf("ValidateDocument() error = %v, want unsupported version error", err)
+	}
+}
+
+func TestValidateDocument_MissingAuthor(t *testing.T) {
+	doc := func() map[string]interface{} {
+		return map[string]interface{}{
+			"@context":  "https://openvex.dev/ns",
+			"@id":       "doc1",
+			"timestamp": "2023-01-01T00:00:00Z",
+			"statements": []interface{}{
+				map[string]interface{}{
+					"vulnerability": map[string]interface{}{"name": "CVE-2023-1234"},
+					"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+					"status":        "fixed",
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		opts         []Option
+		version      string
+		wantValid    bool
+		wantErrors   []string
+		wantWarnings []string
+	}{
+		{
+			name:         "advisory by default",
+			wantValid:    true,
+			wantErrors:   []string{},
+			wantWarnings: []string{"author is empty; downstream systems may require one"},
+		},
+		{
+			name:         "required by client",
+			opts:         []Option{WithRequireAuthor(true)},
+			wantErrors:   []string{"author is required"},
+			wantWarnings: []string{},
+		},
+		{
+			name:         "required by pinned version",
+			version:      "0.2.0",
+			wantErrors:   []string{"@context \"https://openvex.dev/ns\" does not match OpenVEX version 0.2.0, expected \"https://openvex.dev/ns/v0.2.0\"", "author is required by OpenVEX 0.2.0", "version is required by OpenVEX 0.2.0"},
+			wantWarnings: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient("test-author", tt.opts...)
+			report, err := client.ValidateDocument(doc(), tt.version)
+			if err != nil {
+				t.Fatalf("ValidateDocument() error = %v", err)
+			}
+			if report.Valid != tt.wantValid {
+				t.Errorf("ValidateDocument() valid = %v, want %v", report.Valid, tt.wantValid)
+			}
+			if !reflect.DeepEqual(report.Errors, tt.wantErrors) {
+				t.Errorf("ValidateDocument() errors = %q, want %q", report.Errors, tt.wantErrors)
+			}
+			if !reflect.DeepEqual(report.Warnings, tt.wantWarnings) {
+				t.Errorf("ValidateDocument() warnings = %q, want %q", report.Warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package vex
+
+import (
+	"sort"
+	"strings"
+)
+
+// DuplicateReport lists groups of statements within one document that share
+// a vulnerability, product set, status, and justification
+type DuplicateReport struct {
+	HasDuplicates bool    `json:"has_duplicates"`
+	Groups        [][]int `json:"groups"`
+}
+
+// FindDuplicateStatements reports the indices of statements in a document
+// that are identical in vulnerability, products (in any order), status, and
+// justification. Unlike merge deduplication it looks within a single
+// document. Groups are ordered by their first statement index.
+func (c *Client) FindDuplicateStatements(raw map[string]interface{}) (*DuplicateReport, error) {
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	type duplicateKey struct {
+		vulnerability string
+		products      string
+		status        string
+		justification string
+	}
+
+	groups := map[duplicateKey][]int{}
+	var order []duplicateKey
+	for i, stmt := range doc.Statements {
+		products := productIDs(stmt.Products)
+		sort.Strings(products)
+		key := duplicateKey{
+			vulnerability: string(stmt.Vulnerability.Name),
+			products:      strings.Join(products, "\x00"),
+			status:        string(stmt.Status),
+			justification: string(stmt.Justification),
+		}
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], i)
+	}
+
+	report := &DuplicateReport{Groups: [][]int{}}
+	for _, key := range order {
+		if indices := groups[key]; len(indices) > 1 {
+			report.Groups = append(report.Groups, indices)
+		}
+	}
+	report.HasDuplicates = len(report.Groups) > 0
+	return report, nil
+}
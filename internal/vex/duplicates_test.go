@@ -0,0 +1,58 @@
+package vex
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindDuplicateStatements(t *testing.T) {
+	client := NewClient("test-author")
+
+	tests := []struct {
+		name       string
+		doc        string
+		wantGroups [][]int
+	}{
+		{
+			name: "intra-document duplicates",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}, {"@id": "pkg:npm/b@1.0.0"}], "status": "fixed"},
+					{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "not_affected", "justification": "component_not_present"},
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/b@1.0.0"}, {"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"},
+					{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "not_affected", "justification": "vulnerable_code_not_present"},
+					{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "not_affected", "justification": "component_not_present"},
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}, {"@id": "pkg:npm/b@1.0.0"}], "status": "fixed"}
+				]
+			}`,
+			wantGroups: [][]int{{0, 2, 5}, {1, 4}},
+		},
+		{
+			name: "no duplicates",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"},
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "under_investigation"}
+				]
+			}`,
+			wantGroups: [][]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := client.FindDuplicateStatements(decodeDocument(t, tt.doc))
+			if err != nil {
+				t.Fatalf("FindDuplicateStatements() error = %v", err)
+			}
+			if !reflect.DeepEqual(report.Groups, tt.wantGroups) {
+				t.Errorf("FindDuplicateStatements() groups = %v, want %v", report.Groups, tt.wantGroups)
+			}
+			if report.HasDuplicates != (len(tt.wantGroups) > 0) {
+				t.Errorf("FindDuplicateStatements() has_duplicates = %v", report.HasDuplicates)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package vex
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// Difference is a single canonical field that differs between two documents
+type Difference struct {
+	Path  string      `json:"path"`
+	Left  interface{} `json:"left"`
+	Right interface{} `json:"right"`
+}
+
+// Comparison is the result of comparing two documents
+type Comparison struct {
+	Equal       bool         `json:"equal"`
+	Differences []Difference `json:"differences"`
+}
+
+// CompareDocuments reports whether two documents are semantically equal,
+// ignoring generated identifiers and timestamps, and lists the differing
+// fields when they are not
+func (c *Client) CompareDocuments(left, right map[string]interface{}) (*Comparison, error) {
+	parser := newDocumentParser()
+	leftCanonical, err := canonicalDocument(parser, left)
+	if err != nil {
+		return nil, fmt.Errorf("left document: %w", err)
+	}
+	rightCanonical, err := canonicalDocument(parser, right)
+	if err != nil {
+		return nil, fmt.Errorf("right document: %w", err)
+	}
+
+	differences := []Difference{}
+	diffValues("", leftCanonical, rightCanonical, &differences)
+	return &Comparison{
+		Equal:       len(differences) == 0,
+		Differences: differences,
+	}, nil
+}
+
+// canonicalDocument parses a document and returns it as a generic JSON value
+// with document and statement @id, timestamp and last_updated removed, and
+// statements and their products in a stable order
+func canonicalDocument(parser *documentParser, raw map[string]interface{}) (interface{}, error) {
+	doc, err := parser.parse(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	doc.ID = ""
+	doc.Timestamp = nil
+	doc.LastUpdated = nil
+	for i := range doc.Statements {
+		stmt := &doc.Statements[i]
+		stmt.ID = ""
+		stmt.Timestamp = nil
+		stmt.LastUpdated = nil
+		// The parser shares product slices between copies; sort our own
+		stmt.Products = append([]vexlib.Product(nil), stmt.Products...)
+		for j := range stmt.Products {
+			stmt.Products[j].Subcomponents = append([]vexlib.Subcomponent(nil), stmt.Products[j].Subcomponents...)
+		}
+	}
+	SortProducts(doc.Statements)
+	sort.SliceStable(doc.Statements, func(i, j int) bool {
+		return statementKey(doc.Statements[i]) < statementKey(doc.Statements[j])
+	})
+
+	canonicalBytes, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal canonical document: %w", err)
+	}
+	var canonical interface{}
+	if err := json.Unmarshal(canonicalBytes, &canonical); err != nil {
+		return nil, fmt.Errorf("failed to decode canonical document: %w", err)
+	}
+	return canonical, nil
+}
+
+// statementKey orders statements by vulnerability, then products, then
+// status. Product IDs are sorted so their listed order does not matter.
+func statementKey(stmt vexlib.Statement) string {
+	ids := productIDs(stmt.Products)
+	sort.Strings(ids)
+	return strings.Join([]string{
+		string(stmt.Vulnerability.Name),
+		strings.Join(ids, ","),
+		string(stmt.Status),
+	}, "\x00")
+}
+
+// diffValues appends the paths at which two decoded JSON values differ
+func diffValues(path string, left, right interface{}, out *[]Difference) {
+	switch l := left.(type) {
+	case map[string]interface{}:
+		r, ok := right.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := make(map[string]bool, len(l)+len(r))
+		for k := range l {
+			keys[k] = true
+		}
+		for k := range r {
+			keys[k] = true
+		}
+		sorted := make([]string, 0, len(keys))
+		for k := range keys {
+			sorted = append(sorted, k)
+		}
+		sort.Strings(sorted)
+		for _, k := range sorted {
+			diffValues(joinPath(path, k), l[k], r[k], out)
+		}
+		return
+	case []interface{}:
+		r, ok := right.([]interface{})
+		if !ok {
+			break
+		}
+		for i := 0; i < len(l) || i < len(r); i++ {
+			var lv, rv interface{}
+			if i < len(l) {
+				lv = l[i]
+			}
+			if i < len(r) {
+				rv = r[i]
+			}
+			diffValues(fmt.Sprintf("%s[%d]", path, i), lv, rv, out)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(left, right) {
+		*out = append(*out, Difference{Path: path, Left: left, Right: right})
+	}
+}
+
+// joinPath appends a field name to a JSON path
+func joinPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
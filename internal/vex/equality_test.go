@@ -0,0 +1,146 @@
+package vex
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func decodeDocument(t *testing.T, docJSON string) map[string]interface{} {
+	t.Helper()
+	var doc map[string]interface{}
+	if err := json.Unmarshal([]byte(docJSON), &doc); err != nil {
+		t.Fatalf("invalid test document: %v", err)
+	}
+	return doc
+}
+
+func TestCompareDocuments(t *testing.T) {
+	client := NewClient("test-author")
+
+	golden := `{
+		"@context": "https://openvex.dev/ns",
+		"@id": "golden",
+		"author": "Security Team",
+		"timestamp": "2023-01-01T00:00:00Z",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "fixed", "timestamp": "2023-01-01T00:00:00Z"},
+			{"vulnerability": {"name": "CVE-2023-5678"}, "products": [{"@id": "pkg:npm/axios@1.0.0"}], "status": "under_investigation"}
+		]
+	}`
+
+	tests := []struct {
+		name      string
+		candidate string
+		wantEqual bool
+		wantPaths []string
+	}{
+		{
+			name: "equal modulo ids, timestamps and order",
+			candidate: `{
+				"@context": "https://openvex.dev/ns",
+				"@id": "vex-1700000000",
+				"author": "Security Team",
+				"timestamp": "2024-06-01T12:00:00Z",
+				"last_updated": "2024-06-02T12:00:00Z",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-5678"}, "products": [{"@id": "pkg:npm/axios@1.0.0"}], "status": "under_investigation", "timestamp": "2024-06-01T12:00:00Z"},
+					{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "fixed"}
+				]
+			}`,
+			wantEqual: true,
+		},
+		{
+			name: "equal modulo statement ids and product order",
+			candidate: `{
+				"@context": "https://openvex.dev/ns",
+				"author": "Security Team",
+				"statements": [
+					{"@id": "stmt-1", "vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "fixed"},
+					{"@id": "stmt-2", "vulnerability": {"name": "CVE-2023-5678"}, "products": [{"@id": "pkg:npm/axios@1.0.0"}], "status": "under_investigation"}
+				]
+			}`,
+			wantEqual: true,
+		},
+		{
+			name: "different status and author",
+			candidate: `{
+				"@context": "https://openvex.dev/ns",
+				"author": "Other Team",
+				"timestamp": "2023-01-01T00:00:00Z",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "fixed"},
+					{"vulnerability": {"name": "CVE-2023-5678"}, "products": [{"@id": "pkg:npm/axios@1.0.0"}], "status": "affected", "action_statement": "Upgrade"}
+				]
+			}`,
+			wantEqual: false,
+			wantPaths: []string{"author", "statements[1].action_statement", "statements[1].status"},
+		},
+		{
+			name: "missing statement",
+			candidate: `{
+				"@context": "https://openvex.dev/ns",
+				"author": "Security Team",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "fixed"}
+				]
+			}`,
+			wantEqual: false,
+			wantPaths: []string{"statements[1]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			comparison, err := client.CompareDocuments(decodeDocument(t, golden), decodeDocument(t, tt.candidate))
+			if err != nil {
+				t.Fatalf("CompareDocuments() error = %v", err)
+			}
+			if comparison.Equal != tt.wantEqual {
+				t.Errorf("CompareDocuments() equal = %v, want %v (differences %+v)", comparison.Equal, tt.wantEqual, comparison.Differences)
+			}
+			if len(comparison.Differences) != len(tt.wantPaths) {
+				t.Fatalf("CompareDocuments() differences = %+v, want paths %v", comparison.Differences, tt.wantPaths)
+			}
+			for i, path := range tt.wantPaths {
+				if comparison.Differences[i].Path != path {
+					t.Errorf("difference %d path = %v, want %v", i, comparison.Differences[i].Path, path)
+				}
+			}
+		})
+	}
+}
+
+func TestCompareDocuments_ProductOrder(t *testing.T) {
+	client := NewClient("test-author")
+	left := `{
+		"@context": "https://openvex.dev/ns",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/c@1.0.0"}, {"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"},
+			{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/b@1.0.0"}], "status": "fixed"}
+		]
+	}`
+	right := `{
+		"@context": "https://openvex.dev/ns",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/b@1.0.0"}], "status": "fixed"},
+			{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/a@1.0.0"}, {"@id": "pkg:npm/c@1.0.0"}], "status": "fixed"}
+		]
+	}`
+
+	comparison, err := client.CompareDocuments(decodeDocument(t, left), decodeDocument(t, right))
+	if err != nil {
+		t.Fatalf("CompareDocuments() error = %v", err)
+	}
+	if !comparison.Equal {
+		t.Errorf("CompareDocuments() differences = %+v, want equal", comparison.Differences)
+	}
+}
+
+func TestCompareDocuments_InvalidDocument(t *testing.T) {
+	client := NewClient("test-author")
+
+	_, err := client.CompareDocuments(map[string]interface{}{"statements": 1}, map[string]interface{}{})
+	if err == nil {
+		t.Error("CompareDocuments() expected error for invalid document")
+	}
+}
@@ -0,0 +1,282 @@
+package vex
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// LabelsExtension is the extension field holding document labels
+const LabelsExtension = "labels"
+
+// NotesExtension is the statement extension field holding freeform notes.
+// go-vex only models a single status_notes string.
+const NotesExtension = "notes"
+
+// GeneratorExtension is the extension field recording the tool that
+// generated a document, since JSON has no comments
+const GeneratorExtension = "_generator"
+
+// Generator is the value of the generator extension field
+type Generator struct {
+	Name        string    `json:"name"`
+	Version     string    `json:"version,omitempty"`
+	GeneratedAt time.Time `json:"generated_at"`
+}
+
+// knownDocumentFields are the top-level fields modeled by go-vex
+var knownDocumentFields = map[string]bool{
+	"@context":     true,
+	"@id":          true,
+	"author":       true,
+	"role":         true,
+	"timestamp":    true,
+	"last_updated": true,
+	"version":      true,
+	"tooling":      true,
+	"supplier":     true,
+	"statements":   true,
+}
+
+// knownStatementFields are the statement fields modeled by go-vex
+var knownStatementFields = map[string]bool{
+	"@id":                        true,
+	"vulnerability":              true,
+	"timestamp":                  true,
+	"last_updated":               true,
+	"products":                   true,
+	"status":                     true,
+	"status_notes":               true,
+	"justification":              true,
+	"impact_statement":           true,
+	"action_statement":           true,
+	"action_statement_timestamp": true,
+}
+
+// Document is a VEX document together with extension fields that go-vex does
+// not model. Extensions are emitted as additional top-level JSON fields and
+// statement extensions as additional fields of the statement at their index.
+type Document struct {
+	*vexlib.VEX
+	Extensions          map[string]interface{}
+	StatementExtensions map[int]map[string]interface{}
+}
+
+// NewDocument wraps a go-vex document with no extensions
+func NewDocument(doc *vexlib.VEX) *Document {
+	return &Document{
+		VEX:                 doc,
+		Extensions:          map[string]interface{}{},
+		StatementExtensions: map[int]map[string]interface{}{},
+	}
+}
+
+// documentWithExtensions wraps doc with the document and statement extension
+// fields of raw, the document it was parsed from
+func documentWithExtensions(doc *vexlib.VEX, raw map[string]interface{}) *Document {
+	result := NewDocument(doc)
+	for name, value := range ExtractExtensions(raw) {
+		result.SetExtension(name, value)
+	}
+	for index, extensions := range extractStatementExtensions(raw) {
+		for name, value := range extensions {
+			result.SetStatementExtension(index, name, value)
+		}
+	}
+	return result
+}
+
+// documentWithStatementSources is documentWithExtensions for a document whose
+// statements were split, reordered, or dropped: statement i of doc takes the
+// extensions of statement sources[i] of raw
+func documentWithStatementSources(doc *vexlib.VEX, raw map[string]interface{}, sources []int) *Document {
+	result := NewDocument(doc)
+	for name, value := range ExtractExtensions(raw) {
+		result.SetExtension(name, value)
+	}
+	statementExtensions := extractStatementExtensions(raw)
+	for index, source := range sources {
+		for name, value := range statementExtensions[source] {
+			result.SetStatementExtension(index, name, value)
+		}
+	}
+	return result
+}
+
+// SetExtension sets an extension field, removing it when value is empty
+func (d *Document) SetExtension(name string, value interface{}) {
+	if d.Extensions == nil {
+		d.Extensions = map[string]interface{}{}
+	}
+	if labels, ok := value.(map[string]string); ok && len(labels) == 0 {
+		delete(d.Extensions, name)
+		return
+	}
+	d.Extensions[name] = value
+}
+
+// SetStatementExtension sets an extension field on the statement at index,
+// removing it when value is nil
+func (d *Document) SetStatementExtension(index int, name string, value interface{}) {
+	if d.StatementExtensions == nil {
+		d.StatementExtensions = map[int]map[string]interface{}{}
+	}
+	if value == nil {
+		delete(d.StatementExtensions[index], name)
+		if len(d.StatementExtensions[index]) == 0 {
+			delete(d.StatementExtensions, index)
+		}
+		return
+	}
+	if d.StatementExtensions[index] == nil {
+		d.StatementExtensions[index] = map[string]interface{}{}
+	}
+	d.StatementExtensions[index][name] = value
+}
+
+// MarshalJSON emits the go-vex document with the extension fields added.
+// Extensions never override standard OpenVEX fields.
+func (d Document) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(d.VEX)
+	if err != nil {
+		return nil, err
+	}
+	if len(d.Extensions) == 0 && len(d.StatementExtensions) == 0 {
+		return data, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for name, value := range d.Extensions {
+		if knownDocumentFields[name] {
+			continue
+		}
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal extension %s: %w", name, err)
+		}
+		fields[name] = raw
+	}
+	if len(d.StatementExtensions) > 0 {
+		statements, err := d.marshalStatementExtensions(fields["statements"])
+		if err != nil {
+			return nil, err
+		}
+		fields["statements"] = statements
+	}
+	return json.Marshal(fields)
+}
+
+// marshalStatementExtensions adds the statement extensions to the marshaled
+// statements array
+func (d Document) marshalStatementExtensions(data json.RawMessage) (json.RawMessage, error) {
+	var statements []map[string]json.RawMessage
+	if err := json.Unmarshal(data, &statements); err != nil {
+		return nil, err
+	}
+	for index, extensions := range d.StatementExtensions {
+		if index < 0 || index >= len(statements) {
+			continue
+		}
+		for name, value := range extensions {
+			if knownStatementFields[name] {
+				continue
+			}
+			raw, err := json.Marshal(value)
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal statement %d extension %s: %w", index, name, err)
+			}
+			statements[index][name] = raw
+		}
+	}
+	return json.Marshal(statements)
+}
+
+// Statement is a single go-vex statement together with its extension fields,
+// emitted as additional JSON fields of the statement
+type Statement struct {
+	*vexlib.Statement
+	Extensions map[string]interface{}
+}
+
+// MarshalJSON emits the go-vex statement with the extension fields added.
+// Extensions never override standard OpenVEX fields.
+func (s Statement) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(s.Statement)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.Extensions) == 0 {
+		return data, nil
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for name, value := range s.Extensions {
+		if knownStatementFields[name] {
+			continue
+		}
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal statement extension %s: %w", name, err)
+		}
+		fields[name] = raw
+	}
+	return json.Marshal(fields)
+}
+
+// ExtractExtensions returns the top-level fields of a raw document that go-vex
+// does not model, so they can be carried through parsing
+func ExtractExtensions(raw map[string]interface{}) map[string]interface{} {
+	extensions := map[string]interface{}{}
+	for name, value := range raw {
+		if !knownDocumentFields[name] {
+			extensions[name] = value
+		}
+	}
+	return extensions
+}
+
+// extractStatementExtensions returns the fields of each raw statement that
+// go-vex does not model, keyed by statement index
+func extractStatementExtensions(raw map[string]interface{}) map[int]map[string]interface{} {
+	extensions := map[int]map[string]interface{}{}
+	statements, _ := raw["statements"].([]interface{})
+	for index, value := range statements {
+		stmt, _ := value.(map[string]interface{})
+		for name, field := range stmt {
+			if knownStatementFields[name] {
+				continue
+			}
+			if extensions[index] == nil {
+				extensions[index] = map[string]interface{}{}
+			}
+			extensions[index][name] = field
+		}
+	}
+	return extensions
+}
+
+// documentLabels returns the string labels stored in a raw document
+func documentLabels(raw map[string]interface{}) map[string]string {
+	return stringLabels(raw[LabelsExtension])
+}
+
+// stringLabels returns the string values of a decoded labels extension,
+// skipping any that are not strings
+func stringLabels(value interface{}) map[string]string {
+	labels := map[string]string{}
+	stored, _ := value.(map[string]interface{})
+	for key, value := range stored {
+		if str, ok := value.(string); ok {
+			labels[key] = str
+		}
+	}
+	return labels
+}
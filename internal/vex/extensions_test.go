@@ -0,0 +1,199 @@
+package vex
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDocumentLabels_RoundTrip(t *testing.T) {
+	client := NewClient("test-author")
+
+	created, err := client.CreateDocument(&CreateInput{
+		Product:       "pkg:npm/lodash@4.17.21",
+		Vulnerability: "CVE-2023-1234",
+		Status:        "fixed",
+		Labels:        map[string]string{"team": "payments", "environment": "prod"},
+	})
+	if err != nil {
+		t.Fatalf("CreateDocument() error = %v", err)
+	}
+
+	data, err := json.Marshal(created)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if raw["@context"] == nil || raw["statements"] == nil {
+		t.Error("Standard fields should still be serialized")
+	}
+	if got := documentLabels(raw); got["team"] != "payments" || got["environment"] != "prod" {
+		t.Fatalf("labels after serialization = %v", got)
+	}
+	if ext := ExtractExtensions(raw); len(ext) != 1 || ext[LabelsExtension] == nil {
+		t.Errorf("ExtractExtensions() = %v, want only labels", ext)
+	}
+
+	// Labels survive a merge and combine with labels supplied to the merge
+	merged, err := client.MergeDocuments(context.Background(), &MergeInput{
+		Documents: []map[string]interface{}{raw, raw},
+		Labels:    map[string]string{"environment": "staging"},
+	})
+	if err != nil {
+		t.Fatalf("MergeDocuments() error = %v", err)
+	}
+
+	data, err = json.Marshal(merged)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	if !strings.Contains(string(data), `"labels":{"environment":"staging","team":"payments"}`) {
+		t.Errorf("merged document labels not preserved: %s", data)
+	}
+}
+
+func TestDocument_MarshalJSON_NoExtensions(t *testing.T) {
+	client := NewClient("test-author")
+
+	created, err := client.CreateDocument(&CreateInput{
+		Product:       "pkg:npm/lodash@4.17.21",
+		Vulnerability: "CVE-2023-1234",
+		Status:        "fixed",
+	})
+	if err != nil {
+		t.Fatalf("CreateDocument() error = %v", err)
+	}
+
+	withWrapper, _ := json.Marshal(created)
+	plain, _ := json.Marshal(created.VEX)
+	if string(withWrapper) != string(plain) {
+		t.Error("Documents without extensions should serialize exactly as go-vex does")
+	}
+}
+
+func TestValidateLabels(t *testing.T) {
+	tests := []struct {
+		name    string
+		labels  map[string]string
+		wantErr bool
+	}{
+		{name: "nil labels", labels: nil, wantErr: false},
+		{name: "valid labels", labels: map[string]string{"team": "payments", "app.kubernetes.io/name": "api"}, wantErr: false},
+		{name: "invalid key", labels: map[string]string{"-team": "payments"}, wantErr: true},
+		{name: "dangerous value", labels: map[string]string{"team": "$(whoami)"}, wantErr: true},
+		{name: "key too long", labels: map[string]string{strings.Repeat("k", MaxLabelKeyLength+1): "v"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLabels(tt.labels)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateLabels() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStatementNotes_RoundTrip(t *testing.T) {
+	client := NewClient("test-author")
+	notes := []string{"Reviewed by the platform team", "Upgrade scheduled for the next release"}
+
+	created, err := client.CreateDocument(&CreateInput{
+		Product:       "pkg:npm/lodash@4.17.21",
+		Vulnerability: "CVE-2023-1234",
+		Status:        "fixed",
+		Notes:         notes,
+	})
+	if err != nil {
+		t.Fatalf("CreateDocument() error = %v", err)
+	}
+
+	data, err := json.Marshal(created)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var raw struct {
+		Statements []struct {
+			Status string   `json:"status"`
+			Notes  []string `json:"notes"`
+		} `json:"statements"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if len(raw.Statements) != 1 || raw.Statements[0].Status != "fixed" {
+		t.Fatalf("statements after serialization = %+v", raw.Statements)
+	}
+	if got := strings.Join(raw.Statements[0].Notes, "|"); got != strings.Join(notes, "|") {
+		t.Errorf("notes after serialization = %v, want %v", raw.Statements[0].Notes, notes)
+	}
+}
+
+func TestValidateNotes(t *testing.T) {
+	tests := []struct {
+		name    string
+		notes   []string
+		wantErr bool
+	}{
+		{name: "nil notes", notes: nil, wantErr: false},
+		{name: "valid notes", notes: []string{"first note", "second note"}, wantErr: false},
+		{name: "blank note", notes: []string{"first note", "  "}, wantErr: true},
+		{name: "dangerous note", notes: []string{"$(whoami)"}, wantErr: true},
+		{name: "note too long", notes: []string{strings.Repeat("n", MaxStringLength+1)}, wantErr: true},
+		{name: "too many notes", notes: make([]string, MaxNotes+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateNotes(tt.notes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateNotes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGeneratorExtension_RoundTrip(t *testing.T) {
+	client := NewClient("test-author")
+
+	created, err := client.CreateDocument(&CreateInput{
+		Product:       "pkg:npm/lodash@4.17.21",
+		Vulnerability: "CVE-2023-1234",
+		Status:        "fixed",
+	})
+	if err != nil {
+		t.Fatalf("CreateDocument() error = %v", err)
+	}
+	generatedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	created.SetExtension(GeneratorExtension, Generator{Name: "vexdoc-mcp-server", Version: "1.2.3", GeneratedAt: generatedAt})
+
+	data, err := json.Marshal(created)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want := `"_generator":{"name":"vexdoc-mcp-server","version":"1.2.3","generated_at":"2024-05-01T12:00:00Z"}`
+	if !strings.Contains(string(data), want) {
+		t.Fatalf("serialized document = %s, want to contain %s", data, want)
+	}
+
+	// The block is carried through transforms like any other extension
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	bumped, err := client.BumpVersion(raw)
+	if err != nil {
+		t.Fatalf("BumpVersion() error = %v", err)
+	}
+	generator, _ := bumped.Extensions[GeneratorExtension].(map[string]interface{})
+	if generator["name"] != "vexdoc-mcp-server" || generator["version"] != "1.2.3" || generator["generated_at"] != "2024-05-01T12:00:00Z" {
+		t.Errorf("bumped document generator = %v", bumped.Extensions[GeneratorExtension])
+	}
+}
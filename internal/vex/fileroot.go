@@ -0,0 +1,60 @@
+package vex
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// FileRootEnv names the environment variable holding the directory that
+// file-reading and file-writing tools are confined to
+const FileRootEnv = "VEXDOC_FILE_ROOT"
+
+// WithFileRoot confines every path the client reads or writes to root.
+// Relative paths are resolved against root. Without a file root, paths are
+// used as given.
+func WithFileRoot(root string) Option {
+	return func(c *Client) {
+		if root != "" {
+			c.fileRoot = filepath.Clean(root)
+		}
+	}
+}
+
+// ResolvePath resolves path against the client's file root and checks that
+// it stays within it, following symlinks so a link cannot escape the root.
+// name identifies the path in validation errors.
+func (c *Client) ResolvePath(name, path string) (string, error) {
+	if c.fileRoot == "" {
+		return path, nil
+	}
+	if err := ValidateRequired(name, path); err != nil {
+		return "", fmt.Errorf("validation error: %w", err)
+	}
+
+	root, err := filepath.Abs(c.fileRoot)
+	if err != nil {
+		return "", fmt.Errorf("invalid file root: %w", err)
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(root, path)
+	}
+
+	resolved := resolveSymlinks(filepath.Clean(path))
+	if ValidateWithinDirectories(name, resolved, []string{resolveSymlinks(root)}) != nil {
+		return "", fmt.Errorf("validation error: %w", &ValidationError{Field: name, Reason: "must be within the file root"})
+	}
+	return resolved, nil
+}
+
+// resolveSymlinks evaluates the symlinks of the longest existing prefix of
+// path, keeping the components that do not exist yet
+func resolveSymlinks(path string) string {
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		return resolved
+	}
+	parent := filepath.Dir(path)
+	if parent == path {
+		return path
+	}
+	return filepath.Join(resolveSymlinks(parent), filepath.Base(path))
+}
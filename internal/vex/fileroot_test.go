@@ -0,0 +1,108 @@
+package vex
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestResolvePath(t *testing.T) {
+	base := resolveSymlinks(t.TempDir())
+	root := filepath.Join(base, "root")
+	outside := filepath.Join(base, "outside")
+	for _, dir := range []string{filepath.Join(root, "docs"), outside} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Symlink(outside, filepath.Join(root, "escape")); err != nil {
+		t.Fatal(err)
+	}
+
+	client := NewClient("test-author", WithFileRoot(root))
+
+	tests := []struct {
+		name            string
+		path            string
+		want            string
+		wantErrContains string
+	}{
+		{name: "relative in root", path: "docs", want: filepath.Join(root, "docs")},
+		{name: "absolute in root", path: filepath.Join(root, "docs"), want: filepath.Join(root, "docs")},
+		{name: "root itself", path: ".", want: root},
+		{name: "not yet created", path: "docs/out", want: filepath.Join(root, "docs", "out")},
+		{name: "absolute out of root", path: outside, wantErrContains: "directory must be within the file root"},
+		{name: "traversal", path: "docs/../../outside", wantErrContains: "directory must be within the file root"},
+		{name: "symlink escape", path: "escape", wantErrContains: "directory must be within the file root"},
+		{name: "symlink escape below", path: "escape/new", wantErrContains: "directory must be within the file root"},
+		{name: "empty", path: "", wantErrContains: "directory is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.ResolvePath("directory", tt.path)
+			if tt.wantErrContains != "" {
+				if err == nil {
+					t.Fatalf("ResolvePath() = %v, expected error", got)
+				}
+				if !strings.Contains(err.Error(), tt.wantErrContains) {
+					t.Errorf("ResolvePath() error = %v, want to contain %v", err, tt.wantErrContains)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolvePath() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ResolvePath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Without a file root, paths are used as given
+	if got, err := NewClient("test-author").ResolvePath("directory", outside); err != nil || got != outside {
+		t.Errorf("ResolvePath() without file root = %v, %v, want %v", got, err, outside)
+	}
+}
+
+func TestFileRoot_DirectoryOperations(t *testing.T) {
+	base := resolveSymlinks(t.TempDir())
+	root := filepath.Join(base, "root")
+	outside := filepath.Join(base, "outside")
+	for _, dir := range []string{root, outside} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeDirectoryDocument(t, root, "a.vex.json", "CVE-2023-0001", "pkg:npm/a@1.0.0")
+	writeDirectoryDocument(t, outside, "secret.vex.json", "CVE-2023-0002", "pkg:npm/b@1.0.0")
+
+	client := NewClient("test-author", WithFileRoot(root))
+
+	if _, err := client.MergeDirectory(context.Background(), ".", &MergeInput{}); err != nil {
+		t.Errorf("MergeDirectory() in root error = %v", err)
+	}
+	if _, err := client.MergeDirectory(context.Background(), outside, &MergeInput{}); err == nil || !strings.Contains(err.Error(), "within the file root") {
+		t.Errorf("MergeDirectory() out of root error = %v, want file root error", err)
+	}
+	if _, err := client.MigrateDirectory(".", "", "../outside"); err == nil || !strings.Contains(err.Error(), "must not leave") {
+		t.Errorf("MigrateDirectory() traversal error = %v, want output_dir error", err)
+	}
+
+	// A document symlinked in from outside the root is not followed
+	if err := os.Symlink(filepath.Join(outside, "secret.vex.json"), filepath.Join(root, "b.vex.json")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.MergeDirectory(context.Background(), ".", &MergeInput{}); err == nil || !strings.Contains(err.Error(), "b.vex.json must be within the file root") {
+		t.Errorf("MergeDirectory() symlinked file error = %v, want file root error", err)
+	}
+	report, err := client.MigrateDirectory(".", "", "")
+	if err != nil {
+		t.Fatalf("MigrateDirectory() error = %v", err)
+	}
+	if report.Failed != 1 || !strings.Contains(report.Files[1].Error, "within the file root") {
+		t.Errorf("MigrateDirectory() report = %+v, want the symlinked file to fail", report)
+	}
+}
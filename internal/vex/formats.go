@@ -0,0 +1,289 @@
+package vex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/openvex/go-vex/pkg/csaf"
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// Supported input document formats
+const (
+	FormatOpenVEX   = "openvex"
+	FormatCSAF      = "csaf"
+	FormatCycloneDX = "cyclonedx"
+)
+
+// cycloneDXStates maps CycloneDX VEX analysis states to OpenVEX statuses
+var cycloneDXStates = map[string]vexlib.Status{
+	"resolved":               vexlib.StatusFixed,
+	"resolved_with_pedigree": vexlib.StatusFixed,
+	"exploitable":            vexlib.StatusAffected,
+	"in_triage":              vexlib.StatusUnderInvestigation,
+	"false_positive":         vexlib.StatusNotAffected,
+	"not_affected":           vexlib.StatusNotAffected,
+}
+
+// cycloneDXJustifications maps the CycloneDX VEX justifications that have
+// an OpenVEX equivalent. Others are carried as the impact statement.
+var cycloneDXJustifications = map[string]vexlib.Justification{
+	"code_not_present":                vexlib.VulnerableCodeNotPresent,
+	"code_not_reachable":              vexlib.VulnerableCodeNotInExecutePath,
+	"protected_by_mitigating_control": vexlib.InlineMitigationsAlreadyExist,
+	"protected_at_runtime":            vexlib.InlineMitigationsAlreadyExist,
+	"protected_at_perimeter":          vexlib.InlineMitigationsAlreadyExist,
+	"protected_by_compiler":           vexlib.InlineMitigationsAlreadyExist,
+}
+
+// Conversion records a document converted to OpenVEX before merging.
+// Document numbers start at 1, as in merge errors.
+type Conversion struct {
+	Document int    `json:"document"`
+	Format   string `json:"format"`
+}
+
+// DetectFormat identifies a document's format by its characteristic
+// fields: document.csaf_version for CSAF and bomFormat for CycloneDX.
+// Anything else is assumed to be OpenVEX.
+func DetectFormat(raw map[string]interface{}) string {
+	if document, ok := raw["document"].(map[string]interface{}); ok {
+		if _, ok := document["csaf_version"]; ok {
+			return FormatCSAF
+		}
+	}
+	if raw["bomFormat"] == "CycloneDX" {
+		return FormatCycloneDX
+	}
+	return FormatOpenVEX
+}
+
+// MergeMixedFormats merges documents in any supported format, converting
+// CSAF and CycloneDX VEX documents to OpenVEX first. It returns the merged
+// document and the conversions made.
+func (c *Client) MergeMixedFormats(ctx context.Context, input *MergeInput) (*Document, []Conversion, error) {
+	converted := *input
+	converted.Documents = make([]map[string]interface{}, 0, len(input.Documents))
+	conversions := []Conversion{}
+	for i, raw := range input.Documents {
+		format := DetectFormat(raw)
+		if format == FormatOpenVEX {
+			converted.Documents = append(converted.Documents, raw)
+			continue
+		}
+
+		doc, err := convertDocument(raw, format)
+		if err != nil {
+			return nil, nil, fmt.Errorf("document %d: converting %s: %w", i+1, format, err)
+		}
+		converted.Documents = append(converted.Documents, doc)
+		conversions = append(conversions, Conversion{Document: i + 1, Format: format})
+	}
+
+	merged, err := c.MergeDocuments(ctx, &converted)
+	if err != nil {
+		return nil, nil, err
+	}
+	return merged, conversions, nil
+}
+
+// convertDocument converts a CSAF or CycloneDX document to a raw OpenVEX
+// document
+func convertDocument(raw map[string]interface{}, format string) (map[string]interface{}, error) {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal document: %w", err)
+	}
+
+	var doc *vexlib.VEX
+	switch format {
+	case FormatCSAF:
+		doc, err = convertCSAF(data)
+	case FormatCycloneDX:
+		doc, err = convertCycloneDX(data)
+	default:
+		err = fmt.Errorf("unsupported format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal converted document: %w", err)
+	}
+	var converted map[string]interface{}
+	if err := json.Unmarshal(data, &converted); err != nil {
+		return nil, fmt.Errorf("failed to decode converted document: %w", err)
+	}
+	return converted, nil
+}
+
+// convertCSAF converts a CSAF VEX document. Products are identified by
+// their purl helper where they have one, and justifications are taken from
+// product flags. Product status categories without an OpenVEX equivalent
+// (such as recommended) are skipped.
+func convertCSAF(data []byte) (*vexlib.VEX, error) {
+	var csafDoc csaf.CSAF
+	if err := json.Unmarshal(data, &csafDoc); err != nil {
+		return nil, fmt.Errorf("failed to decode CSAF document: %w", err)
+	}
+
+	purls := map[string]string{}
+	for _, p := range csafDoc.ListProducts() {
+		if purl := p.IdentificationHelper["purl"]; purl != "" {
+			purls[p.ID] = purl
+		}
+	}
+	productID := func(id string) string {
+		if purl, ok := purls[id]; ok {
+			return purl
+		}
+		return id
+	}
+
+	doc := newConvertedDocument(csafDoc.Document.Tracking.ID, csafDoc.Document.Publisher.Name, csafDoc.Document.Tracking.CurrentReleaseDate)
+	for _, vuln := range csafDoc.Vulnerabilities {
+		name := vuln.CVE
+		if name == "" && len(vuln.IDs) > 0 {
+			name = vuln.IDs[0].Text
+		}
+
+		justifications := map[string]vexlib.Justification{}
+		for _, flag := range vuln.Flags {
+			for _, id := range flag.ProductIDs {
+				justifications[id] = vexlib.Justification(flag.Label)
+			}
+		}
+		impacts := map[string]string{}
+		for _, threat := range vuln.Threats {
+			if threat.Category != "impact" {
+				continue
+			}
+			for _, id := range threat.ProductIDs {
+				impacts[id] = threat.Details
+			}
+		}
+
+		categories := make([]string, 0, len(vuln.ProductStatus))
+		for category := range vuln.ProductStatus {
+			categories = append(categories, category)
+		}
+		sort.Strings(categories)
+		for _, category := range categories {
+			status := vexlib.StatusFromCSAF(category)
+			if status == "" {
+				continue
+			}
+			for _, id := range vuln.ProductStatus[category] {
+				stmt := vexlib.Statement{
+					Vulnerability: vexlib.Vulnerability{Name: vexlib.VulnerabilityID(name)},
+					Products:      []vexlib.Product{{Component: vexlib.Component{ID: productID(id)}}},
+					Status:        status,
+				}
+				if status == vexlib.StatusNotAffected {
+					stmt.Justification = justifications[id]
+					stmt.ImpactStatement = impacts[id]
+				}
+				doc.Statements = append(doc.Statements, stmt)
+			}
+		}
+	}
+	return doc, nil
+}
+
+// cycloneDXDocument is the subset of a CycloneDX BOM read when converting
+// its VEX data
+type cycloneDXDocument struct {
+	SerialNumber string `json:"serialNumber"`
+	Metadata     struct {
+		Timestamp time.Time `json:"timestamp"`
+	} `json:"metadata"`
+	Components []struct {
+		BOMRef string `json:"bom-ref"`
+		PURL   string `json:"purl"`
+	} `json:"components"`
+	Vulnerabilities []struct {
+		ID       string `json:"id"`
+		Analysis struct {
+			State         string   `json:"state"`
+			Justification string   `json:"justification"`
+			Response      []string `json:"response"`
+			Detail        string   `json:"detail"`
+		} `json:"analysis"`
+		Affects []struct {
+			Ref string `json:"ref"`
+		} `json:"affects"`
+	} `json:"vulnerabilities"`
+}
+
+// convertCycloneDX converts the vulnerabilities of a CycloneDX VEX BOM.
+// Affected refs are resolved to component purls where possible, and
+// vulnerabilities without an analysis state are skipped.
+func convertCycloneDX(data []byte) (*vexlib.VEX, error) {
+	var bom cycloneDXDocument
+	if err := json.Unmarshal(data, &bom); err != nil {
+		return nil, fmt.Errorf("failed to decode CycloneDX document: %w", err)
+	}
+
+	purls := map[string]string{}
+	for _, component := range bom.Components {
+		if component.BOMRef != "" && component.PURL != "" {
+			purls[component.BOMRef] = component.PURL
+		}
+	}
+
+	doc := newConvertedDocument(bom.SerialNumber, "", bom.Metadata.Timestamp)
+	for _, vuln := range bom.Vulnerabilities {
+		if vuln.Analysis.State == "" {
+			continue
+		}
+		status, ok := cycloneDXStates[vuln.Analysis.State]
+		if !ok {
+			return nil, fmt.Errorf("vulnerability %s: unknown analysis state %q", vuln.ID, vuln.Analysis.State)
+		}
+
+		stmt := vexlib.Statement{
+			Vulnerability: vexlib.Vulnerability{Name: vexlib.VulnerabilityID(vuln.ID)},
+			Status:        status,
+		}
+		for _, affects := range vuln.Affects {
+			id := affects.Ref
+			if purl, ok := purls[id]; ok {
+				id = purl
+			}
+			stmt.Products = append(stmt.Products, vexlib.Product{Component: vexlib.Component{ID: id}})
+		}
+		switch status {
+		case vexlib.StatusNotAffected:
+			stmt.Justification = cycloneDXJustifications[vuln.Analysis.Justification]
+			stmt.ImpactStatement = vuln.Analysis.Detail
+			if stmt.Justification == "" && stmt.ImpactStatement == "" {
+				stmt.ImpactStatement = vuln.Analysis.Justification
+			}
+		case vexlib.StatusAffected:
+			stmt.ActionStatement = vuln.Analysis.Detail
+		default:
+			stmt.StatusNotes = vuln.Analysis.Detail
+		}
+		doc.Statements = append(doc.Statements, stmt)
+	}
+	return doc, nil
+}
+
+// newConvertedDocument returns an empty OpenVEX document carrying over a
+// source document's identity, author, and timestamp where known
+func newConvertedDocument(id, author string, timestamp time.Time) *vexlib.VEX {
+	doc := vexlib.New()
+	doc.Context = vexlib.Context
+	doc.ID = id
+	doc.Author = author
+	doc.Version = 1
+	if !timestamp.IsZero() {
+		doc.Timestamp = &timestamp
+	}
+	return &doc
+}
@@ -0,0 +1,141 @@
+package vex
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+const csafTestDocument = `{
+	"document": {
+		"csaf_version": "2.0",
+		"category": "csaf_vex",
+		"title": "Example advisory",
+		"publisher": {"name": "Example Vendor", "category": "vendor", "namespace": "https://example.com"},
+		"tracking": {"id": "EXAMPLE-2023-0001", "current_release_date": "2023-02-01T00:00:00Z", "initial_release_date": "2023-02-01T00:00:00Z"}
+	},
+	"product_tree": {
+		"branches": [
+			{
+				"category": "vendor",
+				"name": "Example",
+				"branches": [
+					{"category": "product_version", "name": "1.0.0", "product": {"name": "app 1.0.0", "product_id": "APP-1", "product_identification_helper": {"purl": "pkg:npm/app@1.0.0"}}},
+					{"category": "product_version", "name": "2.0.0", "product": {"name": "app 2.0.0", "product_id": "APP-2", "product_identification_helper": {"purl": "pkg:npm/app@2.0.0"}}}
+				]
+			}
+		]
+	},
+	"vulnerabilities": [
+		{
+			"cve": "CVE-2023-2000",
+			"product_status": {"known_not_affected": ["APP-1"], "fixed": ["APP-2"], "recommended": ["APP-2"]},
+			"flags": [{"label": "vulnerable_code_not_present", "product_ids": ["APP-1"]}]
+		}
+	]
+}`
+
+const cycloneDXTestDocument = `{
+	"bomFormat": "CycloneDX",
+	"specVersion": "1.5",
+	"serialNumber": "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79",
+	"metadata": {"timestamp": "2023-03-01T00:00:00Z"},
+	"components": [{"bom-ref": "lib-1", "type": "library", "name": "lib", "purl": "pkg:npm/lib@1.0.0"}],
+	"vulnerabilities": [
+		{"id": "CVE-2023-3000", "analysis": {"state": "not_affected", "justification": "code_not_reachable"}, "affects": [{"ref": "lib-1"}]},
+		{"id": "CVE-2023-3001", "analysis": {"state": "exploitable", "detail": "Upgrade to 1.0.1"}, "affects": [{"ref": "lib-1"}]},
+		{"id": "CVE-2023-3002", "affects": [{"ref": "lib-1"}]}
+	]
+}`
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want string
+	}{
+		{name: "openvex", doc: `{"@context": "https://openvex.dev/ns", "statements": []}`, want: FormatOpenVEX},
+		{name: "csaf", doc: csafTestDocument, want: FormatCSAF},
+		{name: "cyclonedx", doc: cycloneDXTestDocument, want: FormatCycloneDX},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectFormat(decodeDocument(t, tt.doc)); got != tt.want {
+				t.Errorf("DetectFormat() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeMixedFormats(t *testing.T) {
+	client := NewClient("test-author")
+	openVEX := `{
+		"@context": "https://openvex.dev/ns",
+		"@id": "https://example.com/vex/1",
+		"author": "test-author",
+		"timestamp": "2023-01-01T00:00:00Z",
+		"version": 1,
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-1000"}, "products": [{"@id": "pkg:npm/app@1.0.0"}], "status": "affected", "action_statement": "Upgrade to 1.0.1"}
+		]
+	}`
+
+	merged, conversions, err := client.MergeMixedFormats(context.Background(), &MergeInput{
+		Documents: []map[string]interface{}{
+			decodeDocument(t, openVEX),
+			decodeDocument(t, csafTestDocument),
+			decodeDocument(t, cycloneDXTestDocument),
+		},
+		ValidateResult: true,
+	})
+	if err != nil {
+		t.Fatalf("MergeMixedFormats() error = %v", err)
+	}
+
+	wantConversions := []Conversion{{Document: 2, Format: FormatCSAF}, {Document: 3, Format: FormatCycloneDX}}
+	if !reflect.DeepEqual(conversions, wantConversions) {
+		t.Errorf("MergeMixedFormats() conversions = %v, want %v", conversions, wantConversions)
+	}
+
+	type cell struct{ vuln, product, status, justification string }
+	var got []cell
+	for _, stmt := range merged.Statements {
+		for _, product := range stmt.Products {
+			got = append(got, cell{string(stmt.Vulnerability.Name), product.Component.ID, string(stmt.Status), string(stmt.Justification)})
+		}
+	}
+	want := map[cell]bool{
+		{"CVE-2023-1000", "pkg:npm/app@1.0.0", "affected", ""}:                                        true,
+		{"CVE-2023-2000", "pkg:npm/app@1.0.0", "not_affected", "vulnerable_code_not_present"}:         true,
+		{"CVE-2023-2000", "pkg:npm/app@2.0.0", "fixed", ""}:                                           true,
+		{"CVE-2023-3000", "pkg:npm/lib@1.0.0", "not_affected", "vulnerable_code_not_in_execute_path"}: true,
+		{"CVE-2023-3001", "pkg:npm/lib@1.0.0", "affected", ""}:                                        true,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("MergeMixedFormats() statements = %v, want %d", got, len(want))
+	}
+	for _, c := range got {
+		if !want[c] {
+			t.Errorf("MergeMixedFormats() unexpected statement %v", c)
+		}
+	}
+}
+
+func TestMergeMixedFormats_ConversionError(t *testing.T) {
+	client := NewClient("test-author")
+	bom := `{
+		"bomFormat": "CycloneDX",
+		"vulnerabilities": [{"id": "CVE-2023-3000", "analysis": {"state": "bogus"}, "affects": [{"ref": "lib-1"}]}]
+	}`
+
+	_, _, err := client.MergeMixedFormats(context.Background(), &MergeInput{
+		Documents: []map[string]interface{}{decodeDocument(t, bom)},
+	})
+	if err == nil {
+		t.Fatal("MergeMixedFormats() expected error")
+	}
+	if want := `document 1: converting cyclonedx: vulnerability CVE-2023-3000: unknown analysis state "bogus"`; err.Error() != want {
+		t.Errorf("MergeMixedFormats() error = %v, want %v", err, want)
+	}
+}
@@ -0,0 +1,21 @@
+package vex
+
+// DefaultGeneratorName is the name recorded in the generator extension field
+// when none is configured
+const DefaultGeneratorName = "vexdoc-mcp-server"
+
+// WithGenerator sets the name and version recorded in the generator extension
+// field; an empty name keeps the default
+func WithGenerator(name, version string) Option {
+	return func(c *Client) {
+		if name != "" {
+			c.generator = Generator{Name: name, Version: version}
+		}
+	}
+}
+
+// Generator returns the tool recorded in the generator extension field of
+// documents requested with generator metadata. GeneratedAt is left unset.
+func (c *Client) Generator() Generator {
+	return c.generator
+}
@@ -0,0 +1,48 @@
+package vex
+
+import (
+	"strings"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// groupByVulnerability combines statements that agree on everything but
+// their products into one statement listing all of their products, in order
+// of first appearance. The combined statement keeps the newest timestamp.
+func groupByVulnerability(statements []vexlib.Statement) []vexlib.Statement {
+	var grouped []vexlib.Statement
+	index := map[string]int{}
+	for _, stmt := range statements {
+		key := groupKey(stmt)
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(grouped)
+			stmt.Products = append([]vexlib.Product(nil), stmt.Products...)
+			grouped = append(grouped, stmt)
+			continue
+		}
+
+		group := &grouped[i]
+		group.Products = dedupeProducts(append(group.Products, stmt.Products...))
+		if stmt.Timestamp != nil && (group.Timestamp == nil || stmt.Timestamp.After(*group.Timestamp)) {
+			group.Timestamp = stmt.Timestamp
+		}
+		if stmt.LastUpdated != nil && (group.LastUpdated == nil || stmt.LastUpdated.After(*group.LastUpdated)) {
+			group.LastUpdated = stmt.LastUpdated
+		}
+	}
+	return grouped
+}
+
+// groupKey identifies the statements that may be combined. Everything that
+// describes the assessment must match so no information is lost.
+func groupKey(stmt vexlib.Statement) string {
+	return strings.Join([]string{
+		string(stmt.Vulnerability.Name),
+		string(stmt.Status),
+		string(stmt.Justification),
+		stmt.ImpactStatement,
+		stmt.ActionStatement,
+		stmt.StatusNotes,
+	}, "\x00")
+}
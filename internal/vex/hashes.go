@@ -0,0 +1,64 @@
+package vex
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// StatementHash is the canonical hash of one statement of a document
+type StatementHash struct {
+	Statement     int    `json:"statement"`
+	Vulnerability string `json:"vulnerability"`
+	Hash          string `json:"hash"`
+}
+
+// HashStatements returns a canonical hash per statement of a document, for
+// indexing and deduplicating statements independently of their documents
+func (c *Client) HashStatements(raw map[string]interface{}) ([]StatementHash, error) {
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make([]StatementHash, 0, len(doc.Statements))
+	for i, stmt := range doc.Statements {
+		hash, err := statementHash(stmt)
+		if err != nil {
+			return nil, fmt.Errorf("statement %d: %w", i, err)
+		}
+		hashes = append(hashes, StatementHash{
+			Statement:     i,
+			Vulnerability: string(stmt.Vulnerability.Name),
+			Hash:          hash,
+		})
+	}
+	return hashes, nil
+}
+
+// statementHash hashes the vulnerability, sorted distinct products, status,
+// and justification of a statement. Timestamps and free text are ignored, so
+// the same assessment hashes equally wherever it appears.
+func statementHash(stmt vexlib.Statement) (string, error) {
+	products := productIDs(dedupeProducts(stmt.Products))
+	sort.Strings(products)
+
+	canonical, err := json.Marshal(struct {
+		Vulnerability string   `json:"vulnerability"`
+		Products      []string `json:"products"`
+		Status        string   `json:"status"`
+		Justification string   `json:"justification"`
+	}{
+		Vulnerability: string(stmt.Vulnerability.Name),
+		Products:      products,
+		Status:        string(stmt.Status),
+		Justification: string(stmt.Justification),
+	})
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(canonical)), nil
+}
@@ -0,0 +1,40 @@
+package vex
+
+import (
+	"testing"
+)
+
+func TestHashStatements(t *testing.T) {
+	client := NewClient("test-author")
+
+	hashes, err := client.HashStatements(decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}, {"@id": "pkg:npm/b@1.0.0"}], "status": "fixed", "timestamp": "2023-01-01T00:00:00Z"},
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/b@1.0.0"}, {"@id": "pkg:npm/a@1.0.0"}], "status": "fixed", "timestamp": "2024-06-01T00:00:00Z"},
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}, {"@id": "pkg:npm/b@1.0.0"}], "status": "not_affected", "justification": "component_not_present"},
+			{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}, {"@id": "pkg:npm/b@1.0.0"}], "status": "fixed"}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("HashStatements() error = %v", err)
+	}
+	if len(hashes) != 4 {
+		t.Fatalf("HashStatements() = %d hashes, want 4", len(hashes))
+	}
+
+	if hashes[0].Hash != hashes[1].Hash {
+		t.Errorf("product order and timestamps should not change the hash: %v != %v", hashes[0].Hash, hashes[1].Hash)
+	}
+	if hashes[0].Hash == hashes[2].Hash {
+		t.Error("a different status and justification should change the hash")
+	}
+	if hashes[0].Hash == hashes[3].Hash {
+		t.Error("a different vulnerability should change the hash")
+	}
+	for i, hash := range hashes {
+		if hash.Statement != i || len(hash.Hash) != len("sha256:")+64 {
+			t.Errorf("hashes[%d] = %+v", i, hash)
+		}
+	}
+}
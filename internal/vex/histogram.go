@@ -0,0 +1,32 @@
+package vex
+
+import (
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// StatusHistogram counts the statements of a document per status
+type StatusHistogram struct {
+	Total       int            `json:"total"`
+	Counts      map[string]int `json:"counts"`
+	HasAffected bool           `json:"has_affected"`
+}
+
+// StatusHistogram counts a document's statements per status in a single
+// pass. Every OpenVEX status is present in the counts, zero if unused.
+func (c *Client) StatusHistogram(raw map[string]interface{}) (*StatusHistogram, error) {
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	histogram := &StatusHistogram{Counts: map[string]int{}}
+	for _, status := range vexlib.Statuses() {
+		histogram.Counts[status] = 0
+	}
+	for _, stmt := range doc.Statements {
+		histogram.Counts[string(stmt.Status)]++
+		histogram.Total++
+	}
+	histogram.HasAffected = histogram.Counts[string(vexlib.StatusAffected)] > 0
+	return histogram, nil
+}
@@ -0,0 +1,63 @@
+package vex
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStatusHistogram(t *testing.T) {
+	client := NewClient("test-author")
+
+	tests := []struct {
+		name            string
+		doc             string
+		wantCounts      map[string]int
+		wantTotal       int
+		wantHasAffected bool
+	}{
+		{
+			name: "mixed document",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "affected", "action_statement": "Upgrade"},
+					{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"},
+					{"vulnerability": {"name": "CVE-2023-0003"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"},
+					{"vulnerability": {"name": "CVE-2023-0004"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "not_affected", "justification": "component_not_present"}
+				]
+			}`,
+			wantCounts:      map[string]int{"not_affected": 1, "affected": 1, "fixed": 2, "under_investigation": 0},
+			wantTotal:       4,
+			wantHasAffected: true,
+		},
+		{
+			name: "no affected statements",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "under_investigation"}
+				]
+			}`,
+			wantCounts: map[string]int{"not_affected": 0, "affected": 0, "fixed": 0, "under_investigation": 1},
+			wantTotal:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			histogram, err := client.StatusHistogram(decodeDocument(t, tt.doc))
+			if err != nil {
+				t.Fatalf("StatusHistogram() error = %v", err)
+			}
+			if !reflect.DeepEqual(histogram.Counts, tt.wantCounts) {
+				t.Errorf("StatusHistogram() counts = %v, want %v", histogram.Counts, tt.wantCounts)
+			}
+			if histogram.Total != tt.wantTotal {
+				t.Errorf("StatusHistogram() total = %d, want %d", histogram.Total, tt.wantTotal)
+			}
+			if histogram.HasAffected != tt.wantHasAffected {
+				t.Errorf("StatusHistogram() has_affected = %v, want %v", histogram.HasAffected, tt.wantHasAffected)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package vex
+
+import (
+	"crypto/rand"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Default document ID generation settings, producing IDs like vex-1700000000
+const (
+	DefaultIDTemplate = "{prefix}-{unix}"
+	DefaultIDPrefix   = "vex"
+)
+
+// idPlaceholder matches a template placeholder such as {uuid}
+var idPlaceholder = regexp.MustCompile(`\{[^{}]*\}`)
+
+// IDTemplate generates document IDs from a template containing {uuid},
+// {unix}, and {prefix} placeholders
+type IDTemplate struct {
+	template string
+	prefix   string
+}
+
+// ParseIDTemplate validates an ID template. The template must contain {uuid}
+// or {unix} so generated IDs are unique, and may only use known placeholders.
+func ParseIDTemplate(template, prefix string) (*IDTemplate, error) {
+	if err := ValidateRequired("id template", template); err != nil {
+		return nil, err
+	}
+	if err := ValidateDangerousChars("id prefix", prefix); err != nil {
+		return nil, err
+	}
+
+	for _, placeholder := range idPlaceholder.FindAllString(template, -1) {
+		switch placeholder {
+		case "{uuid}", "{unix}", "{prefix}":
+		default:
+			return nil, fmt.Errorf("id template contains unknown placeholder %s (supported: {uuid}, {unix}, {prefix})", placeholder)
+		}
+	}
+	if !strings.Contains(template, "{uuid}") && !strings.Contains(template, "{unix}") {
+		return nil, fmt.Errorf("id template must contain {uuid} or {unix}")
+	}
+
+	t := &IDTemplate{template: template, prefix: prefix}
+
+	// The expanded form must pass the same checks as a client-supplied ID
+	sample := t.Generate(time.Now())
+	if err := ValidateStringLength("generated id", sample, MaxIDLength); err != nil {
+		return nil, err
+	}
+	if err := ValidateDangerousChars("generated id", sample); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// Generate expands the template for a document created at now
+func (t *IDTemplate) Generate(now time.Time) string {
+	return strings.NewReplacer(
+		"{uuid}", newUUID(),
+		"{unix}", strconv.FormatInt(now.Unix(), 10),
+		"{prefix}", t.prefix,
+	).Replace(t.template)
+}
+
+// WithIDTemplate sets the template used for generated document IDs
+func WithIDTemplate(t *IDTemplate) Option {
+	return func(c *Client) {
+		c.idTemplate = t
+	}
+}
+
+// newUUID returns a random (version 4) UUID string
+func newUUID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		// crypto/rand does not fail on supported platforms
+		panic(fmt.Sprintf("failed to generate uuid: %v", err))
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
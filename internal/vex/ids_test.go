@@ -0,0 +1,120 @@
+package vex
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseIDTemplate(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name     string
+		template string
+		prefix   string
+		wantID   *regexp.Regexp
+	}{
+		{
+			name:     "default form",
+			template: DefaultIDTemplate,
+			prefix:   DefaultIDPrefix,
+			wantID:   regexp.MustCompile(`^vex-1700000000$`),
+		},
+		{
+			name:     "urn uuid",
+			template: "urn:uuid:{uuid}",
+			wantID:   regexp.MustCompile(`^urn:uuid:[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`),
+		},
+		{
+			name:     "url with prefix",
+			template: "https://example.com/vex/{prefix}/{unix}",
+			prefix:   "acme",
+			wantID:   regexp.MustCompile(`^https://example.com/vex/acme/1700000000$`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := ParseIDTemplate(tt.template, tt.prefix)
+			if err != nil {
+				t.Fatalf("ParseIDTemplate() error = %v", err)
+			}
+			if id := tmpl.Generate(now); !tt.wantID.MatchString(id) {
+				t.Errorf("Generate() = %v, want match for %v", id, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestParseIDTemplate_Invalid(t *testing.T) {
+	tests := []struct {
+		name            string
+		template        string
+		prefix          string
+		wantErrContains string
+	}{
+		{name: "empty", template: "", wantErrContains: "required"},
+		{name: "unknown placeholder", template: "{org}-{unix}", wantErrContains: "unknown placeholder {org}"},
+		{name: "no unique component", template: "{prefix}-static", wantErrContains: "{uuid} or {unix}"},
+		{name: "dangerous prefix", template: "{prefix}-{unix}", prefix: "$(id)", wantErrContains: "dangerous"},
+		{name: "unbalanced brace", template: "vex-{unix}-{", wantErrContains: "dangerous"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseIDTemplate(tt.template, tt.prefix)
+			if err == nil {
+				t.Fatal("ParseIDTemplate() expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.wantErrContains) {
+				t.Errorf("ParseIDTemplate() error = %v, want to contain %v", err, tt.wantErrContains)
+			}
+		})
+	}
+}
+
+func TestClient_IDTemplate(t *testing.T) {
+	tmpl, err := ParseIDTemplate("urn:uuid:{uuid}", "")
+	if err != nil {
+		t.Fatalf("ParseIDTemplate() error = %v", err)
+	}
+	client := NewClient("test-author", WithIDTemplate(tmpl))
+
+	doc, err := client.CreateDocument(&CreateInput{
+		Product:       "pkg:npm/lodash@4.17.21",
+		Vulnerability: "CVE-2023-1234",
+		Status:        "fixed",
+	})
+	if err != nil {
+		t.Fatalf("CreateDocument() error = %v", err)
+	}
+	if !strings.HasPrefix(doc.ID, "urn:uuid:") {
+		t.Errorf("created ID = %v, want urn:uuid: prefix", doc.ID)
+	}
+
+	docMap := map[string]interface{}{
+		"@context":   "https://openvex.dev/ns",
+		"timestamp":  "2023-01-01T00:00:00Z",
+		"statements": []interface{}{},
+	}
+	merged, err := client.MergeDocuments(context.Background(), &MergeInput{Documents: []map[string]interface{}{docMap, docMap}})
+	if err != nil {
+		t.Fatalf("MergeDocuments() error = %v", err)
+	}
+	if !strings.HasPrefix(merged.ID, "urn:uuid:") {
+		t.Errorf("merged ID = %v, want urn:uuid: prefix", merged.ID)
+	}
+
+	// Without a template, created documents keep the vex-{unix} form
+	doc, _ = NewClient("test-author").CreateDocument(&CreateInput{
+		Product:       "pkg:npm/lodash@4.17.21",
+		Vulnerability: "CVE-2023-1234",
+		Status:        "fixed",
+	})
+	if !regexp.MustCompile(`^vex-\d+$`).MatchString(doc.ID) {
+		t.Errorf("default ID = %v, want vex-{unix}", doc.ID)
+	}
+}
@@ -0,0 +1,43 @@
+package vex
+
+import (
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// OpenInvestigation is a statement still under investigation
+type OpenInvestigation struct {
+	Statement     int      `json:"statement"`
+	Vulnerability string   `json:"vulnerability"`
+	Products      []string `json:"products"`
+}
+
+// InvestigationsReport lists the under_investigation statements of a
+// document. Pass is true when there are none.
+type InvestigationsReport struct {
+	Pass bool                `json:"pass"`
+	Open []OpenInvestigation `json:"open"`
+}
+
+// CheckNoOpenInvestigations reports the statements of a document whose
+// status is still under_investigation, for release gates that block on
+// unresolved triage
+func (c *Client) CheckNoOpenInvestigations(raw map[string]interface{}) (*InvestigationsReport, error) {
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &InvestigationsReport{Open: []OpenInvestigation{}}
+	for i, stmt := range doc.Statements {
+		if stmt.Status != vexlib.StatusUnderInvestigation {
+			continue
+		}
+		report.Open = append(report.Open, OpenInvestigation{
+			Statement:     i,
+			Vulnerability: string(stmt.Vulnerability.Name),
+			Products:      productIDs(stmt.Products),
+		})
+	}
+	report.Pass = len(report.Open) == 0
+	return report, nil
+}
@@ -0,0 +1,56 @@
+package vex
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckNoOpenInvestigations(t *testing.T) {
+	client := NewClient("test-author")
+
+	tests := []struct {
+		name     string
+		doc      string
+		wantOpen []OpenInvestigation
+	}{
+		{
+			name: "open investigations",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"},
+					{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}, {"@id": "pkg:npm/b@1.0.0"}], "status": "under_investigation"}
+				]
+			}`,
+			wantOpen: []OpenInvestigation{
+				{Statement: 1, Vulnerability: "CVE-2023-0002", Products: []string{"pkg:npm/a@1.0.0", "pkg:npm/b@1.0.0"}},
+			},
+		},
+		{
+			name: "no open investigations",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"},
+					{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "not_affected", "justification": "component_not_present"}
+				]
+			}`,
+			wantOpen: []OpenInvestigation{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := client.CheckNoOpenInvestigations(decodeDocument(t, tt.doc))
+			if err != nil {
+				t.Fatalf("CheckNoOpenInvestigations() error = %v", err)
+			}
+			if !reflect.DeepEqual(report.Open, tt.wantOpen) {
+				t.Errorf("CheckNoOpenInvestigations() open = %+v, want %+v", report.Open, tt.wantOpen)
+			}
+			if report.Pass != (len(tt.wantOpen) == 0) {
+				t.Errorf("CheckNoOpenInvestigations() pass = %v", report.Pass)
+			}
+		})
+	}
+}
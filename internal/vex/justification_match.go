@@ -0,0 +1,65 @@
+package vex
+
+import (
+	"fmt"
+	"strings"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// JustificationMismatch describes a statement whose justification does not
+// fit its status
+type JustificationMismatch struct {
+	Index  int    `json:"index"`
+	Status string `json:"status"`
+	Reason string `json:"reason"`
+}
+
+// JustificationReport lists the statements of a document whose
+// justification does not match their status
+type JustificationReport struct {
+	Consistent bool                    `json:"consistent"`
+	Mismatches []JustificationMismatch `json:"mismatches"`
+}
+
+// justificationMismatch returns why the statement's justification does not
+// fit its status, or "" when it does. A justification only belongs on a
+// not_affected statement, must be one of the OpenVEX values there, and a
+// not_affected statement needs either it or an impact statement. Statement
+// creation and the publish check share it so the rule is stated once.
+func justificationMismatch(stmt *vexlib.Statement) string {
+	switch {
+	case stmt.Justification != "" && stmt.Status != vexlib.StatusNotAffected:
+		return fmt.Sprintf("justification %q is only valid with status not_affected", stmt.Justification)
+	case stmt.Justification != "" && !stmt.Justification.Valid():
+		return fmt.Sprintf("justification %q is not one of: %s", stmt.Justification, strings.Join(vexlib.Justifications(), ", "))
+	case stmt.Status == vexlib.StatusNotAffected && stmt.Justification == "" && strings.TrimSpace(stmt.ImpactStatement) == "":
+		return fmt.Sprintf("not_affected requires either a 'justification' (one of: %s) or a non-empty 'impact_statement'",
+			strings.Join(vexlib.Justifications(), ", "))
+	}
+	return ""
+}
+
+// CheckJustificationStatus reports the indices of statements whose
+// justification does not match their status. Like the other document
+// checks it is advisory and does not reject the document.
+func (c *Client) CheckJustificationStatus(raw map[string]interface{}) (*JustificationReport, error) {
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &JustificationReport{Mismatches: []JustificationMismatch{}}
+	for i := range doc.Statements {
+		stmt := &doc.Statements[i]
+		if reason := justificationMismatch(stmt); reason != "" {
+			report.Mismatches = append(report.Mismatches, JustificationMismatch{
+				Index:  i,
+				Status: string(stmt.Status),
+				Reason: reason,
+			})
+		}
+	}
+	report.Consistent = len(report.Mismatches) == 0
+	return report, nil
+}
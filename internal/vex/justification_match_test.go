@@ -0,0 +1,72 @@
+package vex
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckJustificationStatus(t *testing.T) {
+	client := NewClient("test-author")
+
+	tests := []struct {
+		name        string
+		doc         string
+		wantIndices []int
+		wantReasons []string
+	}{
+		{
+			name: "mismatched statements",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "not_affected", "justification": "component_not_present"},
+					{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "affected", "justification": "component_not_present", "action_statement": "Upgrade"},
+					{"vulnerability": {"name": "CVE-2023-0003"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "not_affected"},
+					{"vulnerability": {"name": "CVE-2023-0004"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "not_affected", "justification": "not_reachable"},
+					{"vulnerability": {"name": "CVE-2023-0005"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed", "justification": "inline_mitigations_already_exist"},
+					{"vulnerability": {"name": "CVE-2023-0006"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "not_affected", "impact_statement": "Not loaded"}
+				]
+			}`,
+			wantIndices: []int{1, 2, 3, 4},
+			wantReasons: []string{
+				"only valid with status not_affected",
+				"requires either a 'justification' (one of: component_not_present",
+				"is not one of",
+				"only valid with status not_affected",
+			},
+		},
+		{
+			name: "consistent statements",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "not_affected", "justification": "vulnerable_code_not_present"},
+					{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "under_investigation"}
+				]
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := client.CheckJustificationStatus(decodeDocument(t, tt.doc))
+			if err != nil {
+				t.Fatalf("CheckJustificationStatus() error = %v", err)
+			}
+			if len(report.Mismatches) != len(tt.wantIndices) {
+				t.Fatalf("CheckJustificationStatus() mismatches = %+v, want indices %v", report.Mismatches, tt.wantIndices)
+			}
+			for i, mismatch := range report.Mismatches {
+				if mismatch.Index != tt.wantIndices[i] {
+					t.Errorf("mismatch %d index = %d, want %d", i, mismatch.Index, tt.wantIndices[i])
+				}
+				if !strings.Contains(mismatch.Reason, tt.wantReasons[i]) {
+					t.Errorf("mismatch %d reason = %q, want to contain %q", i, mismatch.Reason, tt.wantReasons[i])
+				}
+			}
+			if report.Consistent != (len(tt.wantIndices) == 0) {
+				t.Errorf("CheckJustificationStatus() consistent = %v", report.Consistent)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package vex
+
+import (
+	"strings"
+	"unicode"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// justificationSynonyms maps common near-miss justifications, already
+// normalized to snake case, to their canonical value
+var justificationSynonyms = map[string]vexlib.Justification{
+	"not_present":                           vexlib.ComponentNotPresent,
+	"component_absent":                      vexlib.ComponentNotPresent,
+	"component_not_found":                   vexlib.ComponentNotPresent,
+	"component_not_included":                vexlib.ComponentNotPresent,
+	"code_not_present":                      vexlib.VulnerableCodeNotPresent,
+	"vulnerable_code_absent":                vexlib.VulnerableCodeNotPresent,
+	"vulnerable_code_not_included":          vexlib.VulnerableCodeNotPresent,
+	"not_in_execute_path":                   vexlib.VulnerableCodeNotInExecutePath,
+	"not_in_execution_path":                 vexlib.VulnerableCodeNotInExecutePath,
+	"vulnerable_code_not_in_execution_path": vexlib.VulnerableCodeNotInExecutePath,
+	"vulnerable_code_not_reachable":         vexlib.VulnerableCodeNotInExecutePath,
+	"code_not_reachable":                    vexlib.VulnerableCodeNotInExecutePath,
+	"not_reachable":                         vexlib.VulnerableCodeNotInExecutePath,
+	"unreachable":                           vexlib.VulnerableCodeNotInExecutePath,
+	"cannot_be_controlled_by_adversary":     vexlib.VulnerableCodeCannotBeControlledByAdversary,
+	"not_controllable_by_adversary":         vexlib.VulnerableCodeCannotBeControlledByAdversary,
+	"vulnerable_code_not_controllable":      vexlib.VulnerableCodeCannotBeControlledByAdversary,
+	"inline_mitigations_exist":              vexlib.InlineMitigationsAlreadyExist,
+	"inline_mitigation_already_exists":      vexlib.InlineMitigationsAlreadyExist,
+	"mitigations_already_exist":             vexlib.InlineMitigationsAlreadyExist,
+	"mitigations_exist":                     vexlib.InlineMitigationsAlreadyExist,
+	"mitigated":                             vexlib.InlineMitigationsAlreadyExist,
+}
+
+// WithLenientJustifications makes the client accept case, separator, and
+// common synonym variants of justifications, mapping them to canonical values
+func WithLenientJustifications(lenient bool) Option {
+	return func(c *Client) {
+		c.lenientJustifications = lenient
+	}
+}
+
+// LenientJustifications reports whether justification variants are accepted
+func (c *Client) LenientJustifications() bool {
+	return c.lenientJustifications
+}
+
+// normalizeJustification maps a justification variant such as
+// "Component-Not-Present" or "componentNotPresent" to its canonical value,
+// returning false when it matches no known justification
+func normalizeJustification(justification string) (vexlib.Justification, bool) {
+	normalized := snakeCase(justification)
+	if j := vexlib.Justification(normalized); j.Valid() {
+		return j, true
+	}
+	if j, ok := justificationSynonyms[normalized]; ok {
+		return j, true
+	}
+	return "", false
+}
+
+// snakeCase lowercases a trimmed identifier, turning separators and
+// camelCase word boundaries into underscores
+func snakeCase(s string) string {
+	var b strings.Builder
+	runes := []rune(strings.TrimSpace(s))
+	for i, r := range runes {
+		switch {
+		case r == '-' || r == ' ' || r == '.':
+			b.WriteRune('_')
+		case unicode.IsUpper(r):
+			// Split camelCase words, but not runs of capitals
+			if i > 0 && unicode.IsLower(runes[i-1]) {
+				b.WriteRune('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
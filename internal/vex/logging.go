@@ -0,0 +1,39 @@
+package vex
+
+import (
+	"errors"
+	"log/slog"
+)
+
+// WithLogger sets the logger used to record rejected inputs
+func WithLogger(logger *slog.Logger) Option {
+	return func(c *Client) {
+		if logger != nil {
+			c.logger = logger
+		}
+	}
+}
+
+// logRejection records a validation rejection for security monitoring. Only
+// the field name and reason are logged, never the rejected value, so
+// attacker-controlled input cannot be injected into the log. Each field of
+// a joined error is logged separately.
+func (c *Client) logRejection(operation string, err error) {
+	var joined interface{ Unwrap() []error }
+	if errors.As(err, &joined) {
+		for _, fieldErr := range joined.Unwrap() {
+			c.logRejection(operation, fieldErr)
+		}
+		return
+	}
+
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		return
+	}
+	c.logger.Warn("validation rejected",
+		"operation", operation,
+		"field", validationErr.Field,
+		"reason", validationErr.Reason,
+	)
+}
@@ -0,0 +1,77 @@
+package vex
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestLogRejection(t *testing.T) {
+	var buf bytes.Buffer
+	client := NewClient("test-author", WithLogger(slog.New(slog.NewTextHandler(&buf, nil))))
+
+	_, err := client.CreateStatement("pkg:npm/test;rm -rf", "CVE-2023-1234", "fixed", "", "", "", "")
+	if err == nil {
+		t.Fatal("CreateStatement() expected validation error")
+	}
+
+	output := buf.String()
+	for _, want := range []string{"validation rejected", "operation=create", "field=product", `reason="contains potentially dangerous characters"`} {
+		if !strings.Contains(output, want) {
+			t.Errorf("log should contain %q, got %q", want, output)
+		}
+	}
+	if strings.Contains(output, "rm -rf") {
+		t.Errorf("log must not contain the rejected value, got %q", output)
+	}
+}
+
+func TestLogRejection_Merge(t *testing.T) {
+	var buf bytes.Buffer
+	client := NewClient("test-author", WithLogger(slog.New(slog.NewTextHandler(&buf, nil))))
+
+	_, err := client.MergeDocuments(context.Background(), &MergeInput{
+		Documents: []map[string]interface{}{{}, {}},
+		Author:    strings.Repeat("a", MaxAuthorLength+1),
+	})
+	if err == nil {
+		t.Fatal("MergeDocuments() expected validation error")
+	}
+	if !strings.Contains(buf.String(), "operation=merge field=author") {
+		t.Errorf("log should record the rejected author, got %q", buf.String())
+	}
+}
+
+func TestLogRejection_IgnoresOtherErrors(t *testing.T) {
+	var buf bytes.Buffer
+	client := NewClient("test-author", WithLogger(slog.New(slog.NewTextHandler(&buf, nil))))
+
+	_, err := client.CreateStatement("pkg:npm/test@1.0.0", "CVE-2023-1234", "bogus", "", "", "", "")
+	if err == nil {
+		t.Fatal("CreateStatement() expected error for invalid status")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("non-validation errors should not be logged, got %q", buf.String())
+	}
+}
+
+func TestLogRejection_CollectedErrors(t *testing.T) {
+	var buf bytes.Buffer
+	client := NewClient("test-author", WithLogger(slog.New(slog.NewTextHandler(&buf, nil))))
+
+	_, err := client.CreateDocument(&CreateInput{
+		Product:       "pkg:npm/test;rm -rf",
+		Status:        "fixed",
+		CollectErrors: true,
+	})
+	if err == nil {
+		t.Fatal("CreateDocument() expected validation error")
+	}
+	for _, want := range []string{"field=product", "field=vulnerability"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("log should contain %q, got %q", want, buf.String())
+		}
+	}
+}
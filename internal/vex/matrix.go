@@ -0,0 +1,44 @@
+package vex
+
+// StatusMatrix is a product by vulnerability grid of statuses. Products and
+// Vulnerabilities list the grid axes in the order they first appear.
+type StatusMatrix struct {
+	Products        []string                     `json:"products"`
+	Vulnerabilities []string                     `json:"vulnerabilities"`
+	Statuses        map[string]map[string]string `json:"statuses"`
+}
+
+// StatusMatrix maps each product of a document to the status of every
+// vulnerability stated for it. Statements covering several products are
+// expanded into one cell per product. When several statements cover the
+// same cell, the later one in the document wins.
+func (c *Client) StatusMatrix(raw map[string]interface{}) (*StatusMatrix, error) {
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	matrix := &StatusMatrix{
+		Products:        []string{},
+		Vulnerabilities: []string{},
+		Statuses:        map[string]map[string]string{},
+	}
+	seenVulns := map[string]bool{}
+	for _, stmt := range doc.Statements {
+		vuln := string(stmt.Vulnerability.Name)
+		if !seenVulns[vuln] {
+			seenVulns[vuln] = true
+			matrix.Vulnerabilities = append(matrix.Vulnerabilities, vuln)
+		}
+		for _, product := range productIDs(stmt.Products) {
+			row, ok := matrix.Statuses[product]
+			if !ok {
+				row = map[string]string{}
+				matrix.Statuses[product] = row
+				matrix.Products = append(matrix.Products, product)
+			}
+			row[vuln] = string(stmt.Status)
+		}
+	}
+	return matrix, nil
+}
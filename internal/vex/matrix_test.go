@@ -0,0 +1,37 @@
+package vex
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStatusMatrix(t *testing.T) {
+	client := NewClient("test-author")
+	doc := `{
+		"@context": "https://openvex.dev/ns",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}, {"@id": "pkg:npm/b@1.0.0"}], "status": "under_investigation"},
+			{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/b@1.0.0"}], "status": "affected"},
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"}
+		]
+	}`
+
+	matrix, err := client.StatusMatrix(decodeDocument(t, doc))
+	if err != nil {
+		t.Fatalf("StatusMatrix() error = %v", err)
+	}
+
+	if want := []string{"pkg:npm/a@1.0.0", "pkg:npm/b@1.0.0"}; !reflect.DeepEqual(matrix.Products, want) {
+		t.Errorf("StatusMatrix() products = %v, want %v", matrix.Products, want)
+	}
+	if want := []string{"CVE-2023-0001", "CVE-2023-0002"}; !reflect.DeepEqual(matrix.Vulnerabilities, want) {
+		t.Errorf("StatusMatrix() vulnerabilities = %v, want %v", matrix.Vulnerabilities, want)
+	}
+	want := map[string]map[string]string{
+		"pkg:npm/a@1.0.0": {"CVE-2023-0001": "fixed"},
+		"pkg:npm/b@1.0.0": {"CVE-2023-0001": "under_investigation", "CVE-2023-0002": "affected"},
+	}
+	if !reflect.DeepEqual(matrix.Statuses, want) {
+		t.Errorf("StatusMatrix() statuses = %v, want %v", matrix.Statuses, want)
+	}
+}
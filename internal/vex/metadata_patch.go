@@ -0,0 +1,60 @@
+package vex
+
+import (
+	"fmt"
+)
+
+// PatchMetadata applies an RFC 7386 JSON Merge Patch to the top-level
+// metadata of a document, such as author, @id, and extension fields.
+// Statements cannot be patched and are carried over unchanged, extension
+// fields included. The patched author, role, and @id are validated like
+// merge metadata.
+func (c *Client) PatchMetadata(raw, patch map[string]interface{}) (*Document, error) {
+	if _, ok := patch["statements"]; ok {
+		return nil, fmt.Errorf("metadata patch cannot modify statements")
+	}
+
+	patched, _ := mergePatch(raw, patch).(map[string]interface{})
+	if _, ok := patched["@context"]; !ok {
+		return nil, fmt.Errorf("metadata patch cannot remove @context")
+	}
+
+	doc, err := parseDocument(patched)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMergeMetadata(&MergeInput{Author: doc.Author, AuthorRole: doc.AuthorRole, ID: doc.ID}); err != nil {
+		return nil, err
+	}
+	if err := ValidateLabels(documentLabels(patched)); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	result := documentWithExtensions(doc, patched)
+	return result, nil
+}
+
+// mergePatch returns target with patch applied per RFC 7386: object
+// members are merged recursively, null removes a member, and any other
+// value replaces the target outright. target is not modified.
+func mergePatch(target, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	result := map[string]interface{}{}
+	if targetObject, ok := target.(map[string]interface{}); ok {
+		for key, value := range targetObject {
+			result[key] = value
+		}
+	}
+	for key, value := range patchObject {
+		if value == nil {
+			delete(result, key)
+			continue
+		}
+		result[key] = mergePatch(result[key], value)
+	}
+	return result
+}
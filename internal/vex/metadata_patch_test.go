@@ -0,0 +1,87 @@
+package vex
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPatchMetadata(t *testing.T) {
+	client := NewClient("test-author")
+	doc := `{
+		"@context": "https://openvex.dev/ns",
+		"@id": "https://example.com/vex/1",
+		"author": "old-author",
+		"role": "Engineer",
+		"timestamp": "2023-01-01T00:00:00Z",
+		"version": 1,
+		"labels": {"team": "platform", "env": "prod"},
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed", "cvss": {"score": 7.5}, "notes": ["backported"]}
+		]
+	}`
+
+	tests := []struct {
+		name            string
+		patch           string
+		wantErrContains string
+	}{
+		{
+			name:  "author and id",
+			patch: `{"author": "new-author", "@id": "https://example.com/vex/2", "role": null, "labels": {"env": null, "owner": "sec"}}`,
+		},
+		{
+			name:            "statements",
+			patch:           `{"statements": []}`,
+			wantErrContains: "cannot modify statements",
+		},
+		{
+			name:            "remove context",
+			patch:           `{"@context": null}`,
+			wantErrContains: "cannot remove @context",
+		},
+		{
+			name:            "invalid author",
+			patch:           `{"author": "<script>alert(1)</script>"}`,
+			wantErrContains: "validation error: author",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := decodeDocument(t, doc)
+			result, err := client.PatchMetadata(raw, decodeDocument(t, tt.patch))
+			if tt.wantErrContains != "" {
+				if err == nil {
+					t.Fatal("PatchMetadata() expected error")
+				}
+				if !strings.Contains(err.Error(), tt.wantErrContains) {
+					t.Errorf("PatchMetadata() error = %v, want to contain %v", err, tt.wantErrContains)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("PatchMetadata() error = %v", err)
+			}
+			if result.Author != "new-author" || result.ID != "https://example.com/vex/2" {
+				t.Errorf("PatchMetadata() author = %q, id = %q", result.Author, result.ID)
+			}
+			if result.AuthorRole != "" {
+				t.Errorf("PatchMetadata() role = %q, want removed", result.AuthorRole)
+			}
+			if len(result.Statements) != 1 || result.Statements[0].Vulnerability.Name != "CVE-2023-0001" {
+				t.Errorf("PatchMetadata() statements = %v, want unchanged", result.Statements)
+			}
+			if result.StatementExtensions[0][CVSSExtension] == nil || result.StatementExtensions[0][NotesExtension] == nil {
+				t.Errorf("PatchMetadata() statement extensions = %v, want unchanged", result.StatementExtensions)
+			}
+			wantLabels := map[string]interface{}{"team": "platform", "owner": "sec"}
+			if !reflect.DeepEqual(result.Extensions[LabelsExtension], wantLabels) {
+				t.Errorf("PatchMetadata() labels = %v, want %v", result.Extensions[LabelsExtension], wantLabels)
+			}
+			if raw["author"] != "old-author" {
+				t.Error("PatchMetadata() modified the input document")
+			}
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package vex
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// legacyContextLocator is the @context of OpenVEX v0.0.1 documents, which
+// go-vex reads in compatibility mode
+const legacyContextLocator = vexlib.Context + "/v0.0.1"
+
+// legacyStatementFields are the v0.0.1 statement fields the compatibility
+// parser converts into current fields, so they are not kept as extensions
+var legacyStatementFields = map[string]bool{
+	"vuln_description": true,
+	"subcomponents":    true,
+}
+
+// SupportedMigrationContexts returns the @context values documents can be
+// migrated to: the unversioned OpenVEX context and the current versioned one
+func SupportedMigrationContexts() []string {
+	return []string{vexlib.Context, vexlib.ContextLocator()}
+}
+
+// MigrationFileResult is the outcome of migrating a single file
+type MigrationFileResult struct {
+	File        string `json:"file"`
+	FromContext string `json:"from_context,omitempty"`
+	ToContext   string `json:"to_context,omitempty"`
+	Migrated    bool   `json:"migrated"`
+	Output      string `json:"output,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// MigrationReport is the result of migrating a directory of documents
+type MigrationReport struct {
+	TargetContext string                `json:"target_context"`
+	Migrated      int                   `json:"migrated"`
+	Failed        int                   `json:"failed"`
+	Files         []MigrationFileResult `json:"files"`
+}
+
+// MigrateDirectory migrates every document matching DirectoryDocumentPattern
+// in dir to targetContext. Migrated documents are written back in place, or,
+// when outputDir is set, every document is written to outputDir, which is
+// resolved relative to dir and may not leave it. Per-file failures are
+// reported rather than aborting the run.
+func (c *Client) MigrateDirectory(dir, targetContext, outputDir string) (*MigrationReport, error) {
+	report, err := c.migrateDirectory(dir, targetContext, outputDir)
+	if err != nil {
+		c.logRejection("migrate_directory", err)
+	}
+	return report, err
+}
+
+func (c *Client) migrateDirectory(dir, targetContext, outputDir string) (*MigrationReport, error) {
+	if err := ValidateRequired("directory", dir); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	if targetContext == "" {
+		targetContext = vexlib.ContextLocator()
+	}
+	if err := validateTargetContext(targetContext); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	dir, err := c.ResolvePath("directory", dir)
+	if err != nil {
+		return nil, err
+	}
+	if outputDir != "" {
+		if err := ValidateRelativePath("output_dir", outputDir); err != nil {
+			return nil, fmt.Errorf("validation error: %w", err)
+		}
+		if outputDir, err = c.ResolvePath("output_dir", filepath.Join(dir, outputDir)); err != nil {
+			return nil, err
+		}
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, DirectoryDocumentPattern))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list directory: %w", err)
+	}
+	if err := ValidateDirectoryFileCount(len(files), c.maxDirectoryFiles); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	sort.Strings(files)
+
+	if outputDir != "" {
+		if err := os.MkdirAll(outputDir, 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create output directory: %w", err)
+		}
+	}
+
+	report := &MigrationReport{TargetContext: targetContext, Files: []MigrationFileResult{}}
+	for _, path := range files {
+		// Files are rewritten in place, so a symlink out of the file root
+		// must not be followed
+		if _, err := c.ResolvePath(filepath.Base(path), path); err != nil {
+			report.Failed++
+			report.Files = append(report.Files, MigrationFileResult{File: filepath.Base(path), ToContext: targetContext, Error: err.Error()})
+			continue
+		}
+		output := ""
+		if outputDir != "" {
+			if output, err = c.resolveOutputFile(outputDir, filepath.Base(path)); err != nil {
+				report.Failed++
+				report.Files = append(report.Files, MigrationFileResult{File: filepath.Base(path), ToContext: targetContext, Error: err.Error()})
+				continue
+			}
+		}
+		result := migrateFile(path, targetContext, output)
+		if result.Error != "" {
+			report.Failed++
+		} else if result.Migrated {
+			report.Migrated++
+		}
+		report.Files = append(report.Files, result)
+	}
+	return report, nil
+}
+
+// resolveOutputFile returns the path the migrated copy of name is written to
+// in outputDir. A symlink already there is refused rather than followed, so
+// the write cannot land outside the file root.
+func (c *Client) resolveOutputFile(outputDir, name string) (string, error) {
+	output, err := c.ResolvePath(name, filepath.Join(outputDir, name))
+	if err != nil {
+		return "", err
+	}
+	if info, err := os.Lstat(filepath.Join(outputDir, name)); err == nil && info.Mode()&os.ModeSymlink != 0 {
+		return "", fmt.Errorf("%s in output_dir is a symlink", name)
+	}
+	return output, nil
+}
+
+// migrateFile migrates one document file. With no output path it is written
+// back in place, and only when its context changed. Document and statement
+// extension fields are kept.
+func migrateFile(path, targetContext, output string) MigrationFileResult {
+	result := MigrationFileResult{File: filepath.Base(path), ToContext: targetContext}
+
+	doc, raw, err := readMigratableDocument(path)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.FromContext = doc.Context
+	result.Migrated = migrateDocument(doc, targetContext)
+
+	if output == "" {
+		if !result.Migrated {
+			return result
+		}
+		output = path
+	}
+
+	migrated := documentWithExtensions(doc, raw)
+
+	data, err := json.MarshalIndent(migrated, "", "  ")
+	if err != nil {
+		result.Error = fmt.Sprintf("failed to marshal document: %v", err)
+		return result
+	}
+	if err := os.WriteFile(output, append(data, '\n'), 0o644); err != nil {
+		result.Error = fmt.Sprintf("failed to write %s: %v", filepath.Base(output), err)
+		return result
+	}
+	result.Output = output
+	return result
+}
+
+// readMigratableDocument reads a document of any OpenVEX version, along with
+// its raw fields so extensions survive the rewrite. The unversioned context
+// is shared by v0.0.1 and current documents, so it is read as a current
+// document first and in compatibility mode on failure. go-vex needs the
+// whole file for compatibility mode, so its size is checked before it is
+// read.
+func readMigratableDocument(path string) (*vexlib.VEX, map[string]interface{}, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read %s: %w", filepath.Base(path), err)
+	}
+	if err := ValidateDocumentFileSize(filepath.Base(path), info.Size()); err != nil {
+		return nil, nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read %s: %w", filepath.Base(path), err)
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse %s: %w", filepath.Base(path), err)
+	}
+	context, _ := raw["@context"].(string)
+	if !strings.HasPrefix(context, vexlib.Context) {
+		return nil, nil, fmt.Errorf("%s must be a valid VEX document with an OpenVEX @context", filepath.Base(path))
+	}
+
+	if context != legacyContextLocator {
+		doc, err := vexlib.Parse(data)
+		if err == nil {
+			return doc, raw, nil
+		}
+		if context != vexlib.Context {
+			return nil, nil, fmt.Errorf("failed to parse %s: %w", filepath.Base(path), err)
+		}
+	}
+
+	doc, err := vexlib.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse %s: %w", filepath.Base(path), err)
+	}
+	// The compatibility parser stamps the current context; keep the original
+	doc.Context = context
+	return doc, withoutLegacyStatementFields(raw), nil
+}
+
+// withoutLegacyStatementFields returns a copy of a raw v0.0.1 document whose
+// statements no longer carry the fields go-vex converted, so they are not
+// written back next to their converted form
+func withoutLegacyStatementFields(raw map[string]interface{}) map[string]interface{} {
+	statements, _ := raw["statements"].([]interface{})
+	stripped := make([]interface{}, 0, len(statements))
+	for _, value := range statements {
+		stmt, ok := value.(map[string]interface{})
+		if !ok {
+			stripped = append(stripped, value)
+			continue
+		}
+		kept := make(map[string]interface{}, len(stmt))
+		for name, field := range stmt {
+			if !legacyStatementFields[name] {
+				kept[name] = field
+			}
+		}
+		stripped = append(stripped, kept)
+	}
+
+	result := make(map[string]interface{}, len(raw))
+	for name, value := range raw {
+		result[name] = value
+	}
+	result["statements"] = stripped
+	return result
+}
+
+// migrateDocument rewrites a parsed document's context to targetContext and
+// reports whether it changed
+func migrateDocument(doc *vexlib.VEX, targetContext string) bool {
+	if doc.Context == targetContext {
+		return false
+	}
+	doc.Context = targetContext
+	return true
+}
+
+// validateTargetContext checks that documents can be migrated to context
+func validateTargetContext(context string) error {
+	for _, supported := range SupportedMigrationContexts() {
+		if context == supported {
+			return nil
+		}
+	}
+	return &ValidationError{
+		Field:  "target_context",
+		Reason: fmt.Sprintf("must be one of: %s", strings.Join(SupportedMigrationContexts(), ", ")),
+	}
+}
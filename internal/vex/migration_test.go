@@ -0,0 +1,261 @@
+package vex
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// writeMigrationDirectory writes a legacy, an unversioned, a current, and an
+// invalid document into a new directory
+func writeMigrationDirectory(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"a-legacy.vex.json": `{
+			"@context": "https://openvex.dev/ns/v0.0.1",
+			"@id": "legacy",
+			"author": "team",
+			"version": "1",
+			"timestamp": "2023-01-01T00:00:00Z",
+			"statements": [{"vulnerability": "CVE-2023-0001", "products": ["pkg:npm/a@1.0.0"], "status": "fixed"}]
+		}`,
+		"b-unversioned.vex.json": `{
+			"@context": "https://openvex.dev/ns",
+			"@id": "unversioned",
+			"author": "team",
+			"version": 1,
+			"timestamp": "2023-01-01T00:00:00Z",
+			"statements": [{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/b@1.0.0"}], "status": "fixed"}]
+		}`,
+		"c-current.vex.json": `{
+			"@context": "https://openvex.dev/ns/v0.2.0",
+			"@id": "current",
+			"author": "team",
+			"version": 1,
+			"timestamp": "2023-01-01T00:00:00Z",
+			"statements": [{"vulnerability": {"name": "CVE-2023-0003"}, "products": [{"@id": "pkg:npm/c@1.0.0"}], "status": "fixed"}]
+		}`,
+		"d-invalid.vex.json": `{"@context": "https://example.com/other"}`,
+	}
+	for name, doc := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(doc), 0o600); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+	return dir
+}
+
+func TestMigrateDirectory_InPlace(t *testing.T) {
+	dir := writeMigrationDirectory(t)
+	client := NewClient("test-author")
+
+	report, err := client.MigrateDirectory(dir, "", "")
+	if err != nil {
+		t.Fatalf("MigrateDirectory() error = %v", err)
+	}
+	if report.TargetContext != vexlib.ContextLocator() {
+		t.Errorf("TargetContext = %v, want %v", report.TargetContext, vexlib.ContextLocator())
+	}
+	if report.Migrated != 2 || report.Failed != 1 || len(report.Files) != 4 {
+		t.Fatalf("MigrateDirectory() report = %+v, want 2 migrated and 1 failed of 4", report)
+	}
+
+	want := map[string]bool{"a-legacy.vex.json": true, "b-unversioned.vex.json": true, "c-current.vex.json": false}
+	for _, file := range report.Files[:3] {
+		if file.Migrated != want[file.File] {
+			t.Errorf("%s migrated = %v, want %v", file.File, file.Migrated, want[file.File])
+		}
+		doc, err := vexlib.Open(filepath.Join(dir, file.File))
+		if err != nil {
+			t.Fatalf("failed to reopen %s: %v", file.File, err)
+		}
+		if doc.Context != vexlib.ContextLocator() || len(doc.Statements) != 1 {
+			t.Errorf("%s was not migrated: context %v, %d statements", file.File, doc.Context, len(doc.Statements))
+		}
+	}
+	if report.Files[0].FromContext != legacyContextLocator {
+		t.Errorf("legacy FromContext = %v, want %v", report.Files[0].FromContext, legacyContextLocator)
+	}
+	if report.Files[3].Error == "" {
+		t.Error("Expected an error for the non-OpenVEX document")
+	}
+}
+
+func TestMigrateDirectory_OutputDir(t *testing.T) {
+	dir := writeMigrationDirectory(t)
+	client := NewClient("test-author")
+
+	report, err := client.MigrateDirectory(dir, vexlib.Context, "migrated")
+	if err != nil {
+		t.Fatalf("MigrateDirectory() error = %v", err)
+	}
+	if report.Failed != 1 {
+		t.Errorf("Failed = %d, want 1", report.Failed)
+	}
+
+	// Every readable document is written, the sources are untouched
+	for _, name := range []string{"a-legacy.vex.json", "b-unversioned.vex.json", "c-current.vex.json"} {
+		data, err := os.ReadFile(filepath.Join(dir, "migrated", name))
+		if err != nil {
+			t.Fatalf("expected %s in output directory: %v", name, err)
+		}
+		if !strings.Contains(string(data), `"@context": "https://openvex.dev/ns"`) {
+			t.Errorf("%s not migrated to the unversioned context: %s", name, data)
+		}
+	}
+	source, _ := os.ReadFile(filepath.Join(dir, "c-current.vex.json"))
+	if !strings.Contains(string(source), "v0.2.0") {
+		t.Error("Source document should not be modified when writing to an output directory")
+	}
+}
+
+func TestMigrateDirectory_OutputSymlink(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "docs")
+	if err := os.MkdirAll(filepath.Join(dir, "migrated"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	source := `{"@context": "https://openvex.dev/ns/v0.2.0", "@id": "current", "author": "team", "version": 1, "timestamp": "2023-01-01T00:00:00Z", "statements": []}`
+	if err := os.WriteFile(filepath.Join(dir, "a.vex.json"), []byte(source), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		target string
+	}{
+		{"outside the file root", filepath.Join(t.TempDir(), "outside.json")},
+		{"inside the file root", filepath.Join(root, "inside.json")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := os.WriteFile(tt.target, []byte("original"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			link := filepath.Join(dir, "migrated", "a.vex.json")
+			os.Remove(link)
+			if err := os.Symlink(tt.target, link); err != nil {
+				t.Skipf("symlinks not supported: %v", err)
+			}
+
+			client := NewClient("test-author", WithFileRoot(root))
+			report, err := client.MigrateDirectory(dir, vexlib.Context, "migrated")
+			if err != nil {
+				t.Fatalf("MigrateDirectory() error = %v", err)
+			}
+			if report.Failed != 1 || report.Files[0].Error == "" {
+				t.Errorf("expected the symlinked output to fail, got %+v", report.Files)
+			}
+			data, _ := os.ReadFile(tt.target)
+			if string(data) != "original" {
+				t.Errorf("symlink target was overwritten: %s", data)
+			}
+		})
+	}
+}
+
+func TestMigrateDirectory_KeepsExtensions(t *testing.T) {
+	dir := t.TempDir()
+	doc := `{
+		"@context": "https://openvex.dev/ns/v0.0.1",
+		"@id": "legacy",
+		"author": "team",
+		"version": "1",
+		"timestamp": "2023-01-01T00:00:00Z",
+		"labels": {"team": "payments"},
+		"statements": [{
+			"vulnerability": "CVE-2023-0001",
+			"vuln_description": "Prototype pollution",
+			"products": ["pkg:npm/a@1.0.0"],
+			"subcomponents": ["pkg:npm/b@1.0.0"],
+			"status": "fixed",
+			"cvss": {"score": 7.5}
+		}]
+	}`
+	path := filepath.Join(dir, "legacy.vex.json")
+	if err := os.WriteFile(path, []byte(doc), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := NewClient("test-author").MigrateDirectory(dir, "", "")
+	if err != nil {
+		t.Fatalf("MigrateDirectory() error = %v", err)
+	}
+	if report.Migrated != 1 {
+		t.Fatalf("MigrateDirectory() report = %+v, want 1 migrated", report)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw := decodeDocument(t, string(data))
+	labels, _ := raw["labels"].(map[string]interface{})
+	if labels["team"] != "payments" {
+		t.Errorf("labels = %v, want the document labels kept", raw["labels"])
+	}
+	statements, _ := raw["statements"].([]interface{})
+	if len(statements) != 1 {
+		t.Fatalf("got %d statements, want 1", len(statements))
+	}
+	stmt := statements[0].(map[string]interface{})
+	cvss, _ := stmt["cvss"].(map[string]interface{})
+	if cvss["score"] != 7.5 {
+		t.Errorf("statement cvss = %v, want the statement extension kept", cvss)
+	}
+
+	// Legacy fields are converted, not carried over as extensions
+	for _, legacy := range []string{"vuln_description", "subcomponents"} {
+		if value, ok := stmt[legacy]; ok {
+			t.Errorf("statement %s = %v, want the legacy field dropped", legacy, value)
+		}
+	}
+	vulnerability, _ := stmt["vulnerability"].(map[string]interface{})
+	if vulnerability["description"] != "Prototype pollution" {
+		t.Errorf("statement vulnerability = %v, want the converted description", stmt["vulnerability"])
+	}
+	products, _ := stmt["products"].([]interface{})
+	if len(products) != 1 {
+		t.Fatalf("statement products = %v, want 1", stmt["products"])
+	}
+	subcomponents, _ := products[0].(map[string]interface{})["subcomponents"].([]interface{})
+	if len(subcomponents) != 1 || subcomponents[0].(map[string]interface{})["@id"] != "pkg:npm/b@1.0.0" {
+		t.Errorf("product subcomponents = %v, want the converted subcomponent", subcomponents)
+	}
+}
+
+func TestMigrateDirectory_ValidationErrors(t *testing.T) {
+	client := NewClient("test-author", WithMaxDirectoryFiles(2))
+	dir := writeMigrationDirectory(t)
+
+	tests := []struct {
+		name            string
+		dir             string
+		targetContext   string
+		outputDir       string
+		wantErrContains string
+	}{
+		{name: "missing directory", wantErrContains: "directory is required"},
+		{name: "unsupported context", dir: dir, targetContext: "https://openvex.dev/ns/v0.0.1", wantErrContains: "target_context must be one of"},
+		{name: "absolute output", dir: dir, outputDir: "/tmp/out", wantErrContains: "output_dir must be a relative path"},
+		{name: "escaping output", dir: dir, outputDir: "../out", wantErrContains: "output_dir must not leave"},
+		{name: "too many files", dir: dir, wantErrContains: "maximum is 2"},
+		{name: "empty directory", dir: t.TempDir(), wantErrContains: "no VEX documents found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.MigrateDirectory(tt.dir, tt.targetContext, tt.outputDir)
+			if err == nil {
+				t.Fatal("MigrateDirectory() expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.wantErrContains) {
+				t.Errorf("MigrateDirectory() error = %v, want to contain %v", err, tt.wantErrContains)
+			}
+		})
+	}
+}
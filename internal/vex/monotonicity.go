@@ -0,0 +1,71 @@
+package vex
+
+import (
+	"fmt"
+	"time"
+)
+
+// TimestampOrderIssue is a timestamp that is out of order with another
+type TimestampOrderIssue struct {
+	Location string `json:"location"`
+	Value    string `json:"value"`
+	Reason   string `json:"reason"`
+}
+
+// MonotonicityReport lists the out-of-order timestamps of a document.
+// Monotonic is true when there are none.
+type MonotonicityReport struct {
+	Monotonic bool                  `json:"monotonic"`
+	Issues    []TimestampOrderIssue `json:"issues"`
+}
+
+// CheckTimestampMonotonicity reports timestamps that run backwards: a
+// last_updated earlier than the timestamp it updates, and statement
+// timestamps later than the document was last modified (the later of its
+// timestamp and last_updated). Edits through the statement tools keep these
+// in order, so a violation points at a hand-edited or mis-merged document.
+// Malformed timestamps are reported by CheckTimestamps instead.
+func (c *Client) CheckTimestampMonotonicity(raw map[string]interface{}) (*MonotonicityReport, error) {
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &MonotonicityReport{Issues: []TimestampOrderIssue{}}
+	add := func(location string, ts *time.Time, reason string) {
+		report.Issues = append(report.Issues, TimestampOrderIssue{
+			Location: location,
+			Value:    ts.Format(time.RFC3339),
+			Reason:   reason,
+		})
+	}
+
+	if doc.LastUpdated != nil && doc.Timestamp != nil && doc.LastUpdated.Before(*doc.Timestamp) {
+		add("last_updated", doc.LastUpdated, fmt.Sprintf("precedes the document timestamp %s", doc.Timestamp.Format(time.RFC3339)))
+	}
+	modified, modifiedField := doc.Timestamp, "timestamp"
+	if doc.LastUpdated != nil && (modified == nil || doc.LastUpdated.After(*modified)) {
+		modified, modifiedField = doc.LastUpdated, "last_updated"
+	}
+
+	for i, stmt := range doc.Statements {
+		location := fmt.Sprintf("statements[%d]", i)
+		if stmt.LastUpdated != nil && stmt.Timestamp != nil && stmt.LastUpdated.Before(*stmt.Timestamp) {
+			add(location+".last_updated", stmt.LastUpdated, fmt.Sprintf("precedes the statement timestamp %s", stmt.Timestamp.Format(time.RFC3339)))
+		}
+		if modified == nil {
+			continue
+		}
+		for _, field := range []struct {
+			name string
+			ts   *time.Time
+		}{{"timestamp", stmt.Timestamp}, {"last_updated", stmt.LastUpdated}} {
+			if field.ts != nil && field.ts.After(*modified) {
+				add(location+"."+field.name, field.ts, fmt.Sprintf("is later than the document %s %s", modifiedField, modified.Format(time.RFC3339)))
+			}
+		}
+	}
+
+	report.Monotonic = len(report.Issues) == 0
+	return report, nil
+}
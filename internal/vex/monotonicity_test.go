@@ -0,0 +1,74 @@
+package vex
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckTimestampMonotonicity(t *testing.T) {
+	client := NewClient("test-author")
+
+	tests := []struct {
+		name          string
+		doc           string
+		wantLocations []string
+	}{
+		{
+			name: "monotonic",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"timestamp": "2023-01-01T00:00:00Z",
+				"last_updated": "2023-03-01T00:00:00Z",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed", "timestamp": "2023-01-01T00:00:00Z", "last_updated": "2023-02-01T00:00:00Z"},
+					{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed", "timestamp": "2023-03-01T00:00:00Z"},
+					{"vulnerability": {"name": "CVE-2023-0003"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"}
+				]
+			}`,
+			wantLocations: []string{},
+		},
+		{
+			name: "violations",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"timestamp": "2023-02-01T00:00:00Z",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed", "timestamp": "2023-01-15T00:00:00Z", "last_updated": "2023-01-01T00:00:00Z"},
+					{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed", "timestamp": "2023-03-01T00:00:00Z"}
+				]
+			}`,
+			wantLocations: []string{"statements[0].last_updated", "statements[1].timestamp"},
+		},
+		{
+			name: "document last_updated before timestamp",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"timestamp": "2023-02-01T00:00:00Z",
+				"last_updated": "2023-01-01T00:00:00Z",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed", "timestamp": "2023-01-15T00:00:00Z"}
+				]
+			}`,
+			wantLocations: []string{"last_updated"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := client.CheckTimestampMonotonicity(decodeDocument(t, tt.doc))
+			if err != nil {
+				t.Fatalf("CheckTimestampMonotonicity() error = %v", err)
+			}
+			locations := []string{}
+			for _, issue := range report.Issues {
+				locations = append(locations, issue.Location)
+			}
+			if !reflect.DeepEqual(locations, tt.wantLocations) {
+				t.Errorf("CheckTimestampMonotonicity() locations = %v, want %v (issues %+v)", locations, tt.wantLocations, report.Issues)
+			}
+			if report.Monotonic != (len(tt.wantLocations) == 0) {
+				t.Errorf("CheckTimestampMonotonicity() monotonic = %v", report.Monotonic)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package vex
+
+import (
+	"regexp"
+	"strings"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// Recognized vulnerability identifier formats, matched case-insensitively
+var (
+	cveIDPattern  = regexp.MustCompile(`(?i)^cve-\d{4}-\d{4,}$`)
+	ghsaIDPattern = regexp.MustCompile(`(?i)^ghsa(-[23456789cfghjmpqrvwx]{4}){3}$`)
+)
+
+// NormalizeVulnerabilityID returns the canonical form of a CVE or GHSA
+// identifier: CVE IDs are uppercased (CVE-2023-1234) and GHSA IDs get an
+// uppercase prefix with a lowercase body (GHSA-xxxx-xxxx-xxxx), as GitHub
+// publishes them. Other identifiers are returned unchanged.
+func NormalizeVulnerabilityID(id string) string {
+	switch {
+	case cveIDPattern.MatchString(id):
+		return strings.ToUpper(id)
+	case ghsaIDPattern.MatchString(id):
+		return "GHSA" + strings.ToLower(id[len("ghsa"):])
+	default:
+		return id
+	}
+}
+
+// NormalizeIDs rewrites the vulnerability names and aliases of a document
+// to their canonical form, returning the document and the number of
+// identifiers changed. Document and statement extension fields are
+// preserved.
+func (c *Client) NormalizeIDs(raw map[string]interface{}) (*Document, int, error) {
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	changed := 0
+	normalize := func(id vexlib.VulnerabilityID) vexlib.VulnerabilityID {
+		normalized := vexlib.VulnerabilityID(NormalizeVulnerabilityID(string(id)))
+		if normalized != id {
+			changed++
+		}
+		return normalized
+	}
+	for i := range doc.Statements {
+		vuln := &doc.Statements[i].Vulnerability
+		vuln.Name = normalize(vuln.Name)
+		if len(vuln.Aliases) > 0 {
+			aliases := make([]vexlib.VulnerabilityID, 0, len(vuln.Aliases))
+			for _, alias := range vuln.Aliases {
+				aliases = append(aliases, normalize(alias))
+			}
+			vuln.Aliases = aliases
+		}
+	}
+
+	result := documentWithExtensions(doc, raw)
+	return result, changed, nil
+}
@@ -0,0 +1,94 @@
+package vex
+
+import (
+	"testing"
+)
+
+func TestNormalizeVulnerabilityID(t *testing.T) {
+	tests := []struct {
+		id   string
+		want string
+	}{
+		{id: "cve-2023-1234", want: "CVE-2023-1234"},
+		{id: "Cve-2021-44228", want: "CVE-2021-44228"},
+		{id: "CVE-2023-1234", want: "CVE-2023-1234"},
+		{id: "ghsa-jfh8-c2jp-5v3q", want: "GHSA-jfh8-c2jp-5v3q"},
+		{id: "GHSA-JFH8-C2JP-5V3Q", want: "GHSA-jfh8-c2jp-5v3q"},
+		{id: "pysec-2021-123", want: "pysec-2021-123"},
+		{id: "RUSTSEC-2021-0001", want: "RUSTSEC-2021-0001"},
+		{id: "cve-2023", want: "cve-2023"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			if got := NormalizeVulnerabilityID(tt.id); got != tt.want {
+				t.Errorf("NormalizeVulnerabilityID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeIDs(t *testing.T) {
+	client := NewClient("test-author")
+
+	doc, changed, err := client.NormalizeIDs(decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"labels": {"team": "payments"},
+		"statements": [
+			{"vulnerability": {"name": "cve-2023-1234", "aliases": ["ghsa-jfh8-c2jp-5v3q", "pysec-2021-123"]}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"},
+			{"vulnerability": {"name": "CVE-2023-5678"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed", "cvss": {"score": 7.5}, "notes": ["backported"]}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("NormalizeIDs() error = %v", err)
+	}
+	if changed != 2 {
+		t.Errorf("NormalizeIDs() changed = %d, want 2", changed)
+	}
+
+	vuln := doc.Statements[0].Vulnerability
+	if vuln.Name != "CVE-2023-1234" {
+		t.Errorf("name = %v, want CVE-2023-1234", vuln.Name)
+	}
+	if len(vuln.Aliases) != 2 || vuln.Aliases[0] != "GHSA-jfh8-c2jp-5v3q" || vuln.Aliases[1] != "pysec-2021-123" {
+		t.Errorf("aliases = %v, want normalized GHSA and untouched PYSEC", vuln.Aliases)
+	}
+	if doc.Extensions[LabelsExtension] == nil {
+		t.Error("extension fields should be preserved")
+	}
+	if doc.StatementExtensions[1][CVSSExtension] == nil || doc.StatementExtensions[1][NotesExtension] == nil {
+		t.Errorf("statement extension fields should be preserved, got %v", doc.StatementExtensions)
+	}
+}
+
+func TestCreateDocument_NormalizeIDs(t *testing.T) {
+	client := NewClient("test-author")
+
+	tests := []struct {
+		name          string
+		vulnerability string
+		normalize     bool
+		want          string
+	}{
+		{name: "lowercase CVE normalized", vulnerability: "cve-2023-1234", normalize: true, want: "CVE-2023-1234"},
+		{name: "non-CVE untouched", vulnerability: "pysec-2021-123", normalize: true, want: "pysec-2021-123"},
+		{name: "off by default", vulnerability: "cve-2023-1234", want: "cve-2023-1234"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := client.CreateDocument(&CreateInput{
+				Product:       "pkg:npm/lodash@4.17.21",
+				Vulnerability: tt.vulnerability,
+				Status:        "fixed",
+				NormalizeIDs:  tt.normalize,
+			})
+			if err != nil {
+				t.Fatalf("CreateDocument() error = %v", err)
+			}
+			if got := string(doc.Statements[0].Vulnerability.Name); got != tt.want {
+				t.Errorf("vulnerability = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package vex
+
+// DefaultMaxOutputBytes is the default cap on the size of a serialized
+// document returned to a client
+const DefaultMaxOutputBytes = 16 << 20
+
+// WithMaxOutputBytes overrides the cap on the size of serialized documents
+// returned to clients; non-positive values keep the default
+func WithMaxOutputBytes(max int) Option {
+	return func(c *Client) {
+		if max > 0 {
+			c.maxOutputBytes = max
+		}
+	}
+}
+
+// MaxOutputBytes returns the cap on the size of serialized documents
+// returned to clients
+func (c *Client) MaxOutputBytes() int {
+	return c.maxOutputBytes
+}
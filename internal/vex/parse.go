@@ -0,0 +1,94 @@
+package vex
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// parseDocument parses a raw JSON document into a go-vex document
+func parseDocument(raw map[string]interface{}) (*vexlib.VEX, error) {
+	return newDocumentParser().parse(raw)
+}
+
+// documentParser parses raw documents, memoizing by content hash so the same
+// document passed several times within one request is only parsed once. A
+// parser is meant to live for a single request.
+type documentParser struct {
+	cache map[[sha256.Size]byte]*vexlib.VEX
+}
+
+// newDocumentParser creates an empty request-scoped parser
+func newDocumentParser() *documentParser {
+	return &documentParser{cache: map[[sha256.Size]byte]*vexlib.VEX{}}
+}
+
+// parse returns the parsed document. Each call returns its own shallow copy
+// of the document and statement slice, so callers may assign metadata and
+// statement fields without affecting other results. Values they point to,
+// such as product slices and timestamps, are shared with every other result
+// for the same document and must not be modified in place.
+func (p *documentParser) parse(raw map[string]interface{}) (*vexlib.VEX, error) {
+	// Object keys are marshaled in sorted order, so equal documents hash equally
+	jsonBytes, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal document: %w", err)
+	}
+
+	key := sha256.Sum256(jsonBytes)
+	doc, ok := p.cache[key]
+	if !ok {
+		// Let go-vex validate the structure
+		doc, err = vexlib.Parse(jsonBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse document: %w", err)
+		}
+		if err := validateSubcomponents(doc); err != nil {
+			return nil, fmt.Errorf("validation error: %w", err)
+		}
+		p.cache[key] = doc
+	}
+
+	result := *doc
+	result.Statements = append([]vexlib.Statement(nil), doc.Statements...)
+	return &result, nil
+}
+
+// validateSubcomponents bounds the subcomponents nested in each product of
+// an untrusted document
+func validateSubcomponents(doc *vexlib.VEX) error {
+	for i, stmt := range doc.Statements {
+		for _, product := range stmt.Products {
+			if err := ValidateSubcomponentCount(len(product.Subcomponents)); err != nil {
+				return fmt.Errorf("statement %d %s: %w", i, product.Component.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// parseDocuments parses raw JSON documents, numbering them from 1 in errors
+func parseDocuments(raw []map[string]interface{}) ([]*vexlib.VEX, error) {
+	return parseDocumentsContext(context.Background(), raw)
+}
+
+// parseDocumentsContext is parseDocuments, stopping with ctx's error between
+// documents once ctx is done
+func parseDocumentsContext(ctx context.Context, raw []map[string]interface{}) ([]*vexlib.VEX, error) {
+	parser := newDocumentParser()
+	docs := make([]*vexlib.VEX, 0, len(raw))
+	for i, docData := range raw {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		doc, err := parser.parse(docData)
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", i+1, err)
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
@@ -0,0 +1,109 @@
+package vex
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestParseDocument(t *testing.T) {
+	doc, err := parseDocument(decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"@id": "doc1",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "fixed"}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("parseDocument() error = %v", err)
+	}
+	if doc.ID != "doc1" || len(doc.Statements) != 1 {
+		t.Errorf("parseDocument() = %+v, want doc1 with one statement", doc)
+	}
+}
+
+func TestParseDocument_Errors(t *testing.T) {
+	_, err := parseDocument(map[string]interface{}{"statements": "not-an-array"})
+	if err == nil || !strings.HasPrefix(err.Error(), "failed to parse document: ") {
+		t.Fatalf("parseDocument() error = %v, want parse error", err)
+	}
+	var typeErr *json.UnmarshalTypeError
+	if !errors.As(err, &typeErr) {
+		t.Errorf("parse error should wrap the JSON error, got %T", errors.Unwrap(err))
+	}
+
+	_, err = parseDocument(map[string]interface{}{"version": math.Inf(1)})
+	if err == nil || !strings.HasPrefix(err.Error(), "failed to marshal document: ") {
+		t.Fatalf("parseDocument() error = %v, want marshal error", err)
+	}
+	var valueErr *json.UnsupportedValueError
+	if !errors.As(err, &valueErr) {
+		t.Errorf("marshal error should wrap the JSON error, got %T", errors.Unwrap(err))
+	}
+
+	_, err = parseDocuments([]map[string]interface{}{{}, {"statements": 1}})
+	if err == nil || !strings.HasPrefix(err.Error(), "document 2: failed to parse document") {
+		t.Errorf("parseDocuments() error = %v, want error numbering document 2", err)
+	}
+}
+
+func TestDocumentParser_Memoizes(t *testing.T) {
+	raw := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "fixed"}
+		]
+	}`)
+	parser := newDocumentParser()
+
+	first, err := parser.parse(raw)
+	if err != nil {
+		t.Fatalf("parse() error = %v", err)
+	}
+	second, err := parser.parse(raw)
+	if err != nil {
+		t.Fatalf("parse() error = %v", err)
+	}
+	if len(parser.cache) != 1 {
+		t.Errorf("parser cache size = %d, want 1", len(parser.cache))
+	}
+
+	// Cached results must not share mutable state
+	first.ID = "changed"
+	first.Statements[0].Status = "affected"
+	if second.ID == "changed" || second.Statements[0].Status != "fixed" {
+		t.Error("modifying one parse result should not affect another")
+	}
+}
+
+func TestParseDocument_SubcomponentLimit(t *testing.T) {
+	document := func(count int) map[string]interface{} {
+		subcomponents := make([]interface{}, 0, count)
+		for i := 0; i < count; i++ {
+			subcomponents = append(subcomponents, map[string]interface{}{"@id": fmt.Sprintf("pkg:npm/dep%d@1.0.0", i)})
+		}
+		return map[string]interface{}{
+			"@context": "https://openvex.dev/ns",
+			"statements": []interface{}{
+				map[string]interface{}{
+					"vulnerability": map[string]interface{}{"name": "CVE-2023-1234"},
+					"products":      []interface{}{map[string]interface{}{"@id": "pkg:oci/app", "subcomponents": subcomponents}},
+					"status":        "fixed",
+				},
+			},
+		}
+	}
+
+	if _, err := parseDocument(document(MaxSubcomponents)); err != nil {
+		t.Fatalf("parseDocument() at the limit error = %v", err)
+	}
+
+	_, err := parseDocument(document(MaxSubcomponents + 1))
+	want := fmt.Sprintf("statement 0 pkg:oci/app: product has %d subcomponents, maximum is %d", MaxSubcomponents+1, MaxSubcomponents)
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("parseDocument() error = %v, want to contain %v", err, want)
+	}
+}
@@ -0,0 +1,272 @@
+package vex
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// PatchEntry holds the statements of one (vulnerability, product) pair.
+// Statements covering several products are split into one statement per
+// product. Product names the product as productKey does. Extensions holds
+// the extension fields of each statement, by position, and is omitted when no
+// statement has any. Removed entries carry no statements.
+type PatchEntry struct {
+	Vulnerability string                   `json:"vulnerability"`
+	Product       string                   `json:"product"`
+	Statements    []vexlib.Statement       `json:"statements,omitempty"`
+	Extensions    []map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// Patch is the set of changes turning a base document into a target document
+type Patch struct {
+	Metadata *vexlib.Metadata `json:"metadata,omitempty"`
+	Added    []PatchEntry     `json:"added"`
+	Removed  []PatchEntry     `json:"removed"`
+	Modified []PatchEntry     `json:"modified"`
+}
+
+// patchKey identifies a (vulnerability, product) pair
+type patchKey struct {
+	Vulnerability string
+	Product       string
+}
+
+// key returns the pair the entry applies to
+func (e PatchEntry) key() patchKey {
+	return patchKey{Vulnerability: e.Vulnerability, Product: e.Product}
+}
+
+// statementGroups is the statements of a document grouped by
+// (vulnerability, product), in order of first appearance
+type statementGroups struct {
+	keys    []patchKey
+	entries map[patchKey]PatchEntry
+}
+
+// remove drops a pair, so adding it again places it last rather than
+// emitting it twice
+func (g *statementGroups) remove(key patchKey) {
+	delete(g.entries, key)
+	for i, existing := range g.keys {
+		if existing == key {
+			g.keys = append(g.keys[:i], g.keys[i+1:]...)
+			return
+		}
+	}
+}
+
+// productKey names a product in a patch: its @id, or for a product without
+// one its identifiers and hashes, e.g. "purl=pkg:npm/a@1.0.0"
+func productKey(component *vexlib.Component) string {
+	if component.ID != "" {
+		return component.ID
+	}
+	return strings.Join(componentIdentifiers(component), " ")
+}
+
+// groupStatementsByProduct splits statements into per-product statements
+// grouped by (vulnerability, product). Each split statement keeps the
+// extensions of its source statement, keyed by statement index.
+func groupStatementsByProduct(statements []vexlib.Statement, extensions map[int]map[string]interface{}) *statementGroups {
+	groups := &statementGroups{entries: map[patchKey]PatchEntry{}}
+	for index, stmt := range statements {
+		products := stmt.Products
+		if len(products) == 0 {
+			products = []vexlib.Product{{}}
+		}
+		for _, product := range products {
+			split := stmt
+			name := productKey(&product.Component)
+			if name != "" {
+				split.Products = []vexlib.Product{product}
+			}
+
+			key := patchKey{Vulnerability: string(stmt.Vulnerability.Name), Product: name}
+			entry, seen := groups.entries[key]
+			if !seen {
+				groups.keys = append(groups.keys, key)
+				entry = PatchEntry{Vulnerability: key.Vulnerability, Product: key.Product}
+			}
+			entry.Statements = append(entry.Statements, split)
+			entry.Extensions = append(entry.Extensions, extensions[index])
+			groups.entries[key] = entry
+		}
+	}
+	for key, entry := range groups.entries {
+		if !hasStatementExtensions(entry.Extensions) {
+			entry.Extensions = nil
+			groups.entries[key] = entry
+		}
+	}
+	return groups
+}
+
+// hasStatementExtensions reports whether any statement has extension fields
+func hasStatementExtensions(extensions []map[string]interface{}) bool {
+	for _, fields := range extensions {
+		if len(fields) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// DiffDocuments computes the patch turning base into target. Statements are
+// compared per (vulnerability, product) pair; metadata is included when any
+// metadata field differs.
+func (c *Client) DiffDocuments(base, target map[string]interface{}) (*Patch, error) {
+	parser := newDocumentParser()
+	baseDoc, err := parser.parse(base)
+	if err != nil {
+		return nil, fmt.Errorf("base document: %w", err)
+	}
+	targetDoc, err := parser.parse(target)
+	if err != nil {
+		return nil, fmt.Errorf("target document: %w", err)
+	}
+
+	patch := &Patch{Added: []PatchEntry{}, Removed: []PatchEntry{}, Modified: []PatchEntry{}}
+	equal, err := jsonEqual(baseDoc.Metadata, targetDoc.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	if !equal {
+		metadata := targetDoc.Metadata
+		patch.Metadata = &metadata
+	}
+
+	baseGroups := groupStatementsByProduct(baseDoc.Statements, extractStatementExtensions(base))
+	targetGroups := groupStatementsByProduct(targetDoc.Statements, extractStatementExtensions(target))
+	for _, key := range baseGroups.keys {
+		if _, ok := targetGroups.entries[key]; !ok {
+			patch.Removed = append(patch.Removed, PatchEntry{Vulnerability: key.Vulnerability, Product: key.Product})
+		}
+	}
+	for _, key := range targetGroups.keys {
+		entry := targetGroups.entries[key]
+		baseEntry, ok := baseGroups.entries[key]
+		if !ok {
+			patch.Added = append(patch.Added, entry)
+			continue
+		}
+		equal, err := jsonEqual(baseEntry, entry)
+		if err != nil {
+			return nil, err
+		}
+		if !equal {
+			patch.Modified = append(patch.Modified, entry)
+		}
+	}
+	return patch, nil
+}
+
+// ApplyPatch applies a patch produced by DiffDocuments to base. Removed and
+// modified pairs must exist in base and added pairs must not. The result has
+// one statement per product and keeps the extension fields of base.
+func (c *Client) ApplyPatch(base, rawPatch map[string]interface{}) (*Document, error) {
+	doc, err := parseDocument(base)
+	if err != nil {
+		return nil, fmt.Errorf("base document: %w", err)
+	}
+	patch, err := decodePatch(rawPatch)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := groupStatementsByProduct(doc.Statements, extractStatementExtensions(base))
+	for _, entry := range patch.Removed {
+		key := entry.key()
+		if _, ok := groups.entries[key]; !ok {
+			return nil, fmt.Errorf("cannot remove %s for %q: not in base document", entry.Vulnerability, entry.Product)
+		}
+		groups.remove(key)
+	}
+	for _, entry := range patch.Modified {
+		key := entry.key()
+		if _, ok := groups.entries[key]; !ok {
+			return nil, fmt.Errorf("cannot modify %s for %q: not in base document", entry.Vulnerability, entry.Product)
+		}
+		groups.entries[key] = entry
+	}
+	for _, entry := range patch.Added {
+		key := entry.key()
+		if _, ok := groups.entries[key]; ok {
+			return nil, fmt.Errorf("cannot add %s for %q: already in base document", entry.Vulnerability, entry.Product)
+		}
+		groups.keys = append(groups.keys, key)
+		groups.entries[key] = entry
+	}
+
+	if patch.Metadata != nil {
+		doc.Metadata = *patch.Metadata
+	}
+	doc.Statements = []vexlib.Statement{}
+	var extensions []map[string]interface{}
+	for _, key := range groups.keys {
+		entry := groups.entries[key]
+		doc.Statements = append(doc.Statements, entry.Statements...)
+		for i := range entry.Statements {
+			var fields map[string]interface{}
+			if i < len(entry.Extensions) {
+				fields = entry.Extensions[i]
+			}
+			extensions = append(extensions, fields)
+		}
+	}
+	for i := range doc.Statements {
+		if err := doc.Statements[i].Validate(); err != nil {
+			return nil, fmt.Errorf("statement %d validation failed: %w", i, err)
+		}
+	}
+
+	result := NewDocument(doc)
+	for name, value := range ExtractExtensions(base) {
+		result.SetExtension(name, value)
+	}
+	for index, fields := range extensions {
+		for name, value := range fields {
+			result.SetStatementExtension(index, name, value)
+		}
+	}
+	return result, nil
+}
+
+// decodePatch decodes a patch from its JSON object form
+func decodePatch(raw map[string]interface{}) (*Patch, error) {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal patch: %w", err)
+	}
+	var patch Patch
+	if err := json.Unmarshal(data, &patch); err != nil {
+		return nil, fmt.Errorf("failed to parse patch: %w", err)
+	}
+	for _, entries := range [][]PatchEntry{patch.Added, patch.Modified} {
+		for _, entry := range entries {
+			if len(entry.Statements) == 0 {
+				return nil, fmt.Errorf("patch entry %s for %q has no statements", entry.Vulnerability, entry.Product)
+			}
+			if len(entry.Extensions) > len(entry.Statements) {
+				return nil, fmt.Errorf("patch entry %s for %q has more extensions than statements", entry.Vulnerability, entry.Product)
+			}
+		}
+	}
+	return &patch, nil
+}
+
+// jsonEqual reports whether two values marshal to the same JSON
+func jsonEqual(a, b interface{}) (bool, error) {
+	aBytes, err := json.Marshal(a)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal value: %w", err)
+	}
+	bBytes, err := json.Marshal(b)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal value: %w", err)
+	}
+	return bytes.Equal(aBytes, bBytes), nil
+}
@@ -0,0 +1,261 @@
+package vex
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// toRaw round-trips v through JSON into a generic object
+func toRaw(t *testing.T, v interface{}) map[string]interface{} {
+	t.Helper()
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("failed to unmarshal: %v", err)
+	}
+	return raw
+}
+
+func TestDiffAndApplyPatch_RoundTrip(t *testing.T) {
+	client := NewClient("test-author")
+
+	base := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"@id": "base",
+		"author": "team",
+		"version": 1,
+		"timestamp": "2023-01-01T00:00:00Z",
+		"labels": {"team": "platform"},
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "under_investigation"},
+			{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"},
+			{"vulnerability": {"name": "CVE-2023-0003"}, "products": [{"@id": "pkg:npm/b@1.0.0"}], "status": "fixed", "notes": ["patched upstream"]}
+		]
+	}`)
+	target := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"@id": "base",
+		"author": "team",
+		"version": 2,
+		"timestamp": "2023-01-01T00:00:00Z",
+		"labels": {"team": "platform"},
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "affected", "action_statement": "Upgrade to 1.0.1", "cvss": {"score": 7.5}},
+			{"vulnerability": {"name": "CVE-2023-0003"}, "products": [{"@id": "pkg:npm/b@1.0.0"}], "status": "fixed", "notes": ["patched upstream"]},
+			{"vulnerability": {"name": "CVE-2023-0004"}, "products": [{"@id": "pkg:npm/c@1.0.0"}], "status": "not_affected", "justification": "component_not_present"}
+		]
+	}`)
+
+	patch, err := client.DiffDocuments(base, target)
+	if err != nil {
+		t.Fatalf("DiffDocuments() error = %v", err)
+	}
+	if len(patch.Added) != 1 || patch.Added[0].Vulnerability != "CVE-2023-0004" {
+		t.Errorf("Added = %+v, want CVE-2023-0004", patch.Added)
+	}
+	if len(patch.Removed) != 1 || patch.Removed[0].Vulnerability != "CVE-2023-0002" {
+		t.Errorf("Removed = %+v, want CVE-2023-0002", patch.Removed)
+	}
+	if len(patch.Modified) != 1 || patch.Modified[0].Vulnerability != "CVE-2023-0001" {
+		t.Errorf("Modified = %+v, want CVE-2023-0001", patch.Modified)
+	}
+	if patch.Metadata == nil || patch.Metadata.Version != 2 {
+		t.Errorf("Metadata = %+v, want version 2", patch.Metadata)
+	}
+
+	applied, err := client.ApplyPatch(base, toRaw(t, patch))
+	if err != nil {
+		t.Fatalf("ApplyPatch() error = %v", err)
+	}
+
+	comparison, err := client.CompareDocuments(toRaw(t, applied), target)
+	if err != nil {
+		t.Fatalf("CompareDocuments() error = %v", err)
+	}
+	if !comparison.Equal {
+		t.Errorf("Applying the patch did not reproduce the target: %+v", comparison.Differences)
+	}
+	raw := toRaw(t, applied)
+	if _, ok := raw["labels"]; !ok {
+		t.Errorf("base document extensions should be kept, got %v", raw)
+	}
+	statements := raw["statements"].([]interface{})
+	if _, ok := statements[0].(map[string]interface{})["cvss"]; !ok {
+		t.Errorf("modified statement extensions should be applied, got %v", statements[0])
+	}
+	if _, ok := statements[1].(map[string]interface{})["notes"]; !ok {
+		t.Errorf("unchanged statement extensions should be kept, got %v", statements[1])
+	}
+
+	// Diffing the result against the target is empty
+	empty, err := client.DiffDocuments(toRaw(t, applied), target)
+	if err != nil {
+		t.Fatalf("DiffDocuments() error = %v", err)
+	}
+	if empty.Metadata != nil || len(empty.Added)+len(empty.Removed)+len(empty.Modified) != 0 {
+		t.Errorf("Expected empty patch, got %+v", empty)
+	}
+}
+
+func TestDiffDocuments_SplitsProducts(t *testing.T) {
+	client := NewClient("test-author")
+
+	base := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}, {"@id": "pkg:npm/b@1.0.0"}], "status": "fixed"}
+		]
+	}`)
+	target := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"}
+		]
+	}`)
+
+	patch, err := client.DiffDocuments(base, target)
+	if err != nil {
+		t.Fatalf("DiffDocuments() error = %v", err)
+	}
+	if len(patch.Removed) != 1 || patch.Removed[0].Product != "pkg:npm/b@1.0.0" {
+		t.Errorf("Removed = %+v, want only pkg:npm/b@1.0.0", patch.Removed)
+	}
+	if len(patch.Added)+len(patch.Modified) != 0 {
+		t.Errorf("Expected no added or modified pairs, got %+v", patch)
+	}
+}
+
+func TestDiffDocuments_SplitsProductsWithoutIDs(t *testing.T) {
+	client := NewClient("test-author")
+
+	base := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"identifiers": {"purl": "pkg:npm/a@1.0.0"}}, {"hashes": {"sha-256": "bb"}}], "status": "fixed"}
+		]
+	}`)
+	target := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"identifiers": {"purl": "pkg:npm/a@1.0.0"}}], "status": "fixed"}
+		]
+	}`)
+
+	patch, err := client.DiffDocuments(base, target)
+	if err != nil {
+		t.Fatalf("DiffDocuments() error = %v", err)
+	}
+	if len(patch.Removed) != 1 || patch.Removed[0].Product != "sha-256=bb" {
+		t.Errorf("Removed = %+v, want only sha-256=bb", patch.Removed)
+	}
+	if len(patch.Added)+len(patch.Modified) != 0 {
+		t.Errorf("Expected no added or modified pairs, got %+v", patch)
+	}
+
+	applied, err := client.ApplyPatch(base, toRaw(t, patch))
+	if err != nil {
+		t.Fatalf("ApplyPatch() error = %v", err)
+	}
+	if len(applied.Statements) != 1 || len(applied.Statements[0].Products) != 1 ||
+		applied.Statements[0].Products[0].Component.Identifiers["purl"] != "pkg:npm/a@1.0.0" {
+		t.Errorf("Statements = %+v, want one statement for pkg:npm/a@1.0.0", applied.Statements)
+	}
+}
+
+func TestApplyPatch_RemoveAndAddPair(t *testing.T) {
+	client := NewClient("test-author")
+	base := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "under_investigation"},
+			{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/b@1.0.0"}], "status": "fixed"}
+		]
+	}`)
+	pair := map[string]interface{}{"vulnerability": "CVE-2023-0001", "product": "pkg:npm/a@1.0.0"}
+	added := map[string]interface{}{
+		"vulnerability": "CVE-2023-0001",
+		"product":       "pkg:npm/a@1.0.0",
+		"statements": []interface{}{map[string]interface{}{
+			"vulnerability": map[string]interface{}{"name": "CVE-2023-0001"},
+			"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/a@1.0.0"}},
+			"status":        "fixed",
+		}},
+	}
+
+	applied, err := client.ApplyPatch(base, map[string]interface{}{
+		"removed": []interface{}{pair},
+		"added":   []interface{}{added},
+	})
+	if err != nil {
+		t.Fatalf("ApplyPatch() error = %v", err)
+	}
+	if len(applied.Statements) != 2 {
+		t.Fatalf("Statements length = %d, want 2: %+v", len(applied.Statements), applied.Statements)
+	}
+	if applied.Statements[1].Status != "fixed" {
+		t.Errorf("Re-added statement status = %v, want fixed", applied.Statements[1].Status)
+	}
+}
+
+func TestApplyPatch_Errors(t *testing.T) {
+	client := NewClient("test-author")
+	base := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"}
+		]
+	}`)
+	fixed := []interface{}{map[string]interface{}{
+		"vulnerability": map[string]interface{}{"name": "CVE-2023-0001"},
+		"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/a@1.0.0"}},
+		"status":        "fixed",
+	}}
+
+	tests := []struct {
+		name            string
+		patch           map[string]interface{}
+		wantErrContains string
+	}{
+		{
+			name:            "remove missing pair",
+			patch:           map[string]interface{}{"removed": []interface{}{map[string]interface{}{"vulnerability": "CVE-2023-9999", "product": "pkg:npm/a@1.0.0"}}},
+			wantErrContains: "cannot remove CVE-2023-9999",
+		},
+		{
+			name:            "modify missing pair",
+			patch:           map[string]interface{}{"modified": []interface{}{map[string]interface{}{"vulnerability": "CVE-2023-9999", "product": "pkg:npm/a@1.0.0", "statements": fixed}}},
+			wantErrContains: "cannot modify CVE-2023-9999",
+		},
+		{
+			name:            "add existing pair",
+			patch:           map[string]interface{}{"added": []interface{}{map[string]interface{}{"vulnerability": "CVE-2023-0001", "product": "pkg:npm/a@1.0.0", "statements": fixed}}},
+			wantErrContains: "already in base document",
+		},
+		{
+			name:            "more extensions than statements",
+			patch:           map[string]interface{}{"modified": []interface{}{map[string]interface{}{"vulnerability": "CVE-2023-0001", "product": "pkg:npm/a@1.0.0", "statements": fixed, "extensions": []interface{}{nil, nil}}}},
+			wantErrContains: "more extensions than statements",
+		},
+		{
+			name:            "entry without statements",
+			patch:           map[string]interface{}{"added": []interface{}{map[string]interface{}{"vulnerability": "CVE-2023-0002", "product": "pkg:npm/a@1.0.0"}}},
+			wantErrContains: "has no statements",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.ApplyPatch(base, tt.patch)
+			if err == nil {
+				t.Fatal("ApplyPatch() expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.wantErrContains) {
+				t.Errorf("ApplyPatch() error = %v, want to contain %v", err, tt.wantErrContains)
+			}
+		})
+	}
+}
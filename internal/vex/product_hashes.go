@@ -0,0 +1,100 @@
+package vex
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// hashHexLengths is the number of hex digits of a digest for each hash
+// algorithm OpenVEX defines
+var hashHexLengths = map[vexlib.Algorithm]int{
+	vexlib.MD5:        32,
+	vexlib.SHA1:       40,
+	vexlib.SHA256:     64,
+	vexlib.SHA384:     96,
+	vexlib.SHA512:     128,
+	vexlib.SHA3224:    56,
+	vexlib.SHA3256:    64,
+	vexlib.SHA3384:    96,
+	vexlib.SHA3512:    128,
+	vexlib.BLAKE2S256: 64,
+	vexlib.BLAKE2B256: 64,
+	vexlib.BLAKE2B512: 128,
+	vexlib.BLAKE3:     64,
+}
+
+// HashIssue describes a malformed product or subcomponent hash
+type HashIssue struct {
+	Statement int    `json:"statement"`
+	Product   string `json:"product"`
+	Algorithm string `json:"algorithm"`
+	Reason    string `json:"reason"`
+}
+
+// HashReport lists the malformed hashes of a document
+type HashReport struct {
+	Valid   bool        `json:"valid"`
+	Checked int         `json:"checked"`
+	Issues  []HashIssue `json:"issues"`
+}
+
+// CheckProductHashes checks every product and subcomponent hash of a
+// document: the algorithm must be one OpenVEX defines and the value a hex
+// digest of the algorithm's length. Hex digits may be in either case.
+func (c *Client) CheckProductHashes(raw map[string]interface{}) (*HashReport, error) {
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &HashReport{Issues: []HashIssue{}}
+	check := func(statement int, component vexlib.Component) {
+		algorithms := make([]string, 0, len(component.Hashes))
+		for algorithm := range component.Hashes {
+			algorithms = append(algorithms, string(algorithm))
+		}
+		sort.Strings(algorithms)
+
+		for _, algorithm := range algorithms {
+			report.Checked++
+			reason := hashIssue(vexlib.Algorithm(algorithm), string(component.Hashes[vexlib.Algorithm(algorithm)]))
+			if reason != "" {
+				report.Issues = append(report.Issues, HashIssue{
+					Statement: statement,
+					Product:   component.ID,
+					Algorithm: algorithm,
+					Reason:    reason,
+				})
+			}
+		}
+	}
+	for i, stmt := range doc.Statements {
+		for _, product := range stmt.Products {
+			check(i, product.Component)
+			for _, sub := range product.Subcomponents {
+				check(i, sub.Component)
+			}
+		}
+	}
+	report.Valid = len(report.Issues) == 0
+	return report, nil
+}
+
+// hashIssue returns why value is not a well-formed digest for algorithm,
+// or "" when it is
+func hashIssue(algorithm vexlib.Algorithm, value string) string {
+	length, ok := hashHexLengths[algorithm]
+	if !ok {
+		return "unknown hash algorithm"
+	}
+	if _, err := hex.DecodeString(value); err != nil || value == "" {
+		return "value is not hexadecimal"
+	}
+	if len(value) != length {
+		return fmt.Sprintf("value has %d hex digits, %s requires %d", len(value), algorithm, length)
+	}
+	return ""
+}
@@ -0,0 +1,70 @@
+package vex
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCheckProductHashes(t *testing.T) {
+	client := NewClient("test-author")
+	sha256 := strings.Repeat("a", 64)
+
+	tests := []struct {
+		name        string
+		doc         string
+		wantChecked int
+		wantIssues  []HashIssue
+	}{
+		{
+			name: "well-formed hashes",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0001"}, "status": "fixed", "products": [
+						{"@id": "pkg:oci/app", "hashes": {"sha-256": "` + sha256 + `", "sha1": "` + strings.Repeat("F", 40) + `"},
+						 "subcomponents": [{"@id": "pkg:npm/a@1.0.0", "hashes": {"sha-512": "` + strings.Repeat("0", 128) + `"}}]}
+					]}
+				]
+			}`,
+			wantChecked: 3,
+			wantIssues:  []HashIssue{},
+		},
+		{
+			name: "malformed hashes",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0001"}, "status": "fixed", "products": [{"@id": "pkg:oci/app", "hashes": {"sha-256": "` + sha256 + `"}}]},
+					{"vulnerability": {"name": "CVE-2023-0002"}, "status": "fixed", "products": [
+						{"@id": "pkg:oci/app", "hashes": {"sha-512": "` + sha256 + `", "md5": "not-hex", "crc32": "abcd1234"}}
+					]}
+				]
+			}`,
+			wantChecked: 4,
+			wantIssues: []HashIssue{
+				{Statement: 1, Product: "pkg:oci/app", Algorithm: "crc32", Reason: "unknown hash algorithm"},
+				{Statement: 1, Product: "pkg:oci/app", Algorithm: "md5", Reason: "value is not hexadecimal"},
+				{Statement: 1, Product: "pkg:oci/app", Algorithm: "sha-512", Reason: "value has 64 hex digits, sha-512 requires 128"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := client.CheckProductHashes(decodeDocument(t, tt.doc))
+			if err != nil {
+				t.Fatalf("CheckProductHashes() error = %v", err)
+			}
+			if report.Checked != tt.wantChecked {
+				t.Errorf("CheckProductHashes() checked = %d, want %d", report.Checked, tt.wantChecked)
+			}
+			if !reflect.DeepEqual(report.Issues, tt.wantIssues) {
+				t.Errorf("CheckProductHashes() issues = %+v, want %+v", report.Issues, tt.wantIssues)
+			}
+			if report.Valid != (len(tt.wantIssues) == 0) {
+				t.Errorf("CheckProductHashes() valid = %v", report.Valid)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package vex
+
+import (
+	"sort"
+	"strings"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// componentIdentifiers lists the identifiers and hashes of a component as
+// type=value pairs: the purl first, then the other identifiers and the
+// hashes, each in sorted order
+func componentIdentifiers(component *vexlib.Component) []string {
+	var parts []string
+	if purl, ok := component.Identifiers[vexlib.PURL]; ok {
+		parts = append(parts, string(vexlib.PURL)+"="+purl)
+	}
+
+	types := make([]string, 0, len(component.Identifiers))
+	for identifierType := range component.Identifiers {
+		if identifierType != vexlib.PURL {
+			types = append(types, string(identifierType))
+		}
+	}
+	sort.Strings(types)
+	for _, identifierType := range types {
+		parts = append(parts, identifierType+"="+component.Identifiers[vexlib.IdentifierType(identifierType)])
+	}
+
+	algorithms := make([]string, 0, len(component.Hashes))
+	for algorithm := range component.Hashes {
+		algorithms = append(algorithms, string(algorithm))
+	}
+	sort.Strings(algorithms)
+	for _, algorithm := range algorithms {
+		parts = append(parts, algorithm+"="+string(component.Hashes[vexlib.Algorithm(algorithm)]))
+	}
+	return parts
+}
+
+// componentKey orders components by @id, falling back to their identifiers
+// and hashes so components without an @id still sort deterministically
+func componentKey(component *vexlib.Component) string {
+	return strings.Join(append([]string{component.ID}, componentIdentifiers(component)...), "\x00")
+}
+
+// SortProducts sorts the products of each statement, and the subcomponents
+// of each product, by componentKey so documents diff stably. It returns the
+// number of statements whose ordering changed.
+func SortProducts(statements []vexlib.Statement) int {
+	changed := 0
+	for i := range statements {
+		products := statements[i].Products
+		byID := func(a, b int) bool {
+			return componentKey(&products[a].Component) < componentKey(&products[b].Component)
+		}
+		reordered := !sort.SliceIsSorted(products, byID)
+		if reordered {
+			sort.SliceStable(products, byID)
+		}
+
+		for j := range products {
+			subcomponents := products[j].Subcomponents
+			subByID := func(a, b int) bool {
+				return componentKey(&subcomponents[a].Component) < componentKey(&subcomponents[b].Component)
+			}
+			if !sort.SliceIsSorted(subcomponents, subByID) {
+				reordered = true
+				sort.SliceStable(subcomponents, subByID)
+			}
+		}
+
+		if reordered {
+			changed++
+		}
+	}
+	return changed
+}
+
+// SortStatementProducts canonicalizes the product ordering of a document
+// with SortProducts, returning the document and the number of statements
+// reordered. Document and statement extension fields are preserved.
+func (c *Client) SortStatementProducts(raw map[string]interface{}) (*Document, int, error) {
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	changed := SortProducts(doc.Statements)
+
+	result := documentWithExtensions(doc, raw)
+	return result, changed, nil
+}
@@ -0,0 +1,117 @@
+package vex
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestSortStatementProducts(t *testing.T) {
+	client := NewClient("test-author")
+
+	raw := `{
+		"@context": "https://openvex.dev/ns",
+		"labels": {"team": "payments"},
+		"statements": [
+			{
+				"vulnerability": {"name": "CVE-2023-0001"},
+				"products": [
+					{"@id": "pkg:oci/web@sha256:bb", "subcomponents": [{"@id": "pkg:npm/zlib@1.0.0"}, {"@id": "pkg:npm/axios@1.0.0"}]},
+					{"@id": "pkg:oci/api@sha256:aa"}
+				],
+				"status": "fixed",
+				"notes": ["rebuilt"]
+			},
+			{
+				"vulnerability": {"name": "CVE-2023-0002"},
+				"products": [{"@id": "pkg:npm/a@1.0.0"}, {"@id": "pkg:npm/b@1.0.0"}],
+				"status": "fixed"
+			}
+		]
+	}`
+	shuffled := strings.NewReplacer(
+		`{"@id": "pkg:npm/a@1.0.0"}, {"@id": "pkg:npm/b@1.0.0"}`,
+		`{"@id": "pkg:npm/b@1.0.0"}, {"@id": "pkg:npm/a@1.0.0"}`,
+	).Replace(raw)
+
+	doc, changed, err := client.SortStatementProducts(decodeDocument(t, raw))
+	if err != nil {
+		t.Fatalf("SortStatementProducts() error = %v", err)
+	}
+	if changed != 1 {
+		t.Errorf("SortStatementProducts() changed = %d, want 1", changed)
+	}
+
+	var got []string
+	for _, p := range doc.Statements[0].Products {
+		got = append(got, p.Component.ID)
+	}
+	if want := "pkg:oci/api@sha256:aa,pkg:oci/web@sha256:bb"; strings.Join(got, ",") != want {
+		t.Errorf("products = %v, want %s", got, want)
+	}
+	got = nil
+	for _, s := range doc.Statements[0].Products[1].Subcomponents {
+		got = append(got, s.Component.ID)
+	}
+	if want := "pkg:npm/axios@1.0.0,pkg:npm/zlib@1.0.0"; strings.Join(got, ",") != want {
+		t.Errorf("subcomponents = %v, want %s", got, want)
+	}
+
+	// Any input ordering produces the same output
+	first, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, changed, err := client.SortStatementProducts(decodeDocument(t, shuffled))
+	if err != nil {
+		t.Fatalf("SortStatementProducts() error = %v", err)
+	}
+	if changed != 2 {
+		t.Errorf("SortStatementProducts() changed = %d, want 2", changed)
+	}
+	second, err := json.Marshal(again)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(first) != string(second) {
+		t.Errorf("ordering is not deterministic:\n%s\n%s", first, second)
+	}
+	if !strings.Contains(string(first), `"notes":["rebuilt"]`) || !strings.Contains(string(first), `"labels":{"team":"payments"}`) {
+		t.Errorf("extensions not preserved: %s", first)
+	}
+}
+
+func TestSortProducts_WithoutIDs(t *testing.T) {
+	doc := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"statements": [
+			{
+				"vulnerability": {"name": "CVE-2023-0001"},
+				"products": [
+					{"hashes": {"sha-256": "bb"}},
+					{"identifiers": {"purl": "pkg:npm/b@1.0.0"}},
+					{"@id": "pkg:npm/c@1.0.0"},
+					{"hashes": {"sha-256": "aa"}},
+					{"identifiers": {"purl": "pkg:npm/a@1.0.0"}}
+				],
+				"status": "fixed"
+			}
+		]
+	}`)
+	parsed, err := parseDocument(doc)
+	if err != nil {
+		t.Fatalf("parseDocument() error = %v", err)
+	}
+
+	if changed := SortProducts(parsed.Statements); changed != 1 {
+		t.Errorf("SortProducts() changed = %d, want 1", changed)
+	}
+	var got []string
+	for _, p := range parsed.Statements[0].Products {
+		got = append(got, p.Component.ID+string(p.Component.Hashes["sha-256"])+p.Component.Identifiers["purl"])
+	}
+	want := "pkg:npm/a@1.0.0,pkg:npm/b@1.0.0,aa,bb,pkg:npm/c@1.0.0"
+	if strings.Join(got, ",") != want {
+		t.Errorf("products = %v, want %s", got, want)
+	}
+}
@@ -0,0 +1,115 @@
+package vex
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// ProvenanceExtension is the extension field holding the merge history of a
+// document, oldest event first
+const ProvenanceExtension = "provenance"
+
+// ProvenanceEvent records a single merge: when it happened and the
+// identifiers of the documents it combined
+type ProvenanceEvent struct {
+	Timestamp time.Time `json:"timestamp"`
+	Sources   []string  `json:"sources"`
+}
+
+// documentProvenance returns the provenance chain carried by a raw document
+func documentProvenance(raw map[string]interface{}) ([]ProvenanceEvent, error) {
+	stored, ok := raw[ProvenanceExtension]
+	if !ok {
+		return nil, nil
+	}
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return nil, err
+	}
+	var events []ProvenanceEvent
+	if err := json.Unmarshal(data, &events); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// mergeProvenance builds the provenance chain of a merge of sources, whose
+// identifiers are sourceIDs: the chains the sources already carry, in order
+// and without repeating an event inherited through several of them, followed
+// by an event for this merge. Repeated merges thereby extend the history
+// rather than replace it.
+func mergeProvenance(sources []map[string]interface{}, sourceIDs []string, now time.Time) ([]ProvenanceEvent, error) {
+	var chain []ProvenanceEvent
+	seen := map[string]bool{}
+	for i, raw := range sources {
+		events, err := documentProvenance(raw)
+		if err != nil {
+			return nil, fmt.Errorf("document %d has an invalid %s extension: %w", i+1, ProvenanceExtension, err)
+		}
+		for _, event := range events {
+			key, err := json.Marshal(event)
+			if err != nil {
+				return nil, err
+			}
+			if seen[string(key)] {
+				continue
+			}
+			seen[string(key)] = true
+			chain = append(chain, event)
+		}
+	}
+	return append(chain, ProvenanceEvent{Timestamp: now.UTC(), Sources: sourceIDs}), nil
+}
+
+// documentIDs returns the identifier of each document, falling back to its
+// 1-based position for documents without one
+func documentIDs(docs []*vexlib.VEX) []string {
+	ids := make([]string, 0, len(docs))
+	for i, doc := range docs {
+		if doc.ID == "" {
+			ids = append(ids, fmt.Sprintf("document-%d", i+1))
+		} else {
+			ids = append(ids, doc.ID)
+		}
+	}
+	return ids
+}
+
+// SourceHashesExtension is the extension field holding the canonical hashes
+// of the documents a merge was built from
+const SourceHashesExtension = "source_hashes"
+
+// SourceHash is the canonical hash of one source document of a merge, at its
+// 1-based position among the sources. Hash is empty for a source without a
+// timestamp, which go-vex cannot hash.
+type SourceHash struct {
+	Document int    `json:"document"`
+	ID       string `json:"id,omitempty"`
+	Hash     string `json:"hash,omitempty"`
+}
+
+// sourceHashes returns the go-vex canonical hash of each document. The hash
+// covers the timestamp, version, author, and statements, so consumers can
+// verify which exact inputs produced a merge. Documents without a timestamp
+// are recorded without a hash rather than failing the merge.
+func sourceHashes(docs []*vexlib.VEX) ([]SourceHash, error) {
+	hashes := make([]SourceHash, 0, len(docs))
+	for i, doc := range docs {
+		if doc.Timestamp == nil {
+			hashes = append(hashes, SourceHash{Document: i + 1, ID: doc.ID})
+			continue
+		}
+		// CanonicalHash sorts the statements in place
+		hashed := *doc
+		hashed.Statements = append([]vexlib.Statement(nil), doc.Statements...)
+		hash, err := hashed.CanonicalHash()
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", i+1, err)
+		}
+		hashes = append(hashes, SourceHash{Document: i + 1, ID: doc.ID, Hash: "sha256:" + hash})
+	}
+	return hashes, nil
+}
@@ -0,0 +1,167 @@
+package vex
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMergeDocuments_ProvenanceChain(t *testing.T) {
+	client := NewClient("test-author")
+
+	create := func(vuln string) map[string]interface{} {
+		doc, err := client.CreateDocument(&CreateInput{
+			Product:       "pkg:npm/lodash@4.17.21",
+			Vulnerability: vuln,
+			Status:        "fixed",
+		})
+		if err != nil {
+			t.Fatalf("CreateDocument() error = %v", err)
+		}
+		return toRaw(t, doc)
+	}
+	merge := func(docs ...map[string]interface{}) map[string]interface{} {
+		merged, err := client.MergeDocuments(context.Background(), &MergeInput{Documents: docs, RecordProvenance: true})
+		if err != nil {
+			t.Fatalf("MergeDocuments() error = %v", err)
+		}
+		return toRaw(t, merged)
+	}
+	chain := func(raw map[string]interface{}) []ProvenanceEvent {
+		events, err := documentProvenance(raw)
+		if err != nil {
+			t.Fatalf("documentProvenance() error = %v", err)
+		}
+		return events
+	}
+
+	a, b, c := create("CVE-2023-0001"), create("CVE-2023-0002"), create("CVE-2023-0003")
+	first := merge(a, b)
+	events := chain(first)
+	if len(events) != 1 {
+		t.Fatalf("first merge provenance = %+v, want 1 event", events)
+	}
+	if want := []string{a["@id"].(string), b["@id"].(string)}; !reflect.DeepEqual(events[0].Sources, want) {
+		t.Errorf("first merge sources = %v, want %v", events[0].Sources, want)
+	}
+
+	// Merging an already-merged document extends its history
+	second := merge(first, c)
+	events = chain(second)
+	if len(events) != 2 {
+		t.Fatalf("second merge provenance = %+v, want 2 events", events)
+	}
+	if !reflect.DeepEqual(events[0], chain(first)[0]) {
+		t.Errorf("first event = %+v, want the inherited %+v", events[0], chain(first)[0])
+	}
+	if want := []string{first["@id"].(string), c["@id"].(string)}; !reflect.DeepEqual(events[1].Sources, want) {
+		t.Errorf("second merge sources = %v, want %v", events[1].Sources, want)
+	}
+
+	// An event inherited through several sources is recorded once
+	if events := chain(merge(second, first)); len(events) != 3 {
+		t.Errorf("provenance of merge sharing history = %+v, want 3 events", events)
+	}
+
+	// Without the option no provenance is recorded
+	plain, err := client.MergeDocuments(context.Background(), &MergeInput{Documents: []map[string]interface{}{a, b}})
+	if err != nil {
+		t.Fatalf("MergeDocuments() error = %v", err)
+	}
+	if _, ok := plain.Extensions[ProvenanceExtension]; ok {
+		t.Error("Provenance should only be recorded when requested")
+	}
+}
+
+func TestMergeDocuments_InvalidProvenance(t *testing.T) {
+	client := NewClient("test-author")
+
+	doc, err := client.CreateDocument(&CreateInput{
+		Product:       "pkg:npm/lodash@4.17.21",
+		Vulnerability: "CVE-2023-0001",
+		Status:        "fixed",
+	})
+	if err != nil {
+		t.Fatalf("CreateDocument() error = %v", err)
+	}
+	raw := toRaw(t, doc)
+	broken := toRaw(t, doc)
+	broken[ProvenanceExtension] = "not a chain"
+
+	_, err = client.MergeDocuments(context.Background(), &MergeInput{
+		Documents:        []map[string]interface{}{raw, broken},
+		RecordProvenance: true,
+	})
+	if err == nil {
+		t.Fatal("MergeDocuments() expected error for invalid provenance")
+	}
+	if want := "document 2 has an invalid provenance extension"; !strings.Contains(err.Error(), want) {
+		t.Errorf("MergeDocuments() error = %v, want to contain %v", err, want)
+	}
+}
+
+func TestMergeDocuments_SourceHashes(t *testing.T) {
+	client := NewClient("test-author")
+
+	var sources []map[string]interface{}
+	for _, vuln := range []string{"CVE-2023-0001", "CVE-2023-0002"} {
+		doc, err := client.CreateDocument(&CreateInput{
+			Product:       "pkg:npm/lodash@4.17.21",
+			Vulnerability: vuln,
+			Status:        "fixed",
+		})
+		if err != nil {
+			t.Fatalf("CreateDocument() error = %v", err)
+		}
+		sources = append(sources, toRaw(t, doc))
+	}
+
+	merged, err := client.MergeDocuments(context.Background(), &MergeInput{Documents: sources, IncludeSourceHashes: true})
+	if err != nil {
+		t.Fatalf("MergeDocuments() error = %v", err)
+	}
+	hashes, ok := toRaw(t, merged)[SourceHashesExtension].([]interface{})
+	if !ok || len(hashes) != len(sources) {
+		t.Fatalf("%s = %v, want one hash per source", SourceHashesExtension, hashes)
+	}
+	for i, source := range sources {
+		doc, err := parseDocument(source)
+		if err != nil {
+			t.Fatalf("parseDocument() error = %v", err)
+		}
+		want, err := doc.CanonicalHash()
+		if err != nil {
+			t.Fatalf("CanonicalHash() error = %v", err)
+		}
+		entry := hashes[i].(map[string]interface{})
+		if entry["hash"] != "sha256:"+want || entry["id"] != doc.ID || entry["document"] != float64(i+1) {
+			t.Errorf("source hash %d = %v, want hash sha256:%s of %s", i, entry, want, doc.ID)
+		}
+	}
+
+	// A source dated only on its statements is recorded without a hash
+	undated := toRaw(t, sources[1])
+	undated["statements"].([]interface{})[0].(map[string]interface{})["timestamp"] = undated["timestamp"]
+	delete(undated, "timestamp")
+	merged, err = client.MergeDocuments(context.Background(), &MergeInput{Documents: []map[string]interface{}{sources[0], undated}, IncludeSourceHashes: true})
+	if err != nil {
+		t.Fatalf("MergeDocuments() with an undated source error = %v", err)
+	}
+	hashes, _ = toRaw(t, merged)[SourceHashesExtension].([]interface{})
+	if len(hashes) != 2 {
+		t.Fatalf("%s = %v, want an entry per source", SourceHashesExtension, hashes)
+	}
+	if entry := hashes[1].(map[string]interface{}); entry["document"] != float64(2) || entry["hash"] != nil {
+		t.Errorf("undated source hash = %v, want document 2 without a hash", entry)
+	}
+
+	// Without the option no hashes are recorded
+	plain, err := client.MergeDocuments(context.Background(), &MergeInput{Documents: sources})
+	if err != nil {
+		t.Fatalf("MergeDocuments() error = %v", err)
+	}
+	if _, ok := plain.Extensions[SourceHashesExtension]; ok {
+		t.Error("Source hashes should only be recorded when requested")
+	}
+}
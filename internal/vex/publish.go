@@ -0,0 +1,63 @@
+package vex
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PublishReport is the result of the pre-publish gate. Ready is true when
+// there are no blocking issues.
+type PublishReport struct {
+	Ready  bool     `json:"ready"`
+	Issues []string `json:"issues"`
+}
+
+// CheckPublishReady composes the checks a document must pass before it is
+// published: author and timestamp are set, every statement's justification
+// fits its status (see justificationMismatch), every affected statement has an
+// action statement, and, unless allowInvestigations is set, no statement is
+// still under_investigation. Statement indices in issues are 0-based.
+func (c *Client) CheckPublishReady(raw map[string]interface{}, allowInvestigations bool) (*PublishReport, error) {
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &PublishReport{Issues: []string{}}
+	if strings.TrimSpace(doc.Author) == "" {
+		report.Issues = append(report.Issues, "author is required")
+	}
+	if doc.Timestamp == nil || doc.Timestamp.IsZero() {
+		report.Issues = append(report.Issues, "timestamp is required")
+	}
+
+	for i := range doc.Statements {
+		stmt := &doc.Statements[i]
+		if reason := justificationMismatch(stmt); reason != "" {
+			report.Issues = append(report.Issues, fmt.Sprintf("statement %d (%s): %s", i, stmt.Vulnerability.Name, reason))
+		}
+	}
+
+	actions, err := c.CheckActionsPresent(raw)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range actions.Missing {
+		report.Issues = append(report.Issues, fmt.Sprintf("statement %d (%s): affected requires an action statement",
+			i, doc.Statements[i].Vulnerability.Name))
+	}
+
+	if !allowInvestigations {
+		investigations, err := c.CheckNoOpenInvestigations(raw)
+		if err != nil {
+			return nil, err
+		}
+		for _, open := range investigations.Open {
+			report.Issues = append(report.Issues, fmt.Sprintf("statement %d (%s): still under_investigation",
+				open.Statement, open.Vulnerability))
+		}
+	}
+
+	report.Ready = len(report.Issues) == 0
+	return report, nil
+}
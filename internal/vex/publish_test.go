@@ -0,0 +1,97 @@
+package vex
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckPublishReady(t *testing.T) {
+	client := NewClient("test-author")
+
+	tests := []struct {
+		name                string
+		doc                 string
+		allowInvestigations bool
+		wantReady           bool
+		wantIssues          []string
+	}{
+		{
+			name: "publish ready",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"author": "security-team",
+				"timestamp": "2023-01-01T00:00:00Z",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "not_affected", "justification": "component_not_present"},
+					{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "affected", "action_statement": "Upgrade to 1.0.1"},
+					{"vulnerability": {"name": "CVE-2023-0003"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"}
+				]
+			}`,
+			wantReady:  true,
+			wantIssues: []string{},
+		},
+		{
+			name: "not ready",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "not_affected"},
+					{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "affected", "action_statement": "  "},
+					{"vulnerability": {"name": "CVE-2023-0003"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "under_investigation"}
+				]
+			}`,
+			wantReady: false,
+			wantIssues: []string{
+				"author is required",
+				"timestamp is required",
+				"statement 0 (CVE-2023-0001): not_affected requires either a 'justification' (one of: component_not_present, vulnerable_code_not_present, vulnerable_code_not_in_execute_path, vulnerable_code_cannot_be_controlled_by_adversary, inline_mitigations_already_exist) or a non-empty 'impact_statement'",
+				"statement 1 (CVE-2023-0002): affected requires an action statement",
+				"statement 2 (CVE-2023-0003): still under_investigation",
+			},
+		},
+		{
+			name: "justification on the wrong status",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"author": "security-team",
+				"timestamp": "2023-01-01T00:00:00Z",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed", "justification": "component_not_present"}
+				]
+			}`,
+			wantReady: false,
+			wantIssues: []string{
+				`statement 0 (CVE-2023-0001): justification "component_not_present" is only valid with status not_affected`,
+			},
+		},
+		{
+			name: "investigations allowed",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"author": "security-team",
+				"timestamp": "2023-01-01T00:00:00Z",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0003"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "under_investigation"}
+				]
+			}`,
+			allowInvestigations: true,
+			wantReady:           true,
+			wantIssues:          []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := client.CheckPublishReady(decodeDocument(t, tt.doc), tt.allowInvestigations)
+			if err != nil {
+				t.Fatalf("CheckPublishReady() error = %v", err)
+			}
+			if report.Ready != tt.wantReady {
+				t.Errorf("CheckPublishReady() Ready = %v, want %v", report.Ready, tt.wantReady)
+			}
+			if !reflect.DeepEqual(report.Issues, tt.wantIssues) {
+				t.Errorf("CheckPublishReady() Issues = %q, want %q", report.Issues, tt.wantIssues)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package vex
+
+import (
+	"fmt"
+	"strings"
+
+	packageurl "github.com/package-url/packageurl-go"
+)
+
+// PURLType returns the type of a package URL, e.g. "npm" for pkg:npm/lodash@4.17.21
+func PURLType(purl string) (string, error) {
+	parsed, err := ValidatePURL(purl, nil)
+	if err != nil {
+		return "", err
+	}
+	return strings.ToLower(parsed.Type), nil
+}
+
+// ValidatePURL parses a package URL, including its qualifiers and subpath,
+// and requires each of requiredQualifiers (e.g. arch for OS packages) to be
+// present with a value. Qualifier keys are case-insensitive.
+func ValidatePURL(purl string, requiredQualifiers []string) (packageurl.PackageURL, error) {
+	parsed, err := packageurl.FromString(purl)
+	if err != nil {
+		return packageurl.PackageURL{}, fmt.Errorf("invalid package URL: %w", err)
+	}
+	if parsed.Subpath != "" {
+		for _, segment := range strings.Split(parsed.Subpath, "/") {
+			if segment == "" || segment == "." || segment == ".." {
+				return packageurl.PackageURL{}, fmt.Errorf("invalid package URL subpath %q: segments must not be empty, '.' or '..'", parsed.Subpath)
+			}
+		}
+	}
+
+	qualifiers := parsed.Qualifiers.Map()
+	for _, key := range requiredQualifiers {
+		if qualifiers[strings.ToLower(key)] == "" {
+			return packageurl.PackageURL{}, fmt.Errorf("missing required qualifier %q", strings.ToLower(key))
+		}
+	}
+	return parsed, nil
+}
+
+// InconsistentProduct is a product whose PURL type differs from the expected one
+type InconsistentProduct struct {
+	Statement int    `json:"statement"`
+	Product   string `json:"product"`
+	Type      string `json:"type,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// PURLConsistencyReport is the result of a PURL type consistency check
+type PURLConsistencyReport struct {
+	Consistent   bool                  `json:"consistent"`
+	ExpectedType string                `json:"expected_type"`
+	Inconsistent []InconsistentProduct `json:"inconsistent"`
+}
+
+// CheckPURLConsistency reports the products of a document whose PURL type
+// differs from expectedType. Without an expected type, the type of the first
+// valid product PURL is expected. Products that are not valid PURLs, have an
+// invalid subpath, or lack one of requiredQualifiers are always reported.
+func (c *Client) CheckPURLConsistency(raw map[string]interface{}, expectedType string, requiredQualifiers []string) (*PURLConsistencyReport, error) {
+	if err := ValidateStringLength("type", expectedType, MaxLabelKeyLength); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	if err := validateQualifierKeys(requiredQualifiers); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &PURLConsistencyReport{
+		ExpectedType: strings.ToLower(expectedType),
+		Inconsistent: []InconsistentProduct{},
+	}
+	for i, stmt := range doc.Statements {
+		for _, product := range productIDs(stmt.Products) {
+			parsed, err := ValidatePURL(product, requiredQualifiers)
+			if err != nil {
+				report.Inconsistent = append(report.Inconsistent, InconsistentProduct{
+					Statement: i,
+					Product:   product,
+					Error:     err.Error(),
+				})
+				continue
+			}
+			purlType := strings.ToLower(parsed.Type)
+			if report.ExpectedType == "" {
+				report.ExpectedType = purlType
+			}
+			if purlType != report.ExpectedType {
+				report.Inconsistent = append(report.Inconsistent, InconsistentProduct{
+					Statement: i,
+					Product:   product,
+					Type:      purlType,
+				})
+			}
+		}
+	}
+
+	report.Consistent = len(report.Inconsistent) == 0
+	return report, nil
+}
+
+// validateQualifierKeys checks the names of required PURL qualifiers
+func validateQualifierKeys(keys []string) error {
+	if len(keys) > MaxLabels {
+		return &ValidationError{Field: "required_qualifiers", Reason: fmt.Sprintf("exceed the maximum of %d allowed", MaxLabels)}
+	}
+	for _, key := range keys {
+		if !packageurl.QualifierKeyPattern.MatchString(strings.ToLower(key)) {
+			return &ValidationError{Field: "required_qualifiers", Reason: fmt.Sprintf("%q is not a valid qualifier key", key)}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package vex
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPURLType(t *testing.T) {
+	tests := []struct {
+		purl    string
+		want    string
+		wantErr bool
+	}{
+		{purl: "pkg:npm/lodash@4.17.21", want: "npm"},
+		{purl: "pkg:npm/%40angular/core@16.0.0", want: "npm"},
+		{purl: "pkg:docker/nginx@1.20.1", want: "docker"},
+		{purl: "pkg:apk/wolfi/git@2.39.0-r1?arch=x86_64", want: "apk"},
+		{purl: "lodash", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.purl, func(t *testing.T) {
+			got, err := PURLType(tt.purl)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PURLType() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("PURLType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidatePURL(t *testing.T) {
+	tests := []struct {
+		name               string
+		purl               string
+		requiredQualifiers []string
+		wantErrContains    string
+	}{
+		{name: "qualifiers and subpath", purl: "pkg:rpm/fedora/curl@7.50.3-1.fc25?arch=i386&distro=fedora-25#docs/man"},
+		{name: "required qualifier present", purl: "pkg:deb/debian/curl@7.50.3-1?arch=amd64", requiredQualifiers: []string{"arch"}},
+		{name: "required qualifier case-insensitive", purl: "pkg:deb/debian/curl@7.50.3-1?arch=amd64", requiredQualifiers: []string{"ARCH"}},
+		{name: "required qualifier missing", purl: "pkg:deb/debian/curl@7.50.3-1?distro=bookworm", requiredQualifiers: []string{"arch"},
+			wantErrContains: `missing required qualifier "arch"`},
+		{name: "required qualifier without qualifiers", purl: "pkg:deb/debian/curl@7.50.3-1", requiredQualifiers: []string{"distro"},
+			wantErrContains: `missing required qualifier "distro"`},
+		{name: "invalid qualifier key", purl: "pkg:deb/debian/curl@7.50.3-1?1arch=amd64", wantErrContains: "invalid qualifiers"},
+		{name: "traversal in subpath", purl: "pkg:golang/example.com/mod@v1.0.0#pkg/../secret", wantErrContains: "subpath"},
+		{name: "leading dot segment in subpath", purl: "pkg:golang/example.com/mod@v1.0.0#../secret", wantErrContains: "subpath"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ValidatePURL(tt.purl, tt.requiredQualifiers)
+			if tt.wantErrContains == "" {
+				if err != nil {
+					t.Errorf("ValidatePURL() error = %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("ValidatePURL() expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.wantErrContains) {
+				t.Errorf("ValidatePURL() error = %v, want to contain %v", err, tt.wantErrContains)
+			}
+		})
+	}
+}
+
+func TestCheckPURLConsistency(t *testing.T) {
+	client := NewClient("test-author")
+
+	consistent := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}, {"@id": "pkg:npm/axios@1.0.0"}], "status": "fixed"}
+		]
+	}`)
+	mixed := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "fixed"},
+			{"vulnerability": {"name": "CVE-2023-5678"}, "products": [{"@id": "pkg:pypi/django@4.2.0"}, {"@id": "not-a-purl"}], "status": "fixed"}
+		]
+	}`)
+
+	qualified := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:deb/debian/curl@7.50.3-1?arch=amd64"}, {"@id": "pkg:deb/debian/curl@7.50.3-1?distro=bookworm"}], "status": "fixed"}
+		]
+	}`)
+
+	tests := []struct {
+		name               string
+		doc                map[string]interface{}
+		expectedType       string
+		requiredQualifiers []string
+		wantExpectedType   string
+		wantProducts       []string
+	}{
+		{name: "consistent with inferred type", doc: consistent, wantExpectedType: "npm"},
+		{name: "consistent with expected type", doc: consistent, expectedType: "NPM", wantExpectedType: "npm"},
+		{name: "consistent document, other expected type", doc: consistent, expectedType: "pypi", wantExpectedType: "pypi",
+			wantProducts: []string{"pkg:npm/lodash@4.17.21", "pkg:npm/axios@1.0.0"}},
+		{name: "mixed with inferred type", doc: mixed, wantExpectedType: "npm",
+			wantProducts: []string{"pkg:pypi/django@4.2.0", "not-a-purl"}},
+		{name: "required qualifiers", doc: qualified, requiredQualifiers: []string{"arch"}, wantExpectedType: "deb",
+			wantProducts: []string{"pkg:deb/debian/curl@7.50.3-1?distro=bookworm"}},
+		{name: "without required qualifiers", doc: qualified, wantExpectedType: "deb"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := client.CheckPURLConsistency(tt.doc, tt.expectedType, tt.requiredQualifiers)
+			if err != nil {
+				t.Fatalf("CheckPURLConsistency() error = %v", err)
+			}
+			if report.ExpectedType != tt.wantExpectedType {
+				t.Errorf("ExpectedType = %v, want %v", report.ExpectedType, tt.wantExpectedType)
+			}
+			if report.Consistent != (len(tt.wantProducts) == 0) {
+				t.Errorf("Consistent = %v, want %v", report.Consistent, len(tt.wantProducts) == 0)
+			}
+			if len(report.Inconsistent) != len(tt.wantProducts) {
+				t.Fatalf("Inconsistent = %+v, want %v", report.Inconsistent, tt.wantProducts)
+			}
+			for i, product := range tt.wantProducts {
+				if report.Inconsistent[i].Product != product {
+					t.Errorf("Inconsistent[%d] = %v, want %v", i, report.Inconsistent[i].Product, product)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckPURLConsistency_InvalidQualifierKey(t *testing.T) {
+	client := NewClient("test-author")
+	doc := decodeDocument(t, `{"@context": "https://openvex.dev/ns", "statements": []}`)
+
+	_, err := client.CheckPURLConsistency(doc, "", []string{"arch", "1bad"})
+	if err == nil || !strings.Contains(err.Error(), `required_qualifiers "1bad" is not a valid qualifier key`) {
+		t.Errorf("CheckPURLConsistency() error = %v, want invalid qualifier key", err)
+	}
+}
@@ -0,0 +1,47 @@
+package vex
+
+import (
+	"fmt"
+	"time"
+)
+
+// StatementAuthorExtension is the non-standard statement field some
+// producers use to attribute individual statements
+const StatementAuthorExtension = "author"
+
+// Reauthor rewrites the author of a document, and of any statement carrying
+// its own author field, returning the document with the number of locations
+// updated. A non-empty role replaces the document role. last_updated is
+// refreshed on the document and on each rewritten statement, and extension
+// fields are preserved.
+func (c *Client) Reauthor(raw map[string]interface{}, author, role string) (*Document, int, error) {
+	if err := ValidateRequired("author", author); err != nil {
+		return nil, 0, fmt.Errorf("validation error: %w", err)
+	}
+	if err := validateMergeMetadata(&MergeInput{Author: author, AuthorRole: role}); err != nil {
+		return nil, 0, err
+	}
+
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	now := time.Now()
+	doc.Author = author
+	if role != "" {
+		doc.AuthorRole = role
+	}
+	doc.LastUpdated = &now
+
+	result := documentWithExtensions(doc, raw)
+	updated := 1
+	for index, extensions := range result.StatementExtensions {
+		if _, ok := extensions[StatementAuthorExtension]; ok {
+			extensions[StatementAuthorExtension] = author
+			doc.Statements[index].LastUpdated = &now
+			updated++
+		}
+	}
+	return result, updated, nil
+}
@@ -0,0 +1,81 @@
+package vex
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestReauthor(t *testing.T) {
+	client := NewClient("test-author")
+	doc := `{
+		"@context": "https://openvex.dev/ns",
+		"@id": "https://example.com/vex/1",
+		"author": "old-team",
+		"role": "Maintainer",
+		"timestamp": "2023-01-01T00:00:00Z",
+		"version": 1,
+		"labels": {"team": "old"},
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed", "author": "old-team", "notes": ["patched"]},
+			{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"},
+			{"vulnerability": {"name": "CVE-2023-0003"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed", "author": "someone-else"}
+		]
+	}`
+
+	tests := []struct {
+		name            string
+		author          string
+		role            string
+		wantRole        string
+		wantUpdated     int
+		wantErrContains string
+	}{
+		{name: "author throughout", author: "new-team", wantRole: "Maintainer", wantUpdated: 3},
+		{name: "with role", author: "new-team", role: "Security", wantRole: "Security", wantUpdated: 3},
+		{name: "missing author", wantErrContains: "author is required"},
+		{name: "invalid author", author: "<script>", wantErrContains: "author contains potentially dangerous characters"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, updated, err := client.Reauthor(decodeDocument(t, doc), tt.author, tt.role)
+			if tt.wantErrContains != "" {
+				if err == nil {
+					t.Fatal("Reauthor() expected error")
+				}
+				if !strings.Contains(err.Error(), tt.wantErrContains) {
+					t.Errorf("Reauthor() error = %v, want to contain %v", err, tt.wantErrContains)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Reauthor() error = %v", err)
+			}
+			if updated != tt.wantUpdated {
+				t.Errorf("Reauthor() updated = %d, want %d", updated, tt.wantUpdated)
+			}
+			if result.Author != tt.author || result.AuthorRole != tt.wantRole {
+				t.Errorf("Reauthor() author = %q, role = %q", result.Author, result.AuthorRole)
+			}
+			if result.LastUpdated == nil {
+				t.Error("Reauthor() did not refresh last_updated")
+			}
+
+			data, err := json.Marshal(result)
+			if err != nil {
+				t.Fatalf("json.Marshal() error = %v", err)
+			}
+			output := string(data)
+			if strings.Contains(output, "old-team") || strings.Contains(output, "someone-else") {
+				t.Errorf("Reauthor() output still contains a previous author: %s", output)
+			}
+			if strings.Count(output, `"author":"`+tt.author+`"`) != 3 {
+				t.Errorf("Reauthor() output = %s, want author in 3 locations", output)
+			}
+			if !strings.Contains(output, `"notes":["patched"]`) || !strings.Contains(output, `"labels":{"team":"old"}`) {
+				t.Errorf("Reauthor() did not preserve extensions: %s", output)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package vex
+
+import (
+	"sort"
+	"time"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// Remediation is the action statement of a single affected statement
+type Remediation struct {
+	Vulnerability            string     `json:"vulnerability"`
+	Products                 []string   `json:"products"`
+	ActionStatement          string     `json:"action_statement"`
+	ActionStatementTimestamp *time.Time `json:"action_statement_timestamp,omitempty"`
+}
+
+// ListRemediations returns the remediation for every affected statement in a
+// document, sorted by vulnerability
+func (c *Client) ListRemediations(raw map[string]interface{}) ([]Remediation, error) {
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	remediations := []Remediation{}
+	for _, stmt := range doc.Statements {
+		if stmt.Status != vexlib.StatusAffected {
+			continue
+		}
+		remediations = append(remediations, Remediation{
+			Vulnerability:            string(stmt.Vulnerability.Name),
+			Products:                 productIDs(stmt.Products),
+			ActionStatement:          stmt.ActionStatement,
+			ActionStatementTimestamp: stmt.ActionStatementTimestamp,
+		})
+	}
+
+	sort.SliceStable(remediations, func(i, j int) bool {
+		return remediations[i].Vulnerability < remediations[j].Vulnerability
+	})
+	return remediations, nil
+}
+
+// productIDs returns the component IDs of products
+func productIDs(products []vexlib.Product) []string {
+	ids := make([]string, 0, len(products))
+	for _, p := range products {
+		ids = append(ids, p.Component.ID)
+	}
+	return ids
+}
@@ -0,0 +1,70 @@
+package vex
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestListRemediations(t *testing.T) {
+	client := NewClient("test-author")
+
+	docJSON := `{
+		"@context": "https://openvex.dev/ns",
+		"@id": "doc1",
+		"timestamp": "2023-01-01T00:00:00Z",
+		"statements": [
+			{
+				"vulnerability": {"name": "CVE-2023-9999"},
+				"products": [{"@id": "pkg:npm/axios@0.21.0"}],
+				"status": "affected",
+				"action_statement": "Upgrade axios to 1.0.0",
+				"action_statement_timestamp": "2023-02-01T00:00:00Z"
+			},
+			{
+				"vulnerability": {"name": "CVE-2023-1234"},
+				"products": [{"@id": "pkg:npm/lodash@4.17.21"}],
+				"status": "not_affected",
+				"justification": "component_not_present"
+			},
+			{
+				"vulnerability": {"name": "CVE-2023-5678"},
+				"products": [{"@id": "pkg:npm/express@4.18.0"}, {"@id": "pkg:npm/express@4.18.1"}],
+				"status": "affected",
+				"action_statement": "Upgrade express to 4.19.0"
+			}
+		]
+	}`
+	var docMap map[string]interface{}
+	json.Unmarshal([]byte(docJSON), &docMap)
+
+	remediations, err := client.ListRemediations(docMap)
+	if err != nil {
+		t.Fatalf("ListRemediations() error = %v", err)
+	}
+	if len(remediations) != 2 {
+		t.Fatalf("ListRemediations() length = %v, want 2", len(remediations))
+	}
+
+	first, second := remediations[0], remediations[1]
+	if first.Vulnerability != "CVE-2023-5678" || second.Vulnerability != "CVE-2023-9999" {
+		t.Errorf("remediations not sorted by vulnerability: %v, %v", first.Vulnerability, second.Vulnerability)
+	}
+	if len(first.Products) != 2 || first.ActionStatement != "Upgrade express to 4.19.0" {
+		t.Errorf("unexpected remediation %+v", first)
+	}
+	if first.ActionStatementTimestamp != nil {
+		t.Error("action timestamp should be omitted when absent")
+	}
+	if second.ActionStatementTimestamp == nil {
+		t.Error("action timestamp should be included when present")
+	}
+}
+
+func TestListRemediations_InvalidDocument(t *testing.T) {
+	client := NewClient("test-author")
+
+	_, err := client.ListRemediations(map[string]interface{}{"statements": "not-an-array"})
+	if err == nil {
+		t.Error("ListRemediations() expected error for invalid document")
+	}
+}
@@ -0,0 +1,147 @@
+package vex
+
+import (
+	"fmt"
+	"strings"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// affectedEntry collects the products and remediations stated for one
+// affected vulnerability
+type affectedEntry struct {
+	vulnerability string
+	products      []string
+	remediations  []string
+}
+
+// MarkdownReport renders a human-readable summary of one or more documents:
+// a status summary table, a section per affected vulnerability with its
+// products and remediation, and the not_affected justifications.
+func (c *Client) MarkdownReport(raw []map[string]interface{}) (string, error) {
+	if err := ValidateDocumentListCount(len(raw)); err != nil {
+		return "", fmt.Errorf("validation error: %w", err)
+	}
+
+	docs, err := parseDocuments(raw)
+	if err != nil {
+		return "", err
+	}
+
+	counts := map[vexlib.Status]int{}
+	var statements []vexlib.Statement
+	for _, doc := range docs {
+		for _, stmt := range doc.Statements {
+			counts[stmt.Status]++
+			statements = append(statements, stmt)
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("# VEX Report\n\n")
+	fmt.Fprintf(&b, "%d document(s), %d statement(s).\n\n", len(docs), len(statements))
+
+	b.WriteString("## Status Summary\n\n")
+	b.WriteString("| Status | Statements |\n")
+	b.WriteString("| --- | ---: |\n")
+	for _, status := range vexlib.Statuses() {
+		fmt.Fprintf(&b, "| %s | %d |\n", status, counts[vexlib.Status(status)])
+	}
+
+	b.WriteString("\n## Affected Vulnerabilities\n\n")
+	affected := affectedEntries(statements)
+	if len(affected) == 0 {
+		b.WriteString("No affected vulnerabilities.\n")
+	}
+	for _, entry := range affected {
+		fmt.Fprintf(&b, "### %s\n\n", entry.vulnerability)
+		fmt.Fprintf(&b, "- Products: %s\n", codeList(entry.products))
+		if len(entry.remediations) == 0 {
+			b.WriteString("- Remediation: none provided\n\n")
+			continue
+		}
+		for _, remediation := range entry.remediations {
+			fmt.Fprintf(&b, "- Remediation: %s\n", remediation)
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString("## Not Affected Justifications\n\n")
+	notAffected := 0
+	for _, stmt := range statements {
+		if stmt.Status != vexlib.StatusNotAffected {
+			continue
+		}
+		notAffected++
+		reason := string(stmt.Justification)
+		if impact := singleLine(stmt.ImpactStatement); impact != "" {
+			if reason == "" {
+				reason = impact
+			} else {
+				reason = fmt.Sprintf("%s (%s)", reason, impact)
+			}
+		}
+		fmt.Fprintf(&b, "- %s on %s: %s\n", stmt.Vulnerability.Name, codeList(productIDs(stmt.Products)), reason)
+	}
+	if notAffected == 0 {
+		b.WriteString("No not_affected statements.\n")
+	}
+
+	return b.String(), nil
+}
+
+// affectedEntries groups the affected statements by vulnerability, in order
+// of first appearance
+func affectedEntries(statements []vexlib.Statement) []*affectedEntry {
+	var entries []*affectedEntry
+	byVuln := map[string]*affectedEntry{}
+	for _, stmt := range statements {
+		if stmt.Status != vexlib.StatusAffected {
+			continue
+		}
+		vuln := string(stmt.Vulnerability.Name)
+		entry, ok := byVuln[vuln]
+		if !ok {
+			entry = &affectedEntry{vulnerability: vuln}
+			byVuln[vuln] = entry
+			entries = append(entries, entry)
+		}
+		entry.products = appendUnique(entry.products, productIDs(stmt.Products)...)
+		if action := singleLine(stmt.ActionStatement); action != "" {
+			entry.remediations = appendUnique(entry.remediations, action)
+		}
+	}
+	return entries
+}
+
+// appendUnique appends the values not already in list
+func appendUnique(list []string, values ...string) []string {
+	for _, value := range values {
+		found := false
+		for _, existing := range list {
+			if existing == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
+// codeList formats values as a comma-separated list of inline code spans
+func codeList(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		quoted = append(quoted, "`"+v+"`")
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// singleLine collapses whitespace, including newlines, so free text fits
+// on one Markdown line
+func singleLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
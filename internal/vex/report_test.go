@@ -0,0 +1,49 @@
+package vex
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMarkdownReport(t *testing.T) {
+	client := NewClient("test-author")
+	doc1 := `{
+		"@context": "https://openvex.dev/ns",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "affected", "action_statement": "Upgrade to\n1.0.1"},
+			{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "not_affected", "justification": "component_not_present"}
+		]
+	}`
+	doc2 := `{
+		"@context": "https://openvex.dev/ns",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/b@1.0.0"}], "status": "affected", "action_statement": "Upgrade to 1.0.1"},
+			{"vulnerability": {"name": "CVE-2023-0003"}, "products": [{"@id": "pkg:npm/b@1.0.0"}], "status": "fixed"}
+		]
+	}`
+
+	report, err := client.MarkdownReport([]map[string]interface{}{decodeDocument(t, doc1), decodeDocument(t, doc2)})
+	if err != nil {
+		t.Fatalf("MarkdownReport() error = %v", err)
+	}
+
+	for _, want := range []string{
+		"# VEX Report",
+		"2 document(s), 4 statement(s).",
+		"## Status Summary",
+		"| affected | 2 |",
+		"| fixed | 1 |",
+		"| under_investigation | 0 |",
+		"## Affected Vulnerabilities",
+		"### CVE-2023-0001\n\n- Products: `pkg:npm/a@1.0.0`, `pkg:npm/b@1.0.0`\n- Remediation: Upgrade to 1.0.1\n",
+		"## Not Affected Justifications",
+		"- CVE-2023-0002 on `pkg:npm/a@1.0.0`: component_not_present",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("MarkdownReport() should contain %q, got:\n%s", want, report)
+		}
+	}
+	if strings.Count(report, "Remediation:") != 1 {
+		t.Errorf("MarkdownReport() should list the shared remediation once, got:\n%s", report)
+	}
+}
@@ -0,0 +1,80 @@
+package vex
+
+import (
+	"fmt"
+	"strings"
+
+	packageurl "github.com/package-url/packageurl-go"
+)
+
+// OrphanStatement is a statement with products missing from an SBOM
+type OrphanStatement struct {
+	Statement     int      `json:"statement"`
+	Vulnerability string   `json:"vulnerability"`
+	Products      []string `json:"products"`
+}
+
+// SBOMCoverageReport lists the statements of a document whose products are
+// not components of an SBOM. Covered is true when there are none.
+type SBOMCoverageReport struct {
+	Covered    bool              `json:"covered"`
+	Components int               `json:"components"`
+	Orphans    []OrphanStatement `json:"orphans"`
+}
+
+// normalizePURL returns the canonical form of a package URL, so that
+// spellings differing only in case, qualifier order, or percent-encoding
+// compare equal. Strings that don't parse as a package URL are compared as
+// given.
+func normalizePURL(purl string) string {
+	purl = strings.TrimSpace(purl)
+	parsed, err := packageurl.FromString(purl)
+	if err != nil {
+		return purl
+	}
+	return parsed.ToString()
+}
+
+// CheckProductsInSBOM reports the statements of a document covering
+// products that are not among components, the package URLs of an SBOM.
+// Package URLs are normalized before matching. Each orphan statement is
+// listed by 0-based index with only its missing products.
+func (c *Client) CheckProductsInSBOM(raw map[string]interface{}, components []string) (*SBOMCoverageReport, error) {
+	if len(components) == 0 {
+		return nil, fmt.Errorf("validation error: %w", &ValidationError{Field: "components", Reason: "must list at least one package URL"})
+	}
+	if len(components) > MaxSBOMComponents {
+		return nil, fmt.Errorf("validation error: %w", &ValidationError{
+			Field:  "components",
+			Reason: fmt.Sprintf("must list at most %d package URLs", MaxSBOMComponents),
+		})
+	}
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	sbom := make(map[string]bool, len(components))
+	for _, component := range components {
+		sbom[normalizePURL(component)] = true
+	}
+
+	report := &SBOMCoverageReport{Components: len(sbom), Orphans: []OrphanStatement{}}
+	for i, stmt := range doc.Statements {
+		var missing []string
+		for _, product := range stmt.Products {
+			if !sbom[normalizePURL(product.Component.ID)] {
+				missing = append(missing, product.Component.ID)
+			}
+		}
+		if len(missing) > 0 {
+			report.Orphans = append(report.Orphans, OrphanStatement{
+				Statement:     i,
+				Vulnerability: string(stmt.Vulnerability.Name),
+				Products:      missing,
+			})
+		}
+	}
+	report.Covered = len(report.Orphans) == 0
+	return report, nil
+}
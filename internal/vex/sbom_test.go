@@ -0,0 +1,72 @@
+package vex
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCheckProductsInSBOM(t *testing.T) {
+	client := NewClient("test-author")
+
+	doc := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "fixed"},
+			{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:NPM/lodash@4.17.21"}, {"@id": "pkg:npm/left-pad@1.3.0"}], "status": "fixed"},
+			{"vulnerability": {"name": "CVE-2023-0003"}, "products": [{"@id": "pkg:deb/debian/curl@7.88.1?distro=debian-12&arch=amd64"}], "status": "fixed"},
+			{"vulnerability": {"name": "CVE-2023-0004"}, "products": [{"@id": "pkg:pypi/Django_Rest@3.0"}], "status": "fixed"},
+			{"vulnerability": {"name": "CVE-2023-0005"}, "products": [{"@id": "pkg:golang/example.com/unlisted@v1.0.0"}], "status": "fixed"}
+		]
+	}`)
+
+	tests := []struct {
+		name        string
+		components  []string
+		wantOrphans []OrphanStatement
+	}{
+		{
+			name: "orphaned and matched products",
+			components: []string{
+				"pkg:npm/lodash@4.17.21",
+				"pkg:deb/debian/curl@7.88.1?arch=amd64&distro=debian-12",
+				"pkg:pypi/django-rest@3.0",
+			},
+			wantOrphans: []OrphanStatement{
+				{Statement: 1, Vulnerability: "CVE-2023-0002", Products: []string{"pkg:npm/left-pad@1.3.0"}},
+				{Statement: 4, Vulnerability: "CVE-2023-0005", Products: []string{"pkg:golang/example.com/unlisted@v1.0.0"}},
+			},
+		},
+		{
+			name: "all products in SBOM",
+			components: []string{
+				"pkg:npm/lodash@4.17.21",
+				"pkg:npm/left-pad@1.3.0",
+				"pkg:deb/debian/curl@7.88.1?distro=debian-12&arch=amd64",
+				"pkg:pypi/django-rest@3.0",
+				"pkg:golang/example.com/unlisted@v1.0.0",
+			},
+			wantOrphans: []OrphanStatement{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := client.CheckProductsInSBOM(doc, tt.components)
+			if err != nil {
+				t.Fatalf("CheckProductsInSBOM() error = %v", err)
+			}
+			if !reflect.DeepEqual(report.Orphans, tt.wantOrphans) {
+				t.Errorf("CheckProductsInSBOM() orphans = %+v, want %+v", report.Orphans, tt.wantOrphans)
+			}
+			if report.Covered != (len(tt.wantOrphans) == 0) {
+				t.Errorf("CheckProductsInSBOM() covered = %v", report.Covered)
+			}
+		})
+	}
+
+	_, err := client.CheckProductsInSBOM(doc, nil)
+	if err == nil || !strings.Contains(err.Error(), "components must list at least one package URL") {
+		t.Errorf("CheckProductsInSBOM() error = %v, want missing components error", err)
+	}
+}
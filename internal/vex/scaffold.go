@@ -0,0 +1,90 @@
+package vex
+
+import (
+	"fmt"
+	"time"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// ScaffoldInput represents the input for scaffolding a triage document
+type ScaffoldInput struct {
+	Product         string
+	Vulnerabilities []string
+	Author          string
+	AuthorRole      string
+}
+
+// ScaffoldDocument creates a document with one under_investigation statement
+// per vulnerability for product, as a starting point for triage. Repeated
+// vulnerabilities get a single statement.
+func (c *Client) ScaffoldDocument(input *ScaffoldInput) (*Document, error) {
+	doc, err := c.scaffoldDocument(input)
+	if err != nil {
+		c.logRejection("scaffold", err)
+	}
+	return doc, err
+}
+
+func (c *Client) scaffoldDocument(input *ScaffoldInput) (*Document, error) {
+	if err := ValidateRequired("product", input.Product); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	if err := ValidateStringLength("product", input.Product, MaxStringLength); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	if err := ValidateDangerousChars("product", input.Product); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	if err := ValidateVulnerabilityCount(len(input.Vulnerabilities)); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	for i, vuln := range input.Vulnerabilities {
+		field := fmt.Sprintf("vulnerabilities[%d]", i)
+		if err := ValidateRequired(field, vuln); err != nil {
+			return nil, fmt.Errorf("validation error: %w", err)
+		}
+		if err := ValidateStringLength(field, vuln, MaxStringLength); err != nil {
+			return nil, fmt.Errorf("validation error: %w", err)
+		}
+		if err := ValidateDangerousChars(field, vuln); err != nil {
+			return nil, fmt.Errorf("validation error: %w", err)
+		}
+	}
+	if err := ValidateStringLength("author", input.Author, MaxAuthorLength); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	if err := ValidateDangerousChars("author", input.Author); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	if err := ValidateStringLength("author_role", input.AuthorRole, MaxAuthorRoleLength); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	if err := ValidateDangerousChars("author_role", input.AuthorRole); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	doc := vexlib.New()
+	now := time.Now()
+	doc.Context = vexlib.Context
+	doc.ID = c.generateID(now)
+	doc.Author = c.getAuthor(input.Author)
+	doc.AuthorRole = input.AuthorRole
+	doc.Version = 1
+	doc.Timestamp = &now
+
+	seen := make(map[string]bool, len(input.Vulnerabilities))
+	for _, vuln := range input.Vulnerabilities {
+		if seen[vuln] {
+			continue
+		}
+		seen[vuln] = true
+		doc.Statements = append(doc.Statements, vexlib.Statement{
+			Vulnerability: vexlib.Vulnerability{Name: vexlib.VulnerabilityID(vuln)},
+			Products:      []vexlib.Product{{Component: vexlib.Component{ID: input.Product}}},
+			Status:        vexlib.StatusUnderInvestigation,
+		})
+	}
+
+	return NewDocument(&doc), nil
+}
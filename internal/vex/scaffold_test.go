@@ -0,0 +1,90 @@
+package vex
+
+import (
+	"strings"
+	"testing"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+func TestScaffoldDocument(t *testing.T) {
+	client := NewClient("test-author")
+
+	doc, err := client.ScaffoldDocument(&ScaffoldInput{
+		Product:         "pkg:oci/app@sha256:abc",
+		Vulnerabilities: []string{"CVE-2023-0001", "CVE-2023-0002", "CVE-2023-0001", "GHSA-aaaa-bbbb-cccc"},
+	})
+	if err != nil {
+		t.Fatalf("ScaffoldDocument() error = %v", err)
+	}
+
+	if len(doc.Statements) != 3 {
+		t.Fatalf("ScaffoldDocument() statements = %d, want 3", len(doc.Statements))
+	}
+	for i, want := range []string{"CVE-2023-0001", "CVE-2023-0002", "GHSA-aaaa-bbbb-cccc"} {
+		stmt := doc.Statements[i]
+		if string(stmt.Vulnerability.Name) != want {
+			t.Errorf("statement %d vulnerability = %v, want %v", i, stmt.Vulnerability.Name, want)
+		}
+		if stmt.Status != vexlib.StatusUnderInvestigation {
+			t.Errorf("statement %d status = %v, want under_investigation", i, stmt.Status)
+		}
+		if len(stmt.Products) != 1 || stmt.Products[0].Component.ID != "pkg:oci/app@sha256:abc" {
+			t.Errorf("statement %d products = %+v", i, stmt.Products)
+		}
+		if err := stmt.Validate(); err != nil {
+			t.Errorf("statement %d is invalid: %v", i, err)
+		}
+	}
+	if doc.Author != "test-author" || doc.ID == "" || doc.Timestamp == nil {
+		t.Errorf("ScaffoldDocument() metadata not set: %+v", doc.Metadata)
+	}
+}
+
+func TestScaffoldDocument_ValidationErrors(t *testing.T) {
+	client := NewClient("test-author")
+
+	tests := []struct {
+		name            string
+		input           *ScaffoldInput
+		wantErrContains string
+	}{
+		{
+			name:            "missing product",
+			input:           &ScaffoldInput{Vulnerabilities: []string{"CVE-2023-0001"}},
+			wantErrContains: "product is required",
+		},
+		{
+			name:            "no vulnerabilities",
+			input:           &ScaffoldInput{Product: "pkg:npm/a@1.0.0"},
+			wantErrContains: "at least one vulnerability is required",
+		},
+		{
+			name:            "too many vulnerabilities",
+			input:           &ScaffoldInput{Product: "pkg:npm/a@1.0.0", Vulnerabilities: make([]string, MaxBatchItems+1)},
+			wantErrContains: "vulnerabilities can be scaffolded at once",
+		},
+		{
+			name:            "empty vulnerability",
+			input:           &ScaffoldInput{Product: "pkg:npm/a@1.0.0", Vulnerabilities: []string{"CVE-2023-0001", ""}},
+			wantErrContains: "vulnerabilities[1] is required",
+		},
+		{
+			name:            "dangerous vulnerability",
+			input:           &ScaffoldInput{Product: "pkg:npm/a@1.0.0", Vulnerabilities: []string{"CVE-2023-0001;rm"}},
+			wantErrContains: "dangerous characters",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.ScaffoldDocument(tt.input)
+			if err == nil {
+				t.Fatal("ScaffoldDocument() expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.wantErrContains) {
+				t.Errorf("ScaffoldDocument() error = %v, want to contain %v", err, tt.wantErrContains)
+			}
+		})
+	}
+}
@@ -0,0 +1,215 @@
+package vex
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+	packageurl "github.com/package-url/packageurl-go"
+)
+
+// Supported vulnerability scanner report formats
+const (
+	ScannerFormatTrivy = "trivy"
+)
+
+// ScannerFormats returns the supported scanner report formats
+func ScannerFormats() []string {
+	return []string{ScannerFormatTrivy}
+}
+
+// ScannerImportStatuses returns the statuses a scanner import may assign.
+// not_affected and affected statements need a justification or action
+// statement per finding, which a scanner report cannot supply.
+func ScannerImportStatuses() []string {
+	return []string{string(vexlib.StatusUnderInvestigation), string(vexlib.StatusFixed)}
+}
+
+// ScannerImportInput represents the input for importing a scanner report
+type ScannerImportInput struct {
+	Report     map[string]interface{}
+	Format     string // trivy (default)
+	Status     string // under_investigation (default) or fixed
+	Author     string
+	AuthorRole string
+}
+
+// scannerFinding is a vulnerability reported for a package
+type scannerFinding struct {
+	Vulnerability string
+	Product       string
+}
+
+// trivyReport is the subset of a Trivy JSON report read when importing it
+type trivyReport struct {
+	Results []struct {
+		Target          string `json:"Target"`
+		Type            string `json:"Type"`
+		Vulnerabilities []struct {
+			VulnerabilityID  string `json:"VulnerabilityID"`
+			PkgName          string `json:"PkgName"`
+			InstalledVersion string `json:"InstalledVersion"`
+			PkgIdentifier    struct {
+				PURL string `json:"PURL"`
+			} `json:"PkgIdentifier"`
+		} `json:"Vulnerabilities"`
+	} `json:"Results"`
+}
+
+// trivyPURLTypes maps the Trivy language package types that identify a
+// package by name and version alone to their purl type. OS packages need
+// distro qualifiers and are only imported when Trivy supplies the purl.
+var trivyPURLTypes = map[string]string{
+	"npm":         packageurl.TypeNPM,
+	"yarn":        packageurl.TypeNPM,
+	"pnpm":        packageurl.TypeNPM,
+	"pip":         packageurl.TypePyPi,
+	"pipenv":      packageurl.TypePyPi,
+	"poetry":      packageurl.TypePyPi,
+	"gomod":       packageurl.TypeGolang,
+	"gobinary":    packageurl.TypeGolang,
+	"cargo":       packageurl.TypeCargo,
+	"composer":    packageurl.TypeComposer,
+	"bundler":     packageurl.TypeGem,
+	"gemspec":     packageurl.TypeGem,
+	"nuget":       packageurl.TypeNuget,
+	"dotnet-core": packageurl.TypeNuget,
+}
+
+// parseTrivyReport returns the findings of a Trivy JSON report and the
+// number skipped because no purl could be determined for their package
+func parseTrivyReport(data []byte) ([]scannerFinding, int, error) {
+	var report trivyReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, 0, fmt.Errorf("failed to decode Trivy report: %w", err)
+	}
+
+	var findings []scannerFinding
+	skipped := 0
+	for _, result := range report.Results {
+		for _, vuln := range result.Vulnerabilities {
+			purl := vuln.PkgIdentifier.PURL
+			if purl == "" {
+				purl = trivyPURL(result.Type, vuln.PkgName, vuln.InstalledVersion)
+			}
+			if purl == "" || vuln.VulnerabilityID == "" {
+				skipped++
+				continue
+			}
+			findings = append(findings, scannerFinding{Vulnerability: vuln.VulnerabilityID, Product: purl})
+		}
+	}
+	return findings, skipped, nil
+}
+
+// trivyPURL builds the purl of a language package reported by older Trivy
+// versions, which omit it. The namespace is everything before the last slash
+// of the name, as for Go modules and scoped npm packages.
+func trivyPURL(pkgType, name, version string) string {
+	purlType, ok := trivyPURLTypes[pkgType]
+	if !ok || name == "" {
+		return ""
+	}
+	namespace := ""
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		namespace, name = name[:i], name[i+1:]
+	}
+	return packageurl.NewPackageURL(purlType, namespace, name, version, nil, "").ToString()
+}
+
+// ImportScannerReport creates a document with one statement per distinct
+// (vulnerability, package) finding of a scanner report, with the package
+// purl as product. It also returns the number of findings skipped because
+// their package has no purl.
+func (c *Client) ImportScannerReport(input *ScannerImportInput) (*Document, int, error) {
+	doc, skipped, err := c.importScannerReport(input)
+	if err != nil {
+		c.logRejection("import_scanner", err)
+	}
+	return doc, skipped, err
+}
+
+func (c *Client) importScannerReport(input *ScannerImportInput) (*Document, int, error) {
+	format := input.Format
+	if format == "" {
+		format = ScannerFormatTrivy
+	}
+	statusValue := input.Status
+	if statusValue == "" {
+		statusValue = string(vexlib.StatusUnderInvestigation)
+	}
+	status, err := parseStatus(statusValue)
+	if err != nil || (status != vexlib.StatusUnderInvestigation && status != vexlib.StatusFixed) {
+		return nil, 0, fmt.Errorf("validation error: %w", &ValidationError{
+			Field:  "status",
+			Reason: fmt.Sprintf("must be one of: %s", strings.Join(ScannerImportStatuses(), ", ")),
+		})
+	}
+	if err := validateMergeMetadata(&MergeInput{Author: input.Author, AuthorRole: input.AuthorRole}); err != nil {
+		return nil, 0, err
+	}
+
+	data, err := json.Marshal(input.Report)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to marshal report: %w", err)
+	}
+	var findings []scannerFinding
+	var skipped int
+	switch format {
+	case ScannerFormatTrivy:
+		findings, skipped, err = parseTrivyReport(data)
+	default:
+		err = fmt.Errorf("unsupported scanner format %q, must be one of: %s", format, strings.Join(ScannerFormats(), ", "))
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(findings) == 0 {
+		return nil, 0, fmt.Errorf("report contains no findings with a package URL")
+	}
+
+	now := time.Now()
+	doc := vexlib.New()
+	doc.Context = vexlib.Context
+	doc.ID = c.generateID(now)
+	doc.Author = c.getAuthor(input.Author)
+	doc.AuthorRole = input.AuthorRole
+	doc.Version = 1
+	doc.Timestamp = &now
+
+	seen := map[scannerFinding]bool{}
+	for i, finding := range findings {
+		if seen[finding] {
+			continue
+		}
+		seen[finding] = true
+
+		for _, f := range []struct{ name, value string }{
+			{"vulnerability", finding.Vulnerability},
+			{"product", finding.Product},
+		} {
+			field, value := fmt.Sprintf("findings[%d].%s", i, f.name), f.value
+			if err := ValidateStringLength(field, value, MaxStringLength); err != nil {
+				return nil, 0, fmt.Errorf("validation error: %w", err)
+			}
+			if err := ValidateDangerousChars(field, value); err != nil {
+				return nil, 0, fmt.Errorf("validation error: %w", err)
+			}
+		}
+		if len(doc.Statements) == MaxScannerFindings {
+			return nil, 0, fmt.Errorf("validation error: %w", &ValidationError{
+				Field:  "report",
+				Reason: fmt.Sprintf("has more than %d distinct findings", MaxScannerFindings),
+			})
+		}
+		doc.Statements = append(doc.Statements, vexlib.Statement{
+			Vulnerability: vexlib.Vulnerability{Name: vexlib.VulnerabilityID(finding.Vulnerability)},
+			Products:      []vexlib.Product{{Component: vexlib.Component{ID: finding.Product}}},
+			Status:        status,
+		})
+	}
+
+	return NewDocument(&doc), skipped, nil
+}
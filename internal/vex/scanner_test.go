@@ -0,0 +1,140 @@
+package vex
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// trivySample is a trimmed Trivy JSON report covering a purl supplied by
+// Trivy, a Go module without one, an OS package without one, and a finding
+// repeated across targets
+const trivySample = `{
+	"SchemaVersion": 2,
+	"ArtifactName": "app:latest",
+	"ArtifactType": "container_image",
+	"Results": [
+		{
+			"Target": "app:latest (debian 12.4)",
+			"Class": "os-pkgs",
+			"Type": "debian",
+			"Vulnerabilities": [
+				{"VulnerabilityID": "CVE-2023-0001", "PkgName": "openssl", "InstalledVersion": "3.0.11-1", "Severity": "HIGH"}
+			]
+		},
+		{
+			"Target": "app/package-lock.json",
+			"Class": "lang-pkgs",
+			"Type": "npm",
+			"Vulnerabilities": [
+				{"VulnerabilityID": "CVE-2023-0002", "PkgName": "lodash", "InstalledVersion": "4.17.20", "PkgIdentifier": {"PURL": "pkg:npm/lodash@4.17.20"}, "Severity": "CRITICAL"},
+				{"VulnerabilityID": "GHSA-xxxx-yyyy-zzzz", "PkgName": "@babel/core", "InstalledVersion": "7.0.0", "PkgIdentifier": {"PURL": "pkg:npm/%40babel/core@7.0.0"}}
+			]
+		},
+		{
+			"Target": "app/other/package-lock.json",
+			"Type": "npm",
+			"Vulnerabilities": [
+				{"VulnerabilityID": "CVE-2023-0002", "PkgName": "lodash", "InstalledVersion": "4.17.20", "PkgIdentifier": {"PURL": "pkg:npm/lodash@4.17.20"}}
+			]
+		},
+		{
+			"Target": "app/server",
+			"Type": "gobinary",
+			"Vulnerabilities": [
+				{"VulnerabilityID": "CVE-2023-0003", "PkgName": "golang.org/x/net", "InstalledVersion": "v0.7.0"}
+			]
+		}
+	]
+}`
+
+func TestImportScannerReport(t *testing.T) {
+	client := NewClient("test-author")
+
+	doc, skipped, err := client.ImportScannerReport(&ScannerImportInput{
+		Report: decodeDocument(t, trivySample),
+		Author: "scanner-bot",
+	})
+	if err != nil {
+		t.Fatalf("ImportScannerReport() error = %v", err)
+	}
+	if skipped != 1 {
+		t.Errorf("ImportScannerReport() skipped = %d, want 1", skipped)
+	}
+	if doc.Author != "scanner-bot" || doc.Timestamp == nil || doc.ID == "" {
+		t.Errorf("ImportScannerReport() metadata = %q %v %q", doc.Author, doc.Timestamp, doc.ID)
+	}
+
+	type finding struct{ vuln, product, status string }
+	var got []finding
+	for _, stmt := range doc.Statements {
+		if len(stmt.Products) != 1 {
+			t.Fatalf("statement %s products = %v, want 1", stmt.Vulnerability.Name, stmt.Products)
+		}
+		got = append(got, finding{string(stmt.Vulnerability.Name), stmt.Products[0].Component.ID, string(stmt.Status)})
+	}
+	want := []finding{
+		{"CVE-2023-0002", "pkg:npm/lodash@4.17.20", "under_investigation"},
+		{"GHSA-xxxx-yyyy-zzzz", "pkg:npm/%40babel/core@7.0.0", "under_investigation"},
+		{"CVE-2023-0003", "pkg:golang/golang.org/x/net@v0.7.0", "under_investigation"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ImportScannerReport() statements = %v, want %v", got, want)
+	}
+	for i := range doc.Statements {
+		if err := doc.Statements[i].Validate(); err != nil {
+			t.Errorf("statement %d is invalid: %v", i, err)
+		}
+	}
+
+	fixed, _, err := client.ImportScannerReport(&ScannerImportInput{Report: decodeDocument(t, trivySample), Status: "fixed"})
+	if err != nil {
+		t.Fatalf("ImportScannerReport() error = %v", err)
+	}
+	if fixed.Statements[0].Status != "fixed" {
+		t.Errorf("ImportScannerReport() status = %s, want fixed", fixed.Statements[0].Status)
+	}
+}
+
+func TestImportScannerReport_Errors(t *testing.T) {
+	client := NewClient("test-author")
+
+	tests := []struct {
+		name            string
+		input           *ScannerImportInput
+		wantErrContains string
+	}{
+		{
+			name:            "status needing per-finding detail",
+			input:           &ScannerImportInput{Report: decodeDocument(t, trivySample), Status: "not_affected"},
+			wantErrContains: "status must be one of: under_investigation, fixed",
+		},
+		{
+			name:            "unsupported format",
+			input:           &ScannerImportInput{Report: decodeDocument(t, trivySample), Format: "grype"},
+			wantErrContains: `unsupported scanner format "grype"`,
+		},
+		{
+			name:            "no findings",
+			input:           &ScannerImportInput{Report: decodeDocument(t, `{"Results": [{"Target": "clean"}]}`)},
+			wantErrContains: "no findings",
+		},
+		{
+			name:            "not a Trivy report",
+			input:           &ScannerImportInput{Report: decodeDocument(t, `{"Results": "nope"}`)},
+			wantErrContains: "failed to decode Trivy report",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := client.ImportScannerReport(tt.input)
+			if err == nil {
+				t.Fatal("ImportScannerReport() expected error")
+			}
+			if !strings.Contains(err.Error(), tt.wantErrContains) {
+				t.Errorf("ImportScannerReport() error = %v, want to contain %v", err, tt.wantErrContains)
+			}
+		})
+	}
+}
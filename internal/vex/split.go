@@ -0,0 +1,51 @@
+package vex
+
+import (
+	"fmt"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// StatusDocument is the part of a split document holding the statements
+// with one status
+type StatusDocument struct {
+	Status   string
+	Document *Document
+}
+
+// SplitByStatus partitions a document into one document per status present,
+// in the order of vexlib.Statuses, for routing to different teams. Each part
+// keeps the source metadata and extension fields, takes the source @id
+// suffixed with its status, and keeps the statement extensions of its
+// statements.
+func (c *Client) SplitByStatus(raw map[string]interface{}) ([]StatusDocument, error) {
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	indices := map[vexlib.Status][]int{}
+	for i, stmt := range doc.Statements {
+		indices[stmt.Status] = append(indices[stmt.Status], i)
+	}
+
+	parts := []StatusDocument{}
+	for _, status := range vexlib.Statuses() {
+		statementIndices := indices[vexlib.Status(status)]
+		if len(statementIndices) == 0 {
+			continue
+		}
+
+		part := *doc
+		if doc.ID != "" {
+			part.ID = fmt.Sprintf("%s-%s", doc.ID, status)
+		}
+		part.Statements = make([]vexlib.Statement, 0, len(statementIndices))
+		for _, i := range statementIndices {
+			part.Statements = append(part.Statements, doc.Statements[i])
+		}
+
+		parts = append(parts, StatusDocument{Status: status, Document: documentWithStatementSources(&part, raw, statementIndices)})
+	}
+	return parts, nil
+}
@@ -0,0 +1,68 @@
+package vex
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSplitByStatus(t *testing.T) {
+	client := NewClient("test-author")
+
+	parts, err := client.SplitByStatus(decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"@id": "https://example.com/vex/1",
+		"author": "security-team",
+		"timestamp": "2023-01-01T00:00:00Z",
+		"version": 2,
+		"labels": {"team": "payments"},
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"},
+			{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "affected", "action_statement": "Upgrade", "notes": ["urgent"]},
+			{"vulnerability": {"name": "CVE-2023-0003"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"},
+			{"vulnerability": {"name": "CVE-2023-0004"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "affected", "action_statement": "Upgrade"}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("SplitByStatus() error = %v", err)
+	}
+
+	want := map[string]string{
+		"affected": "CVE-2023-0002,CVE-2023-0004",
+		"fixed":    "CVE-2023-0001,CVE-2023-0003",
+	}
+	if len(parts) != len(want) {
+		t.Fatalf("SplitByStatus() produced %d documents, want %d", len(parts), len(want))
+	}
+	for _, part := range parts {
+		var vulns []string
+		for _, stmt := range part.Document.Statements {
+			if string(stmt.Status) != part.Status {
+				t.Errorf("%s document contains a %s statement", part.Status, stmt.Status)
+			}
+			vulns = append(vulns, string(stmt.Vulnerability.Name))
+		}
+		if got := strings.Join(vulns, ","); got != want[part.Status] {
+			t.Errorf("%s statements = %s, want %s", part.Status, got, want[part.Status])
+		}
+		if wantID := "https://example.com/vex/1-" + part.Status; part.Document.ID != wantID {
+			t.Errorf("%s document @id = %s, want %s", part.Status, part.Document.ID, wantID)
+		}
+		if part.Document.Author != "security-team" || part.Document.Version != 2 {
+			t.Errorf("%s document metadata not preserved: author = %s, version = %d",
+				part.Status, part.Document.Author, part.Document.Version)
+		}
+		if part.Document.Extensions[LabelsExtension] == nil {
+			t.Errorf("%s document labels not preserved", part.Status)
+		}
+	}
+
+	if parts[0].Status != "affected" {
+		t.Errorf("first document status = %s, want affected", parts[0].Status)
+	}
+	if notes := parts[0].Document.StatementExtensions[0][NotesExtension]; notes == nil {
+		t.Error("statement extensions not carried to the split document")
+	}
+	if len(parts[1].Document.StatementExtensions) != 0 {
+		t.Errorf("fixed document has unexpected statement extensions: %v", parts[1].Document.StatementExtensions)
+	}
+}
@@ -0,0 +1,67 @@
+package vex
+
+import (
+	"sort"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// StalePair is a (vulnerability, product) pair with both resolved and open
+// statements, which usually means an older statement was never retired
+type StalePair struct {
+	Vulnerability string `json:"vulnerability"`
+	Product       string `json:"product"`
+	Resolved      []int  `json:"resolved"`
+	Open          []int  `json:"open"`
+}
+
+// StalenessReport is the result of a cross-statement staleness check
+type StalenessReport struct {
+	Stale bool        `json:"stale"`
+	Pairs []StalePair `json:"pairs"`
+}
+
+// CheckStaleness reports (vulnerability, product) pairs that have both a
+// fixed/not_affected statement and an affected/under_investigation
+// statement, listing the conflicting statement indices
+func (c *Client) CheckStaleness(raw map[string]interface{}) (*StalenessReport, error) {
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	type pairKey struct{ vulnerability, product string }
+	pairs := map[pairKey]*StalePair{}
+	for i, stmt := range doc.Statements {
+		for _, product := range productIDs(stmt.Products) {
+			key := pairKey{string(stmt.Vulnerability.Name), product}
+			pair, ok := pairs[key]
+			if !ok {
+				pair = &StalePair{Vulnerability: key.vulnerability, Product: key.product}
+				pairs[key] = pair
+			}
+			switch stmt.Status {
+			case vexlib.StatusFixed, vexlib.StatusNotAffected:
+				pair.Resolved = append(pair.Resolved, i)
+			case vexlib.StatusAffected, vexlib.StatusUnderInvestigation:
+				pair.Open = append(pair.Open, i)
+			}
+		}
+	}
+
+	report := &StalenessReport{Pairs: []StalePair{}}
+	for _, pair := range pairs {
+		if len(pair.Resolved) > 0 && len(pair.Open) > 0 {
+			report.Pairs = append(report.Pairs, *pair)
+		}
+	}
+	sort.Slice(report.Pairs, func(i, j int) bool {
+		if report.Pairs[i].Vulnerability != report.Pairs[j].Vulnerability {
+			return report.Pairs[i].Vulnerability < report.Pairs[j].Vulnerability
+		}
+		return report.Pairs[i].Product < report.Pairs[j].Product
+	})
+
+	report.Stale = len(report.Pairs) > 0
+	return report, nil
+}
@@ -0,0 +1,56 @@
+package vex
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckStaleness(t *testing.T) {
+	client := NewClient("test-author")
+
+	stale := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}, {"@id": "pkg:npm/axios@1.0.0"}], "status": "affected", "action_statement": "Upgrade"},
+			{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "fixed"},
+			{"vulnerability": {"name": "CVE-2023-5678"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "under_investigation"}
+		]
+	}`)
+	clean := decodeDocument(t, `{
+		"@context": "https://openvex.dev/ns",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-1234"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "fixed"},
+			{"vulnerability": {"name": "CVE-2023-5678"}, "products": [{"@id": "pkg:npm/lodash@4.17.21"}], "status": "affected", "action_statement": "Upgrade"}
+		]
+	}`)
+
+	tests := []struct {
+		name      string
+		doc       map[string]interface{}
+		wantPairs []StalePair
+	}{
+		{
+			name: "stale pair",
+			doc:  stale,
+			wantPairs: []StalePair{
+				{Vulnerability: "CVE-2023-1234", Product: "pkg:npm/lodash@4.17.21", Resolved: []int{1}, Open: []int{0}},
+			},
+		},
+		{name: "no stale pairs", doc: clean, wantPairs: []StalePair{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := client.CheckStaleness(tt.doc)
+			if err != nil {
+				t.Fatalf("CheckStaleness() error = %v", err)
+			}
+			if !reflect.DeepEqual(report.Pairs, tt.wantPairs) {
+				t.Errorf("CheckStaleness() pairs = %+v, want %+v", report.Pairs, tt.wantPairs)
+			}
+			if report.Stale != (len(tt.wantPairs) > 0) {
+				t.Errorf("CheckStaleness() stale = %v, want %v", report.Stale, len(tt.wantPairs) > 0)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package vex
+
+import (
+	"fmt"
+	"time"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// GetStatement returns the statement at the given 0-based index of a
+// document, together with its extension fields
+func (c *Client) GetStatement(raw map[string]interface{}, index int) (*Statement, error) {
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkStatementIndex(doc, index); err != nil {
+		return nil, err
+	}
+	return &Statement{
+		Statement:  &doc.Statements[index],
+		Extensions: extractStatementExtensions(raw)[index],
+	}, nil
+}
+
+// ReplaceStatement replaces the statement at the given 0-based index with one
+// built and validated from input exactly as CreateDocument would. The
+// document's last_updated is refreshed and its extension fields, and those of
+// the other statements, are preserved.
+func (c *Client) ReplaceStatement(raw map[string]interface{}, index int, input *CreateInput) (*Document, error) {
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkStatementIndex(doc, index); err != nil {
+		return nil, err
+	}
+
+	created, err := c.CreateDocument(input)
+	if err != nil {
+		return nil, err
+	}
+	doc.Statements[index] = created.Statements[0]
+
+	now := time.Now()
+	doc.LastUpdated = &now
+
+	result := documentWithExtensions(doc, raw)
+	delete(result.StatementExtensions, index)
+	for name, value := range created.StatementExtensions[0] {
+		result.SetStatementExtension(index, name, value)
+	}
+	return result, nil
+}
+
+// checkStatementIndex reports whether index addresses a statement of doc
+func checkStatementIndex(doc *vexlib.VEX, index int) error {
+	if index < 0 || index >= len(doc.Statements) {
+		return fmt.Errorf("statement index %d out of range: document has %d statement(s)", index, len(doc.Statements))
+	}
+	return nil
+}
+
+// RemoveInput selects what RemoveStatements drops: the statement at Index,
+// or the (Vulnerability, Product) pair wherever it appears
+type RemoveInput struct {
+	Index         *int
+	Vulnerability string
+	Product       string
+}
+
+// RemoveStatements removes statements from a document and returns it with
+// the number removed. By key, the product is dropped from each statement
+// for the vulnerability that covers it, and statements left without
+// products are removed; each such statement counts once. The document's
+// last_updated is refreshed and its extension fields, and those of the
+// remaining statements, are preserved.
+func (c *Client) RemoveStatements(raw map[string]interface{}, input *RemoveInput) (*Document, int, error) {
+	byKey := input.Vulnerability != "" || input.Product != ""
+	switch {
+	case input.Index != nil && byKey:
+		return nil, 0, fmt.Errorf("specify either an index or a vulnerability and product, not both")
+	case input.Index == nil && (input.Vulnerability == "" || input.Product == ""):
+		return nil, 0, fmt.Errorf("specify either an index or both a vulnerability and a product")
+	}
+
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// sources maps each remaining statement to its original index
+	removed := 0
+	var sources []int
+	if input.Index != nil {
+		if err := checkStatementIndex(doc, *input.Index); err != nil {
+			return nil, 0, err
+		}
+		for i := range doc.Statements {
+			if i != *input.Index {
+				sources = append(sources, i)
+			}
+		}
+		doc.Statements = append(doc.Statements[:*input.Index], doc.Statements[*input.Index+1:]...)
+		removed = 1
+	} else {
+		kept := make([]vexlib.Statement, 0, len(doc.Statements))
+		for i, stmt := range doc.Statements {
+			if string(stmt.Vulnerability.Name) == input.Vulnerability {
+				products := make([]vexlib.Product, 0, len(stmt.Products))
+				for _, p := range stmt.Products {
+					if p.Component.ID != input.Product {
+						products = append(products, p)
+					}
+				}
+				if len(products) < len(stmt.Products) {
+					removed++
+					if len(products) == 0 {
+						continue
+					}
+					stmt.Products = products
+				}
+			}
+			kept = append(kept, stmt)
+			sources = append(sources, i)
+		}
+		if removed == 0 {
+			return nil, 0, fmt.Errorf("no statement for %s covers product %q", input.Vulnerability, input.Product)
+		}
+		doc.Statements = kept
+	}
+
+	now := time.Now()
+	doc.LastUpdated = &now
+
+	return documentWithStatementSources(doc, raw, sources), removed, nil
+}
@@ -0,0 +1,248 @@
+package vex
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGetStatement(t *testing.T) {
+	client := NewClient("test-author")
+	doc := `{
+		"@context": "https://openvex.dev/ns",
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"},
+			{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "under_investigation", "notes": ["triaged"]}
+		]
+	}`
+
+	tests := []struct {
+		name            string
+		index           int
+		wantVuln        string
+		wantNotes       bool
+		wantErrContains string
+	}{
+		{name: "first statement", index: 0, wantVuln: "CVE-2023-0001"},
+		{name: "last statement", index: 1, wantVuln: "CVE-2023-0002", wantNotes: true},
+		{name: "past the end", index: 2, wantErrContains: "statement index 2 out of range: document has 2 statement(s)"},
+		{name: "negative index", index: -1, wantErrContains: "statement index -1 out of range"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stmt, err := client.GetStatement(decodeDocument(t, doc), tt.index)
+			if tt.wantErrContains != "" {
+				if err == nil {
+					t.Fatal("GetStatement() expected error")
+				}
+				if !strings.Contains(err.Error(), tt.wantErrContains) {
+					t.Errorf("GetStatement() error = %v, want to contain %v", err, tt.wantErrContains)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetStatement() error = %v", err)
+			}
+			if string(stmt.Vulnerability.Name) != tt.wantVuln {
+				t.Errorf("GetStatement() vulnerability = %s, want %s", stmt.Vulnerability.Name, tt.wantVuln)
+			}
+			if got := stmt.Extensions[NotesExtension] != nil; got != tt.wantNotes {
+				t.Errorf("GetStatement() has notes = %v, want %v", got, tt.wantNotes)
+			}
+		})
+	}
+}
+
+func TestReplaceStatement(t *testing.T) {
+	client := NewClient("test-author")
+	doc := `{
+		"@context": "https://openvex.dev/ns",
+		"@id": "https://example.com/vex/1",
+		"author": "test-author",
+		"timestamp": "2023-01-01T00:00:00Z",
+		"version": 1,
+		"labels": {"team": "platform"},
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed", "cvss": {"score": 5.3}, "notes": ["backported"]},
+			{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "under_investigation", "cvss": {"score": 9.8}},
+			{"vulnerability": {"name": "CVE-2023-0003"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"}
+		]
+	}`
+
+	tests := []struct {
+		name            string
+		index           int
+		input           *CreateInput
+		wantErrContains string
+	}{
+		{
+			name:  "replace middle statement",
+			index: 1,
+			input: &CreateInput{
+				Product:       "pkg:npm/a@1.0.0",
+				Vulnerability: "CVE-2023-0002",
+				Status:        "not_affected",
+				Justification: "vulnerable_code_not_present",
+				Notes:         []string{"triaged"},
+			},
+		},
+		{
+			name:            "out of range",
+			index:           3,
+			input:           &CreateInput{Product: "pkg:npm/a@1.0.0", Vulnerability: "CVE-2023-0002", Status: "fixed"},
+			wantErrContains: "statement index 3 out of range",
+		},
+		{
+			name:            "invalid replacement",
+			index:           1,
+			input:           &CreateInput{Product: "pkg:npm/a@1.0.0", Vulnerability: "CVE-2023-0002", Status: "not_affected"},
+			wantErrContains: "not_affected requires",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := client.ReplaceStatement(decodeDocument(t, doc), tt.index, tt.input)
+			if tt.wantErrContains != "" {
+				if err == nil {
+					t.Fatal("ReplaceStatement() expected error")
+				}
+				if !strings.Contains(err.Error(), tt.wantErrContains) {
+					t.Errorf("ReplaceStatement() error = %v, want to contain %v", err, tt.wantErrContains)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReplaceStatement() error = %v", err)
+			}
+			if len(result.Statements) != 3 {
+				t.Fatalf("ReplaceStatement() statements = %d, want 3", len(result.Statements))
+			}
+			if result.Statements[1].Status != "not_affected" {
+				t.Errorf("ReplaceStatement() status = %s, want not_affected", result.Statements[1].Status)
+			}
+			if result.Statements[0].Vulnerability.Name != "CVE-2023-0001" || result.Statements[2].Vulnerability.Name != "CVE-2023-0003" {
+				t.Error("ReplaceStatement() changed neighbouring statements")
+			}
+			if result.LastUpdated == nil {
+				t.Error("ReplaceStatement() did not refresh last_updated")
+			}
+			if result.Extensions[LabelsExtension] == nil {
+				t.Error("ReplaceStatement() dropped document labels")
+			}
+			if result.StatementExtensions[1][NotesExtension] == nil {
+				t.Error("ReplaceStatement() dropped statement notes")
+			}
+			if result.StatementExtensions[1][CVSSExtension] != nil {
+				t.Error("ReplaceStatement() kept the replaced statement's cvss")
+			}
+			if result.StatementExtensions[0][CVSSExtension] == nil || result.StatementExtensions[0][NotesExtension] == nil {
+				t.Errorf("ReplaceStatement() dropped untouched statement extensions: %v", result.StatementExtensions[0])
+			}
+		})
+	}
+}
+
+func TestRemoveStatements(t *testing.T) {
+	client := NewClient("test-author")
+	doc := `{
+		"@context": "https://openvex.dev/ns",
+		"labels": {"team": "platform"},
+		"statements": [
+			{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed", "notes": ["first"]},
+			{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}, {"@id": "pkg:npm/b@1.0.0"}], "status": "fixed", "notes": ["second"]},
+			{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "under_investigation", "notes": ["third"]}
+		]
+	}`
+	index := func(i int) *int { return &i }
+
+	tests := []struct {
+		name            string
+		input           *RemoveInput
+		wantRemoved     int
+		wantVulns       []string
+		wantNotes       []string
+		wantErrContains string
+	}{
+		{
+			name:        "by index",
+			input:       &RemoveInput{Index: index(0)},
+			wantRemoved: 1,
+			wantVulns:   []string{"CVE-2023-0002", "CVE-2023-0002"},
+			wantNotes:   []string{"second", "third"},
+		},
+		{
+			name:        "by key",
+			input:       &RemoveInput{Vulnerability: "CVE-2023-0002", Product: "pkg:npm/a@1.0.0"},
+			wantRemoved: 2,
+			wantVulns:   []string{"CVE-2023-0001", "CVE-2023-0002"},
+			wantNotes:   []string{"first", "second"},
+		},
+		{
+			name:            "index out of range",
+			input:           &RemoveInput{Index: index(3)},
+			wantErrContains: "statement index 3 out of range",
+		},
+		{
+			name:            "no matching key",
+			input:           &RemoveInput{Vulnerability: "CVE-2023-0001", Product: "pkg:npm/b@1.0.0"},
+			wantErrContains: `no statement for CVE-2023-0001 covers product "pkg:npm/b@1.0.0"`,
+		},
+		{
+			name:            "both modes",
+			input:           &RemoveInput{Index: index(0), Vulnerability: "CVE-2023-0001", Product: "pkg:npm/a@1.0.0"},
+			wantErrContains: "not both",
+		},
+		{
+			name:            "incomplete key",
+			input:           &RemoveInput{Vulnerability: "CVE-2023-0001"},
+			wantErrContains: "both a vulnerability and a product",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, removed, err := client.RemoveStatements(decodeDocument(t, doc), tt.input)
+			if tt.wantErrContains != "" {
+				if err == nil {
+					t.Fatal("RemoveStatements() expected error")
+				}
+				if !strings.Contains(err.Error(), tt.wantErrContains) {
+					t.Errorf("RemoveStatements() error = %v, want to contain %v", err, tt.wantErrContains)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RemoveStatements() error = %v", err)
+			}
+			if removed != tt.wantRemoved {
+				t.Errorf("RemoveStatements() removed = %d, want %d", removed, tt.wantRemoved)
+			}
+			var vulns []string
+			for _, stmt := range result.Statements {
+				vulns = append(vulns, string(stmt.Vulnerability.Name))
+			}
+			if !reflect.DeepEqual(vulns, tt.wantVulns) {
+				t.Errorf("RemoveStatements() vulnerabilities = %v, want %v", vulns, tt.wantVulns)
+			}
+			if result.LastUpdated == nil {
+				t.Error("RemoveStatements() did not refresh last_updated")
+			}
+			if result.Extensions[LabelsExtension] == nil {
+				t.Error("RemoveStatements() dropped document labels")
+			}
+			var notes []string
+			for i := range result.Statements {
+				stmtNotes, _ := result.StatementExtensions[i][NotesExtension].([]interface{})
+				if len(stmtNotes) != 1 {
+					t.Fatalf("RemoveStatements() statement %d notes = %v, want one note", i, stmtNotes)
+				}
+				notes = append(notes, stmtNotes[0].(string))
+			}
+			if !reflect.DeepEqual(notes, tt.wantNotes) {
+				t.Errorf("RemoveStatements() notes = %v, want %v", notes, tt.wantNotes)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package vex
+
+import (
+	"fmt"
+	"strings"
+
+	vexlib "github.com/openvex/go-vex/pkg/vex"
+)
+
+// Subcomponent flattening modes
+const (
+	FlattenIntoProducts   = "products"
+	FlattenIntoStatements = "statements"
+)
+
+// FlattenModes returns the supported subcomponent flattening modes
+func FlattenModes() []string {
+	return []string{FlattenIntoProducts, FlattenIntoStatements}
+}
+
+// FlattenSubcomponents promotes every subcomponent to a top-level product for
+// consumers that do not understand subcomponents. In products mode (the
+// default) a product's subcomponents replace it in the statement's product
+// list; in statements mode each subcomponent gets its own copy of the
+// statement, while products without subcomponents stay together. Statuses,
+// justifications, and the other statement fields are preserved, as are
+// document extension fields; statement extension fields are copied to every
+// statement split from the original.
+func (c *Client) FlattenSubcomponents(raw map[string]interface{}, mode string) (*Document, error) {
+	if mode == "" {
+		mode = FlattenIntoProducts
+	}
+	if mode != FlattenIntoProducts && mode != FlattenIntoStatements {
+		return nil, fmt.Errorf("validation error: %w", &ValidationError{
+			Field:  "mode",
+			Reason: fmt.Sprintf("must be one of: %s", strings.Join(FlattenModes(), ", ")),
+		})
+	}
+
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	// sources maps each flattened statement to the index it came from
+	flattened := make([]vexlib.Statement, 0, len(doc.Statements))
+	var sources []int
+	for i, stmt := range doc.Statements {
+		var products, promoted []vexlib.Product
+		for _, product := range stmt.Products {
+			if len(product.Subcomponents) == 0 {
+				products = append(products, product)
+				continue
+			}
+			for _, sub := range product.Subcomponents {
+				promoted = append(promoted, vexlib.Product{Component: sub.Component})
+			}
+		}
+
+		if mode == FlattenIntoProducts {
+			stmt.Products = dedupeProducts(append(products, promoted...))
+			flattened = append(flattened, stmt)
+			sources = append(sources, i)
+			continue
+		}
+		if len(products) > 0 {
+			kept := stmt
+			kept.Products = products
+			flattened = append(flattened, kept)
+			sources = append(sources, i)
+		}
+		for _, product := range dedupeProducts(promoted) {
+			split := stmt
+			split.Products = []vexlib.Product{product}
+			flattened = append(flattened, split)
+			sources = append(sources, i)
+		}
+	}
+	doc.Statements = flattened
+
+	return documentWithStatementSources(doc, raw, sources), nil
+}
@@ -0,0 +1,113 @@
+package vex
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestFlattenSubcomponents(t *testing.T) {
+	client := NewClient("test-author")
+	doc := `{
+		"@context": "https://openvex.dev/ns",
+		"statements": [
+			{
+				"vulnerability": {"name": "CVE-2023-0001"},
+				"products": [
+					{"@id": "pkg:oci/app@sha256:abc", "subcomponents": [{"@id": "pkg:npm/a@1.0.0"}, {"@id": "pkg:npm/b@1.0.0"}]},
+					{"@id": "pkg:npm/c@1.0.0"}
+				],
+				"status": "not_affected",
+				"justification": "vulnerable_code_not_in_execute_path",
+				"notes": ["first"]
+			},
+			{
+				"vulnerability": {"name": "CVE-2023-0002"},
+				"products": [{"@id": "pkg:npm/d@1.0.0"}],
+				"status": "fixed",
+				"notes": ["second"]
+			}
+		]
+	}`
+
+	tests := []struct {
+		name            string
+		mode            string
+		want            []string
+		wantNotes       []string
+		wantErrContains string
+	}{
+		{
+			name: "default promotes subcomponents to products",
+			want: []string{
+				"CVE-2023-0001 not_affected vulnerable_code_not_in_execute_path pkg:npm/c@1.0.0,pkg:npm/a@1.0.0,pkg:npm/b@1.0.0",
+				"CVE-2023-0002 fixed  pkg:npm/d@1.0.0",
+			},
+			wantNotes: []string{"first", "second"},
+		},
+		{
+			name: "statements mode splits each subcomponent",
+			mode: FlattenIntoStatements,
+			want: []string{
+				"CVE-2023-0001 not_affected vulnerable_code_not_in_execute_path pkg:npm/c@1.0.0",
+				"CVE-2023-0001 not_affected vulnerable_code_not_in_execute_path pkg:npm/a@1.0.0",
+				"CVE-2023-0001 not_affected vulnerable_code_not_in_execute_path pkg:npm/b@1.0.0",
+				"CVE-2023-0002 fixed  pkg:npm/d@1.0.0",
+			},
+			wantNotes: []string{"first", "first", "first", "second"},
+		},
+		{
+			name:            "unknown mode",
+			mode:            "nested",
+			wantErrContains: "mode must be one of: products, statements",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flattened, err := client.FlattenSubcomponents(decodeDocument(t, doc), tt.mode)
+			if tt.wantErrContains != "" {
+				if err == nil {
+					t.Fatal("FlattenSubcomponents() expected error, got nil")
+				}
+				if !strings.Contains(err.Error(), tt.wantErrContains) {
+					t.Errorf("FlattenSubcomponents() error = %v, want to contain %v", err, tt.wantErrContains)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FlattenSubcomponents() error = %v", err)
+			}
+
+			var got []string
+			for _, stmt := range flattened.Statements {
+				for _, p := range stmt.Products {
+					if len(p.Subcomponents) > 0 {
+						t.Errorf("product %s still has subcomponents", p.Component.ID)
+					}
+				}
+				got = append(got, strings.Join([]string{
+					string(stmt.Vulnerability.Name),
+					string(stmt.Status),
+					string(stmt.Justification),
+					strings.Join(productIDs(stmt.Products), ","),
+				}, " "))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FlattenSubcomponents() statements =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+
+			var notes []string
+			for i := range flattened.Statements {
+				stmtNotes, _ := flattened.StatementExtensions[i][NotesExtension].([]interface{})
+				if len(stmtNotes) != 1 {
+					t.Fatalf("statement %d notes = %v, want one note", i, stmtNotes)
+				}
+				notes = append(notes, stmtNotes[0].(string))
+			}
+			if !reflect.DeepEqual(notes, tt.wantNotes) {
+				t.Errorf("FlattenSubcomponents() notes = %v, want %v", notes, tt.wantNotes)
+			}
+		})
+	}
+}
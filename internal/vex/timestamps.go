@@ -0,0 +1,125 @@
+package vex
+
+import (
+	"fmt"
+	"time"
+)
+
+// FutureTimestampTolerance is how far ahead of the current time a timestamp
+// may be before it is considered implausible (allows for clock skew)
+const FutureTimestampTolerance = 24 * time.Hour
+
+// Timestamp problems reported by CheckTimestamps
+const (
+	TimestampMissing     = "missing"
+	TimestampUnparseable = "unparseable"
+	TimestampFuture      = "future"
+)
+
+// Merged document timestamp strategies
+const (
+	TimestampStrategyNow            = "now"
+	TimestampStrategyLatestSource   = "latest_source"
+	TimestampStrategyEarliestSource = "earliest_source"
+)
+
+// TimestampStrategies returns the supported merged document timestamp strategies
+func TimestampStrategies() []string {
+	return []string{TimestampStrategyNow, TimestampStrategyLatestSource, TimestampStrategyEarliestSource}
+}
+
+// mergeTimestamp picks the merged document timestamp. The source strategies
+// use the latest or earliest source document timestamp, falling back to now
+// when no source has one.
+func mergeTimestamp(strategy string, sources []*time.Time, now time.Time) time.Time {
+	var picked *time.Time
+	for _, ts := range sources {
+		if ts == nil {
+			continue
+		}
+		switch strategy {
+		case TimestampStrategyLatestSource:
+			if picked == nil || ts.After(*picked) {
+				picked = ts
+			}
+		case TimestampStrategyEarliestSource:
+			if picked == nil || ts.Before(*picked) {
+				picked = ts
+			}
+		}
+	}
+	if picked == nil {
+		return now
+	}
+	return *picked
+}
+
+// TimestampIssue describes a problematic timestamp within a document
+type TimestampIssue struct {
+	Location string `json:"location"`
+	Problem  string `json:"problem"`
+	Value    string `json:"value,omitempty"`
+}
+
+// statementTimestampFields are the optional timestamp fields of a statement
+var statementTimestampFields = []string{"timestamp", "last_updated", "action_statement_timestamp"}
+
+// CheckTimestamps reports missing, unparseable, and implausibly future
+// timestamps in a raw VEX document. The raw form is inspected because go-vex
+// rejects malformed timestamps outright when parsing.
+func (c *Client) CheckTimestamps(doc map[string]interface{}) ([]TimestampIssue, error) {
+	statements, ok := doc["statements"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("document must be a valid VEX document with statements")
+	}
+
+	latest := time.Now().Add(FutureTimestampTolerance)
+	issues := []TimestampIssue{}
+
+	// The document timestamp is required; statements inherit it when they lack their own
+	if _, ok := doc["timestamp"]; !ok {
+		issues = append(issues, TimestampIssue{Location: "timestamp", Problem: TimestampMissing})
+	} else if issue := checkTimestamp("timestamp", doc["timestamp"], latest); issue != nil {
+		issues = append(issues, *issue)
+	}
+	if value, ok := doc["last_updated"]; ok {
+		if issue := checkTimestamp("last_updated", value, latest); issue != nil {
+			issues = append(issues, *issue)
+		}
+	}
+
+	for i, raw := range statements {
+		stmt, ok := raw.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("statement %d must be a JSON object", i)
+		}
+		for _, field := range statementTimestampFields {
+			value, ok := stmt[field]
+			if !ok {
+				continue
+			}
+			if issue := checkTimestamp(fmt.Sprintf("statements[%d].%s", i, field), value, latest); issue != nil {
+				issues = append(issues, *issue)
+			}
+		}
+	}
+
+	return issues, nil
+}
+
+// checkTimestamp returns an issue if value is not an RFC 3339 timestamp at or before latest
+func checkTimestamp(location string, value interface{}, latest time.Time) *TimestampIssue {
+	str, ok := value.(string)
+	if !ok || str == "" {
+		return &TimestampIssue{Location: location, Problem: TimestampUnparseable, Value: fmt.Sprint(value)}
+	}
+
+	ts, err := time.Parse(time.RFC3339, str)
+	if err != nil {
+		return &TimestampIssue{Location: location, Problem: TimestampUnparseable, Value: str}
+	}
+	if ts.After(latest) {
+		return &TimestampIssue{Location: location, Problem: TimestampFuture, Value: str}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package vex
+
+import (
+	"testing"
+)
+
+func TestCheckTimestamps(t *testing.T) {
+	client := NewClient("test-author")
+
+	tests := []struct {
+		name       string
+		doc        map[string]interface{}
+		wantIssues []TimestampIssue
+	}{
+		{
+			name: "valid timestamps",
+			doc: map[string]interface{}{
+				"timestamp": "2023-01-01T00:00:00Z",
+				"statements": []interface{}{
+					map[string]interface{}{"timestamp": "2023-01-02T00:00:00.5Z"},
+					map[string]interface{}{},
+				},
+			},
+			wantIssues: []TimestampIssue{},
+		},
+		{
+			name: "missing document timestamp",
+			doc: map[string]interface{}{
+				"statements": []interface{}{},
+			},
+			wantIssues: []TimestampIssue{{Location: "timestamp", Problem: TimestampMissing}},
+		},
+		{
+			name: "malformed timestamps",
+			doc: map[string]interface{}{
+				"timestamp": "2023-01-01T00:00:00Z",
+				"statements": []interface{}{
+					map[string]interface{}{"timestamp": "2023-01-01T00:00:00Z"},
+					map[string]interface{}{"timestamp": "yesterday", "last_updated": 1672531200},
+				},
+			},
+			wantIssues: []TimestampIssue{
+				{Location: "statements[1].timestamp", Problem: TimestampUnparseable, Value: "yesterday"},
+				{Location: "statements[1].last_updated", Problem: TimestampUnparseable, Value: "1672531200"},
+			},
+		},
+		{
+			name: "far-future timestamps",
+			doc: map[string]interface{}{
+				"timestamp": "2999-01-01T00:00:00Z",
+				"statements": []interface{}{
+					map[string]interface{}{"action_statement_timestamp": "2999-06-01T00:00:00Z"},
+				},
+			},
+			wantIssues: []TimestampIssue{
+				{Location: "timestamp", Problem: TimestampFuture, Value: "2999-01-01T00:00:00Z"},
+				{Location: "statements[0].action_statement_timestamp", Problem: TimestampFuture, Value: "2999-06-01T00:00:00Z"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues, err := client.CheckTimestamps(tt.doc)
+			if err != nil {
+				t.Fatalf("CheckTimestamps() error = %v", err)
+			}
+			if len(issues) != len(tt.wantIssues) {
+				t.Fatalf("CheckTimestamps() = %v, want %v", issues, tt.wantIssues)
+			}
+			for i := range issues {
+				if issues[i] != tt.wantIssues[i] {
+					t.Errorf("issue %d = %v, want %v", i, issues[i], tt.wantIssues[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCheckTimestamps_InvalidDocument(t *testing.T) {
+	client := NewClient("test-author")
+
+	if _, err := client.CheckTimestamps(map[string]interface{}{"timestamp": "2023-01-01T00:00:00Z"}); err == nil {
+		t.Error("CheckTimestamps() expected error for document without statements")
+	}
+}
@@ -0,0 +1,56 @@
+package vex
+
+// MixedStatus is a vulnerability assessed with more than one status across
+// the statements of a document
+type MixedStatus struct {
+	Vulnerability string   `json:"vulnerability"`
+	Statuses      []string `json:"statuses"`
+	Statements    []int    `json:"statements"`
+}
+
+// UniformStatusReport lists the vulnerabilities of a document whose
+// statements disagree on status. Uniform is true when there are none.
+type UniformStatusReport struct {
+	Uniform         bool          `json:"uniform"`
+	Vulnerabilities []MixedStatus `json:"vulnerabilities"`
+}
+
+// CheckUniformStatus reports vulnerabilities whose statements carry
+// differing statuses, for reports requiring a single status per
+// vulnerability across all products. Statuses are listed in order of first
+// appearance and statements by 0-based index. This is advisory and does not
+// reject the document.
+func (c *Client) CheckUniformStatus(raw map[string]interface{}) (*UniformStatusReport, error) {
+	doc, err := parseDocument(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	byVulnerability := map[string]*MixedStatus{}
+	seenStatuses := map[string]map[string]bool{}
+	var order []string
+	for i, stmt := range doc.Statements {
+		name := string(stmt.Vulnerability.Name)
+		entry, seen := byVulnerability[name]
+		if !seen {
+			entry = &MixedStatus{Vulnerability: name}
+			byVulnerability[name] = entry
+			seenStatuses[name] = map[string]bool{}
+			order = append(order, name)
+		}
+		if status := string(stmt.Status); !seenStatuses[name][status] {
+			seenStatuses[name][status] = true
+			entry.Statuses = append(entry.Statuses, status)
+		}
+		entry.Statements = append(entry.Statements, i)
+	}
+
+	report := &UniformStatusReport{Vulnerabilities: []MixedStatus{}}
+	for _, name := range order {
+		if entry := byVulnerability[name]; len(entry.Statuses) > 1 {
+			report.Vulnerabilities = append(report.Vulnerabilities, *entry)
+		}
+	}
+	report.Uniform = len(report.Vulnerabilities) == 0
+	return report, nil
+}
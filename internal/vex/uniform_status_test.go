@@ -0,0 +1,68 @@
+package vex
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCheckUniformStatus(t *testing.T) {
+	client := NewClient("test-author")
+
+	tests := []struct {
+		name        string
+		doc         string
+		wantUniform bool
+		wantMixed   []MixedStatus
+	}{
+		{
+			name: "uniform statuses",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "not_affected", "justification": "component_not_present"},
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/b@1.0.0"}], "status": "not_affected", "justification": "vulnerable_code_not_present"},
+					{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"}
+				]
+			}`,
+			wantUniform: true,
+			wantMixed:   []MixedStatus{},
+		},
+		{
+			name: "differing statuses",
+			doc: `{
+				"@context": "https://openvex.dev/ns",
+				"statements": [
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "not_affected", "justification": "component_not_present"},
+					{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/a@1.0.0"}], "status": "fixed"},
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/b@1.0.0"}], "status": "affected", "action_statement": "Upgrade"},
+					{"vulnerability": {"name": "CVE-2023-0002"}, "products": [{"@id": "pkg:npm/b@1.0.0"}], "status": "fixed"},
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/c@1.0.0"}], "status": "under_investigation"},
+					{"vulnerability": {"name": "CVE-2023-0001"}, "products": [{"@id": "pkg:npm/d@1.0.0"}], "status": "affected", "action_statement": "Upgrade"}
+				]
+			}`,
+			wantUniform: false,
+			wantMixed: []MixedStatus{
+				{
+					Vulnerability: "CVE-2023-0001",
+					Statuses:      []string{"not_affected", "affected", "under_investigation"},
+					Statements:    []int{0, 2, 4, 5},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := client.CheckUniformStatus(decodeDocument(t, tt.doc))
+			if err != nil {
+				t.Fatalf("CheckUniformStatus() error = %v", err)
+			}
+			if report.Uniform != tt.wantUniform {
+				t.Errorf("CheckUniformStatus() uniform = %v, want %v", report.Uniform, tt.wantUniform)
+			}
+			if !reflect.DeepEqual(report.Vulnerabilities, tt.wantMixed) {
+				t.Errorf("CheckUniformStatus() vulnerabilities = %+v, want %+v", report.Vulnerabilities, tt.wantMixed)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package vex
+
+import "fmt"
+
+// DuplicateID is an @id shared by more than one document
+type DuplicateID struct {
+	ID        string `json:"id"`
+	Documents []int  `json:"documents"`
+}
+
+// UniqueIDsReport lists the @id values shared by several documents. Unique
+// is true when there are none. Documents without an @id are not compared.
+type UniqueIDsReport struct {
+	Unique     bool          `json:"unique"`
+	Duplicates []DuplicateID `json:"duplicates"`
+}
+
+// CheckUniqueIDs reports @id values that appear in more than one document,
+// with the 1-based positions of the documents using each, so a set can be
+// checked before ingestion into storage keyed by @id
+func (c *Client) CheckUniqueIDs(raw []map[string]interface{}) (*UniqueIDsReport, error) {
+	if err := ValidateDocumentListCount(len(raw)); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	docs, err := parseDocuments(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	positions := map[string][]int{}
+	var order []string
+	for i, doc := range docs {
+		if doc.ID == "" {
+			continue
+		}
+		if _, seen := positions[doc.ID]; !seen {
+			order = append(order, doc.ID)
+		}
+		positions[doc.ID] = append(positions[doc.ID], i+1)
+	}
+
+	report := &UniqueIDsReport{Duplicates: []DuplicateID{}}
+	for _, id := range order {
+		if len(positions[id]) > 1 {
+			report.Duplicates = append(report.Duplicates, DuplicateID{ID: id, Documents: positions[id]})
+		}
+	}
+	report.Unique = len(report.Duplicates) == 0
+	return report, nil
+}
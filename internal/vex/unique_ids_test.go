@@ -0,0 +1,87 @@
+package vex
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCheckUniqueIDs(t *testing.T) {
+	client := NewClient("test-author")
+	document := func(id string) map[string]interface{} {
+		idField := ""
+		if id != "" {
+			idField = fmt.Sprintf(`"@id": %q,`, id)
+		}
+		return decodeDocument(t, fmt.Sprintf(`{
+			"@context": "https://openvex.dev/ns",
+			%s
+			"timestamp": "2023-01-01T00:00:00Z",
+			"statements": []
+		}`, idField))
+	}
+
+	tests := []struct {
+		name            string
+		ids             []string
+		wantUnique      bool
+		wantDuplicates  []DuplicateID
+		wantErrContains string
+	}{
+		{
+			name:           "unique",
+			ids:            []string{"doc-a", "doc-b", "doc-c"},
+			wantUnique:     true,
+			wantDuplicates: []DuplicateID{},
+		},
+		{
+			name:       "duplicates",
+			ids:        []string{"doc-a", "doc-b", "doc-a", "doc-c", "doc-b", "doc-a"},
+			wantUnique: false,
+			wantDuplicates: []DuplicateID{
+				{ID: "doc-a", Documents: []int{1, 3, 6}},
+				{ID: "doc-b", Documents: []int{2, 5}},
+			},
+		},
+		{
+			name:           "missing ids are not compared",
+			ids:            []string{"", "doc-a", ""},
+			wantUnique:     true,
+			wantDuplicates: []DuplicateID{},
+		},
+		{
+			name:            "no documents",
+			wantErrContains: "at least one document is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var docs []map[string]interface{}
+			for _, id := range tt.ids {
+				docs = append(docs, document(id))
+			}
+
+			report, err := client.CheckUniqueIDs(docs)
+			if tt.wantErrContains != "" {
+				if err == nil {
+					t.Fatal("CheckUniqueIDs() expected error")
+				}
+				if !strings.Contains(err.Error(), tt.wantErrContains) {
+					t.Errorf("CheckUniqueIDs() error = %v, want to contain %v", err, tt.wantErrContains)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CheckUniqueIDs() error = %v", err)
+			}
+			if report.Unique != tt.wantUnique {
+				t.Errorf("CheckUniqueIDs() Unique = %v, want %v", report.Unique, tt.wantUnique)
+			}
+			if !reflect.DeepEqual(report.Duplicates, tt.wantDuplicates) {
+				t.Errorf("CheckUniqueIDs() Duplicates = %v, want %v", report.Duplicates, tt.wantDuplicates)
+			}
+		})
+	}
+}
@@ -2,29 +2,57 @@ package vex
 
 import (
 	"fmt"
+	"path/filepath"
 	"regexp"
+	"strings"
 )
 
 // Security limits for DoS prevention
 const (
-	MaxStringLength   = 1000 // General max for most string fields
-	MaxAuthorLength   = 200  // Shorter limit for author fields
-	MaxIDLength       = 500  // Limit for custom IDs
-	MaxMergeDocuments = 20   // Maximum documents to merge at once
-	MinMergeDocuments = 2    // Minimum documents needed for merge
+	MaxStringLength     = 1000 // General max for most string fields
+	MaxAuthorLength     = 200  // Shorter limit for author fields
+	MaxAuthorRoleLength = 200  // Limit for author_role
+	MaxIDLength         = 500  // Limit for custom IDs
+	MaxMergeDocuments   = 20   // Maximum documents to merge at once
+	MinMergeDocuments   = 2    // Minimum documents needed for merge
+	MaxDirectoryFiles   = 500  // Default maximum files read by a directory merge
+	MaxLabels           = 32   // Maximum labels per document
+	MaxLabelKeyLength   = 63   // Limit for label keys
+	MaxBatchItems       = 100  // Maximum statements created by one batch request
+	MaxNotes            = 20   // Maximum notes per statement
+	MaxSubcomponents    = 100  // Maximum subcomponents per product
+	MaxScannerFindings  = 1000 // Maximum statements imported from one scanner report
+
+	MaxDocumentFileBytes = 64 << 20 // Maximum size of a document file read from disk
+	MaxSBOMComponents    = 10000    // Maximum SBOM components checked against a document
 )
 
+// labelKeyPattern restricts label keys to simple identifiers usable as index keys
+var labelKeyPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._/-]*$`)
+
 // Dangerous characters that could be used for injection attacks
 // Defense in depth - even though we use native library, not subprocesses
 var dangerousChars = regexp.MustCompile(`[;&|` + "`" + `$(){}[\]<>'"\\]`)
 
+// ValidationError is a rejected input field. It carries the field name and
+// rejection reason but never the rejected value, so it is safe to log.
+type ValidationError struct {
+	Field  string
+	Reason string
+}
+
+// Error returns the field name followed by the rejection reason
+func (e *ValidationError) Error() string {
+	return e.Field + " " + e.Reason
+}
+
 // ValidateStringLength checks if a string exceeds maximum length (DoS prevention)
 func ValidateStringLength(name, value string, maxLength int) error {
 	if value == "" {
 		return nil // Empty is okay, let go-vex handle required field validation
 	}
 	if len(value) > maxLength {
-		return fmt.Errorf("%s exceeds maximum length of %d characters", name, maxLength)
+		return &ValidationError{Field: name, Reason: fmt.Sprintf("exceeds maximum length of %d characters", maxLength)}
 	}
 	return nil
 }
@@ -35,7 +63,7 @@ func ValidateDangerousChars(name, value string) error {
 		return nil
 	}
 	if dangerousChars.MatchString(value) {
-		return fmt.Errorf("%s contains potentially dangerous characters", name)
+		return &ValidationError{Field: name, Reason: "contains potentially dangerous characters"}
 	}
 	return nil
 }
@@ -43,7 +71,55 @@ func ValidateDangerousChars(name, value string) error {
 // ValidateRequired checks if a required field is present
 func ValidateRequired(name, value string) error {
 	if value == "" {
-		return fmt.Errorf("%s is required", name)
+		return &ValidationError{Field: name, Reason: "is required"}
+	}
+	return nil
+}
+
+// ValidateRelativePath checks that path is relative and stays within the
+// directory it is resolved against
+func ValidateRelativePath(name, path string) error {
+	if filepath.IsAbs(path) {
+		return &ValidationError{Field: name, Reason: "must be a relative path"}
+	}
+	cleaned := filepath.Clean(path)
+	if cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+		return &ValidationError{Field: name, Reason: "must not leave the configured directory"}
+	}
+	return nil
+}
+
+// ValidateWithinDirectories checks that path is one of dirs or lies beneath
+// one of them. Symlinks are resolved on both sides first, so a link inside
+// a directory cannot point outside it.
+func ValidateWithinDirectories(name, path string, dirs []string) error {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return &ValidationError{Field: name, Reason: "is not a valid path"}
+	}
+	abs = resolveSymlinks(abs)
+	for _, dir := range dirs {
+		base, err := filepath.Abs(dir)
+		if err != nil {
+			continue
+		}
+		base = resolveSymlinks(base)
+		rel, err := filepath.Rel(base, abs)
+		if err != nil {
+			continue
+		}
+		if rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return nil
+		}
+	}
+	return &ValidationError{Field: name, Reason: "must be within an allowed directory"}
+}
+
+// ValidateDocumentFileSize checks that a document file is small enough to
+// read without unbounded memory use
+func ValidateDocumentFileSize(name string, size int64) error {
+	if size > MaxDocumentFileBytes {
+		return fmt.Errorf("%s is %d bytes, maximum is %d", name, size, MaxDocumentFileBytes)
 	}
 	return nil
 }
@@ -58,3 +134,99 @@ func ValidateDocumentCount(count int) error {
 	}
 	return nil
 }
+
+// ValidateDocumentListCount validates the number of documents passed to a
+// multi-document query or consolidation, which unlike merge accepts one
+func ValidateDocumentListCount(count int) error {
+	if count == 0 {
+		return fmt.Errorf("at least one document is required")
+	}
+	if count > MaxMergeDocuments {
+		return fmt.Errorf("maximum of %d documents can be processed at once", MaxMergeDocuments)
+	}
+	return nil
+}
+
+// ValidateVulnerabilityCount checks that a scaffold request lists a
+// processable number of vulnerabilities
+func ValidateVulnerabilityCount(count int) error {
+	if count == 0 {
+		return fmt.Errorf("at least one vulnerability is required")
+	}
+	if count > MaxBatchItems {
+		return fmt.Errorf("maximum of %d vulnerabilities can be scaffolded at once", MaxBatchItems)
+	}
+	return nil
+}
+
+// ValidateBatchCount checks that a batch request has a processable number of items
+func ValidateBatchCount(count int) error {
+	if count == 0 {
+		return fmt.Errorf("at least one item is required")
+	}
+	if count > MaxBatchItems {
+		return fmt.Errorf("batch contains %d items, maximum is %d", count, MaxBatchItems)
+	}
+	return nil
+}
+
+// ValidateSubcomponentCount checks the number of subcomponents of one product
+func ValidateSubcomponentCount(count int) error {
+	if count > MaxSubcomponents {
+		return fmt.Errorf("product has %d subcomponents, maximum is %d", count, MaxSubcomponents)
+	}
+	return nil
+}
+
+// ValidateDirectoryFileCount validates the number of files found for a directory merge
+func ValidateDirectoryFileCount(count, max int) error {
+	if count == 0 {
+		return fmt.Errorf("no VEX documents found in directory")
+	}
+	if count > max {
+		return fmt.Errorf("directory contains %d VEX documents, maximum is %d", count, max)
+	}
+	return nil
+}
+
+// ValidateNotes validates the freeform notes attached to a statement
+func ValidateNotes(notes []string) error {
+	if len(notes) > MaxNotes {
+		return &ValidationError{Field: "notes", Reason: fmt.Sprintf("exceed the maximum of %d allowed", MaxNotes)}
+	}
+	for i, note := range notes {
+		name := fmt.Sprintf("notes[%d]", i)
+		if strings.TrimSpace(note) == "" {
+			return &ValidationError{Field: name, Reason: "is empty"}
+		}
+		if err := ValidateStringLength(name, note, MaxStringLength); err != nil {
+			return err
+		}
+		if err := ValidateDangerousChars(name, note); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateLabels validates document labels used for storage indexing
+func ValidateLabels(labels map[string]string) error {
+	if len(labels) > MaxLabels {
+		return &ValidationError{Field: "labels", Reason: fmt.Sprintf("exceed the maximum of %d allowed", MaxLabels)}
+	}
+	for key, value := range labels {
+		if len(key) > MaxLabelKeyLength {
+			return &ValidationError{Field: "label key", Reason: fmt.Sprintf("exceeds maximum length of %d characters", MaxLabelKeyLength)}
+		}
+		if !labelKeyPattern.MatchString(key) {
+			return &ValidationError{Field: "label key", Reason: "must start with a letter or digit and contain only letters, digits, '.', '_', '/', or '-'"}
+		}
+		if err := ValidateStringLength(fmt.Sprintf("labels[%s]", key), value, MaxAuthorLength); err != nil {
+			return err
+		}
+		if err := ValidateDangerousChars(fmt.Sprintf("labels[%s]", key), value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -260,6 +260,27 @@ func TestValidateDocumentCount(t *testing.T) {
 	}
 }
 
+func TestValidateSubcomponentCount(t *testing.T) {
+	tests := []struct {
+		name    string
+		count   int
+		wantErr bool
+	}{
+		{name: "no subcomponents passes", count: 0, wantErr: false},
+		{name: "max subcomponents passes", count: MaxSubcomponents, wantErr: false},
+		{name: "over max subcomponents fails", count: MaxSubcomponents + 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSubcomponentCount(tt.count)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSubcomponentCount() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestValidationConstants(t *testing.T) {
 	// Verify constants are set to reasonable values
 	if MaxStringLength != 1000 {
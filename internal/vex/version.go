@@ -0,0 +1,49 @@
+package vex
+
+import (
+	"math"
+	"time"
+)
+
+// BumpVersion increments the integer version of a document and refreshes its
+// timestamp for re-publishing. A missing, non-integer, or non-positive
+// version is reset to 1. Document and statement extension fields are
+// preserved.
+func (c *Client) BumpVersion(raw map[string]interface{}) (*Document, error) {
+	// Work on a copy so the malformed version never reaches the parser and
+	// the caller's document is left untouched
+	updated := make(map[string]interface{}, len(raw))
+	for key, value := range raw {
+		updated[key] = value
+	}
+	updated["version"] = nextVersion(raw["version"])
+
+	doc, err := parseDocument(updated)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	doc.Timestamp = &now
+
+	result := documentWithExtensions(doc, raw)
+	return result, nil
+}
+
+// nextVersion returns the version following a raw JSON version value, or 1
+// when the value is not a positive integer
+func nextVersion(value interface{}) int {
+	var version float64
+	switch v := value.(type) {
+	case float64:
+		version = v
+	case int:
+		version = float64(v)
+	default:
+		return 1
+	}
+	if version < 1 || version != math.Trunc(version) || version >= math.MaxInt32 {
+		return 1
+	}
+	return int(version) + 1
+}
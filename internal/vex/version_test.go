@@ -0,0 +1,90 @@
+package vex
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestBumpVersion(t *testing.T) {
+	client := NewClient("test-author")
+
+	tests := []struct {
+		name        string
+		version     interface{}
+		wantVersion int
+	}{
+		{name: "present", version: float64(3), wantVersion: 4},
+		{name: "missing", version: nil, wantVersion: 1},
+		{name: "string", version: "2", wantVersion: 1},
+		{name: "fractional", version: 1.5, wantVersion: 1},
+		{name: "zero", version: float64(0), wantVersion: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"@context":  "https://openvex.dev/ns",
+				"@id":       "doc1",
+				"timestamp": "2023-01-01T00:00:00Z",
+				"labels":    map[string]interface{}{"team": "platform"},
+				"statements": []interface{}{
+					map[string]interface{}{
+						"vulnerability": map[string]interface{}{"name": "CVE-2023-1234"},
+						"products":      []interface{}{map[string]interface{}{"@id": "pkg:npm/lodash@4.17.21"}},
+						"status":        "fixed",
+						"notes":         []interface{}{"patched upstream"},
+						"cvss":          map[string]interface{}{"score": 7.5},
+					},
+				},
+			}
+			if tt.version != nil {
+				raw["version"] = tt.version
+			}
+
+			before := time.Now()
+			doc, err := client.BumpVersion(raw)
+			if err != nil {
+				t.Fatalf("BumpVersion() error = %v", err)
+			}
+			if doc.Version != tt.wantVersion {
+				t.Errorf("Version = %v, want %v", doc.Version, tt.wantVersion)
+			}
+			if doc.Timestamp == nil || doc.Timestamp.Before(before) {
+				t.Errorf("Timestamp = %v, want refreshed", doc.Timestamp)
+			}
+			if doc.ID != "doc1" || len(doc.Statements) != 1 {
+				t.Errorf("document content should be preserved, got %+v", doc.VEX)
+			}
+			if raw["version"] != tt.version {
+				t.Error("BumpVersion() should not modify the input document")
+			}
+
+			output, err := json.Marshal(doc)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			var decoded map[string]interface{}
+			json.Unmarshal(output, &decoded)
+			if _, ok := decoded["labels"]; !ok {
+				t.Errorf("extension fields should be preserved, got %s", output)
+			}
+			stmt := decoded["statements"].([]interface{})[0].(map[string]interface{})
+			if _, ok := stmt["notes"]; !ok {
+				t.Errorf("statement notes should be preserved, got %s", output)
+			}
+			if _, ok := stmt["cvss"]; !ok {
+				t.Errorf("statement cvss should be preserved, got %s", output)
+			}
+		})
+	}
+}
+
+func TestBumpVersion_InvalidDocument(t *testing.T) {
+	client := NewClient("test-author")
+
+	_, err := client.BumpVersion(map[string]interface{}{"statements": "invalid"})
+	if err == nil {
+		t.Error("BumpVersion() expected error for invalid document")
+	}
+}
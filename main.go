@@ -1,30 +1,129 @@
 package main
 
 import (
+	"flag"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/rosstaco/vexdoc-mcp/internal/mcp"
 	"github.com/rosstaco/vexdoc-mcp/internal/tools"
 	"github.com/rosstaco/vexdoc-mcp/internal/vex"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
 )
 
 func main() {
+	mergeDir := flag.String("merge-dir", "", "directory of *.vex.json documents exposed to merge_vex_directory, relative to $VEXDOC_FILE_ROOT (disabled when empty); client roots can only narrow it")
+	migrateDir := flag.String("migrate-dir", "", "directory of *.vex.json documents exposed to migrate_vex_directory, which rewrites them, relative to $VEXDOC_FILE_ROOT (disabled when empty)")
+	maxMergeFiles := flag.Int("max-merge-files", vex.MaxDirectoryFiles, "maximum number of files merge_vex_directory and migrate_vex_directory will read")
+	idTemplate := flag.String("id-template", "", "template for generated document IDs, e.g. urn:uuid:{uuid}; supports {uuid}, {unix}, and {prefix} (default vex-{unix})")
+	idPrefix := flag.String("id-prefix", vex.DefaultIDPrefix, "value substituted for {prefix} in -id-template")
+	lenientJustifications := flag.Bool("lenient-justifications", false, "accept case, separator, and synonym variants of justifications (e.g. component-not-present)")
+	requireAuthor := flag.Bool("require-author", false, "make validate_vex_document reject documents without an author instead of warning")
+	allowedContexts := flag.String("allowed-contexts", "", "comma-separated @context URIs accepted in documents supplied for merging (default: any)")
+	maxOutputBytes := flag.Int("max-output-bytes", vex.DefaultMaxOutputBytes, "maximum size of a serialized document in a tool result")
+	rateLimit := flag.Float64("rate-limit", 0, "maximum tool calls per second (0 disables rate limiting)")
+	rateBurst := flag.Int("rate-burst", 0, "tool calls allowed in a burst above -rate-limit (default: the per-second rate)")
+	safeErrors := flag.Bool("safe-errors", false, "omit internal error details from JSON-RPC error responses, logging them to stderr instead")
+	idleTimeout := flag.Duration("idle-timeout", 0, "exit when no request arrives for this long, e.g. 5m (0 disables)")
+	flag.Parse()
+
+	clientOpts := []vex.Option{
+		vex.WithMaxDirectoryFiles(*maxMergeFiles),
+		vex.WithLenientJustifications(*lenientJustifications),
+		vex.WithAllowedContexts(strings.Split(*allowedContexts, ",")...),
+		vex.WithRequireAuthor(*requireAuthor),
+		vex.WithMaxOutputBytes(*maxOutputBytes),
+		vex.WithGenerator(mcp.ServerName, mcp.Version()),
+	}
+	// File operations are confined to the file root and disabled without one
+	fileRoot := os.Getenv(vex.FileRootEnv)
+	if fileRoot != "" {
+		clientOpts = append(clientOpts, vex.WithFileRoot(fileRoot))
+	}
+	if *idTemplate != "" {
+		template, err := vex.ParseIDTemplate(*idTemplate, *idPrefix)
+		if err != nil {
+			log.Fatalf("Invalid ID template: %v", err)
+		}
+		clientOpts = append(clientOpts, vex.WithIDTemplate(template))
+	}
+
 	// Create MCP server instance
-	server := mcp.NewServer()
+	server := mcp.NewServer(
+		mcp.WithName(os.Getenv(mcp.ServerNameEnv)),
+		mcp.WithVersion(os.Getenv(mcp.ServerVersionEnv)),
+		mcp.WithRateLimit(*rateLimit, *rateBurst),
+		mcp.WithIdleTimeout(*idleTimeout),
+		mcp.WithSafeErrors(*safeErrors),
+	)
 
 	// Create VEX client
-	vexClient := vex.NewClient("vexdoc-mcp-server")
+	vexClient := vex.NewClient("vexdoc-mcp-server", clientOpts...)
 
 	// Register VEX tools
-	createTool := tools.NewVEXCreateTool(vexClient)
-	if err := server.RegisterTool(createTool); err != nil {
-		log.Fatalf("Failed to register create tool: %v", err)
+	vexTools := []api.Tool{
+		tools.NewVEXCreateTool(vexClient),
+		tools.NewVEXMergeTool(vexClient),
+		tools.NewVEXTimestampCheckTool(vexClient),
+		tools.NewVEXRemediationsTool(vexClient),
+		tools.NewVEXBatchCreateTool(vexClient),
+		tools.NewVEXEqualTool(vexClient),
+		tools.NewVEXAffectedProductsTool(vexClient),
+		tools.NewVEXBumpVersionTool(vexClient),
+		tools.NewVEXConsolidateTool(vexClient),
+		tools.NewVEXValidateTool(vexClient),
+		tools.NewVEXPURLConsistencyTool(vexClient),
+		tools.NewVEXStalenessTool(vexClient),
+		tools.NewVEXPatchTool(vexClient),
+		tools.NewVEXApplyPatchTool(vexClient),
+		tools.NewVEXStatusHistogramTool(vexClient),
+		tools.NewVEXAliasConsistencyTool(vexClient),
+		tools.NewVEXScaffoldTool(vexClient),
+		tools.NewVEXCanParseTool(vexClient),
+		tools.NewVEXFindDuplicatesTool(vexClient),
+		tools.NewVEXCheckActionsTool(vexClient),
+		tools.NewVEXFlattenSubcomponentsTool(vexClient),
+		tools.NewVEXHashStatementsTool(vexClient),
+		tools.NewVEXNormalizeIDsTool(vexClient),
+		tools.NewVEXCheckBudgetTool(vexClient),
+		tools.NewVEXGetStatementTool(vexClient),
+		tools.NewVEXReplaceStatementTool(vexClient),
+		tools.NewVEXRemoveStatementTool(vexClient),
+		tools.NewVEXAutofixTool(vexClient),
+		tools.NewVEXMatrixTool(vexClient),
+		tools.NewVEXMergeMixedTool(vexClient),
+		tools.NewVEXCheckHashesTool(vexClient),
+		tools.NewVEXCheckInvestigationsTool(vexClient),
+		tools.NewVEXReportMarkdownTool(vexClient),
+		tools.NewVEXPatchMetadataTool(vexClient),
+		tools.NewVEXReauthorTool(vexClient),
+		tools.NewVEXCheckUniqueIDsTool(vexClient),
+		tools.NewVEXCheckPublishTool(vexClient),
+		tools.NewVEXSortProductsTool(vexClient),
+		tools.NewVEXSplitStatusTool(vexClient),
+		tools.NewVEXStatementSchemaTool(vexClient),
+		tools.NewVEXCheckJustificationTool(vexClient),
+		tools.NewVEXCheckUniformStatusTool(vexClient),
+		tools.NewVEXCheckMonotonicityTool(vexClient),
+		tools.NewVEXImportScannerTool(vexClient),
+		tools.NewVEXCheckSBOMTool(vexClient),
+	}
+	if fileRoot != "" {
+		if *mergeDir != "" {
+			vexTools = append(vexTools, tools.NewVEXDirectoryMergeTool(vexClient, *mergeDir))
+		}
+		if *migrateDir != "" {
+			vexTools = append(vexTools, tools.NewVEXDirectoryMigrateTool(vexClient, *migrateDir))
+		}
+	} else {
+		log.Printf("[INFO] File tools disabled: set %s to enable them", vex.FileRootEnv)
 	}
 
-	mergeTool := tools.NewVEXMergeTool(vexClient)
-	if err := server.RegisterTool(mergeTool); err != nil {
-		log.Fatalf("Failed to register merge tool: %v", err)
+	for _, tool := range vexTools {
+		if err := server.RegisterTool(tool); err != nil {
+			log.Fatalf("Failed to register %s tool: %v", tool.Name(), err)
+		}
 	}
 
 	// Start server with stdio transport
@@ -16,9 +16,17 @@ type MCPServer interface {
 type Transport interface {
 	Read() (*Request, error)
 	Write(*Response) error
+	Notify(*Notification) error
 	Close() error
 }
 
+// RequestTransport extends Transport with sending server-initiated requests,
+// such as roots/list. The client's responses arrive through Read.
+type RequestTransport interface {
+	Transport
+	Request(*Request) error
+}
+
 // Tool represents an MCP tool
 type Tool interface {
 	Name() string
@@ -27,6 +35,21 @@ type Tool interface {
 	Execute(ctx context.Context, args map[string]interface{}) (*ToolResult, error)
 }
 
+// StructuredTool extends Tool with a declared schema for the
+// structuredContent of its results
+type StructuredTool interface {
+	Tool
+	OutputSchema() *JSONSchema
+}
+
+// TitledTool extends Tool with a human-friendly display title. Tools that
+// don't implement it are listed without a title, and clients fall back to
+// the name.
+type TitledTool interface {
+	Tool
+	Title() string
+}
+
 // StreamingTool extends Tool with streaming capabilities
 type StreamingTool interface {
 	Tool
@@ -0,0 +1,36 @@
+package api
+
+import (
+	"context"
+	"net/url"
+	"path/filepath"
+)
+
+type rootsKey struct{}
+
+// ContextWithRoots returns a context carrying the client's root directories,
+// so tools can restrict file access to them
+func ContextWithRoots(ctx context.Context, roots []string) context.Context {
+	return context.WithValue(ctx, rootsKey{}, roots)
+}
+
+// RootsFromContext returns the client's root directories, or nil when the
+// client listed none
+func RootsFromContext(ctx context.Context) []string {
+	roots, _ := ctx.Value(rootsKey{}).([]string)
+	return roots
+}
+
+// RootDirectories converts the file:// URIs of roots to local directories,
+// skipping roots with any other scheme
+func RootDirectories(roots []Root) []string {
+	var dirs []string
+	for _, root := range roots {
+		u, err := url.Parse(root.URI)
+		if err != nil || u.Scheme != "file" || u.Path == "" {
+			continue
+		}
+		dirs = append(dirs, filepath.Clean(filepath.FromSlash(u.Path)))
+	}
+	return dirs
+}
@@ -2,15 +2,21 @@ package api
 
 import "encoding/json"
 
-// Request represents an MCP JSON-RPC request
+// Request represents an MCP JSON-RPC request. A message read without a
+// method is the client's response to a server-initiated request, carrying
+// Result or Error instead of Params.
 type Request struct {
-	JSONRPC string          `json:"jsonrpc"`
-	ID      interface{}     `json:"id"`
-	Method  string          `json:"method"`
-	Params  json.RawMessage `json:"params,omitempty"`
+	JSONRPC string                 `json:"jsonrpc"`
+	ID      interface{}            `json:"id"`
+	Method  string                 `json:"method"`
+	Params  json.RawMessage        `json:"params,omitempty"`
+	Meta    map[string]interface{} `json:"_meta,omitempty"`
+	Result  json.RawMessage        `json:"result,omitempty"`
+	Error   *Error                 `json:"error,omitempty"`
 }
 
-// Response represents an MCP JSON-RPC response
+// Response represents an MCP JSON-RPC response. Response metadata belongs in
+// the result's _meta object, not the envelope.
 type Response struct {
 	JSONRPC string      `json:"jsonrpc"`
 	ID      interface{} `json:"id"`
@@ -34,8 +40,10 @@ type Error struct {
 
 // ToolResult represents the result of tool execution
 type ToolResult struct {
-	Content []Content `json:"content"`
-	IsError bool      `json:"isError,omitempty"`
+	Content           []Content              `json:"content"`
+	StructuredContent interface{}            `json:"structuredContent,omitempty"`
+	IsError           bool                   `json:"isError,omitempty"`
+	Meta              map[string]interface{} `json:"_meta,omitempty"`
 }
 
 // Content represents a piece of content in a tool result
@@ -46,9 +54,11 @@ type Content struct {
 
 // ToolInfo contains metadata about a tool
 type ToolInfo struct {
-	Name        string      `json:"name"`
-	Description string      `json:"description"`
-	InputSchema *JSONSchema `json:"inputSchema"`
+	Name         string      `json:"name"`
+	Title        string      `json:"title,omitempty"`
+	Description  string      `json:"description"`
+	InputSchema  *JSONSchema `json:"inputSchema"`
+	OutputSchema *JSONSchema `json:"outputSchema,omitempty"`
 }
 
 // JSONSchema represents a JSON Schema for tool parameters
@@ -59,6 +69,8 @@ type JSONSchema struct {
 	Items                *JSONSchema            `json:"items,omitempty"`
 	Enum                 []string               `json:"enum,omitempty"`
 	Description          string                 `json:"description,omitempty"`
+	Examples             []interface{}          `json:"examples,omitempty"`
+	Default              interface{}            `json:"default,omitempty"`
 	AdditionalProperties interface{}            `json:"additionalProperties,omitempty"`
 }
 
@@ -89,6 +101,24 @@ type ServerCapabilities struct {
 // ClientCapabilities represents the capabilities advertised by the client
 type ClientCapabilities struct {
 	Experimental map[string]interface{} `json:"experimental,omitempty"`
+	Roots        *RootsCapability       `json:"roots,omitempty"`
+}
+
+// RootsCapability advertises that the client exposes filesystem roots,
+// which the server fetches with a roots/list request
+type RootsCapability struct {
+	ListChanged bool `json:"listChanged,omitempty"`
+}
+
+// RootsListResult is the client's response to roots/list
+type RootsListResult struct {
+	Roots []Root `json:"roots"`
+}
+
+// Root is a filesystem root exposed by the client, identified by a file:// URI
+type Root struct {
+	URI  string `json:"uri"`
+	Name string `json:"name,omitempty"`
 }
 
 // InitializeRequest represents the initialize method parameters
@@ -122,8 +152,11 @@ type ToolsListResult struct {
 	Tools []ToolInfo `json:"tools"`
 }
 
-// ToolCallParams represents the parameters for tools/call
+// ToolCallParams represents the parameters for tools/call. Arguments may be
+// omitted by the client, in which case it is nil; the server passes tools an
+// empty map instead, so absent and empty arguments behave the same.
 type ToolCallParams struct {
 	Name      string                 `json:"name"`
-	Arguments map[string]interface{} `json:"arguments"`
+	Arguments map[string]interface{} `json:"arguments,omitempty"`
+	Meta      map[string]interface{} `json:"_meta,omitempty"`
 }
@@ -0,0 +1,143 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// MCP protocol constants used by the client
+const (
+	JSONRPCVersion  = "2.0"
+	ProtocolVersion = "2024-11-05"
+)
+
+// RPCError is returned when the server answers a request with a JSON-RPC error
+type RPCError struct {
+	Code    int
+	Message string
+	Data    interface{}
+}
+
+// Error implements the error interface
+func (e *RPCError) Error() string {
+	if e.Data != nil {
+		return fmt.Sprintf("rpc error %d: %s (%v)", e.Code, e.Message, e.Data)
+	}
+	return fmt.Sprintf("rpc error %d: %s", e.Code, e.Message)
+}
+
+// Client is a minimal MCP client issuing one request at a time
+type Client struct {
+	transport Transport
+	mu        sync.Mutex
+	nextID    int
+}
+
+// New creates a client communicating over transport
+func New(transport Transport) *Client {
+	return &Client{transport: transport}
+}
+
+// Initialize performs the initialize handshake
+func (c *Client) Initialize(info api.ClientInfo) (*api.InitializeResult, error) {
+	params := api.InitializeRequest{
+		ProtocolVersion: ProtocolVersion,
+		ClientInfo:      info,
+	}
+
+	var result api.InitializeResult
+	if err := c.call("initialize", params, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListTools returns the tools registered on the server
+func (c *Client) ListTools() ([]api.ToolInfo, error) {
+	var result api.ToolsListResult
+	if err := c.call("tools/list", nil, &result); err != nil {
+		return nil, err
+	}
+	return result.Tools, nil
+}
+
+// CallTool executes a tool on the server
+func (c *Client) CallTool(name string, args map[string]interface{}) (*api.ToolResult, error) {
+	params := api.ToolCallParams{
+		Name:      name,
+		Arguments: args,
+	}
+
+	var result api.ToolResult
+	if err := c.call("tools/call", params, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Close closes the underlying transport
+func (c *Client) Close() error {
+	return c.transport.Close()
+}
+
+// call sends a request and decodes the matching response's result into result
+func (c *Client) call(method string, params interface{}, result interface{}) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.nextID++
+	req := &api.Request{
+		JSONRPC: JSONRPCVersion,
+		ID:      c.nextID,
+		Method:  method,
+	}
+	if params != nil {
+		data, err := json.Marshal(params)
+		if err != nil {
+			return fmt.Errorf("error marshaling %s params: %w", method, err)
+		}
+		req.Params = data
+	}
+
+	if err := c.transport.Send(req); err != nil {
+		return err
+	}
+
+	resp, err := c.receiveResponse()
+	if err != nil {
+		return err
+	}
+	if id, ok := resp.ID.(float64); !ok || int(id) != c.nextID {
+		return fmt.Errorf("response id %v does not match request id %d", resp.ID, c.nextID)
+	}
+	if resp.Error != nil {
+		return &RPCError{Code: resp.Error.Code, Message: resp.Error.Message, Data: resp.Error.Data}
+	}
+
+	// Round-trip the generic result into the typed result
+	data, err := json.Marshal(resp.Result)
+	if err != nil {
+		return fmt.Errorf("error marshaling %s result: %w", method, err)
+	}
+	if err := json.Unmarshal(data, result); err != nil {
+		return fmt.Errorf("error parsing %s result: %w", method, err)
+	}
+	return nil
+}
+
+// receiveResponse reads the next response, skipping server notifications,
+// which carry neither an id nor an error
+func (c *Client) receiveResponse() (*api.Response, error) {
+	for {
+		resp, err := c.transport.Receive()
+		if err != nil {
+			return nil, err
+		}
+		if resp.ID != nil || resp.Error != nil {
+			return resp, nil
+		}
+	}
+}
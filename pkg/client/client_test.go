@@ -0,0 +1,117 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/rosstaco/vexdoc-mcp/internal/mcp"
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// echoTool returns its "message" argument as text
+type echoTool struct{}
+
+func (e *echoTool) Name() string {
+	return "echo"
+}
+
+func (e *echoTool) Description() string {
+	return "Echo a message"
+}
+
+func (e *echoTool) InputSchema() *api.JSONSchema {
+	return &api.JSONSchema{
+		Type: "object",
+		Properties: map[string]*api.JSONSchema{
+			"message": {Type: "string"},
+		},
+	}
+}
+
+func (e *echoTool) Execute(ctx context.Context, args map[string]interface{}) (*api.ToolResult, error) {
+	message, _ := args["message"].(string)
+	return &api.ToolResult{
+		Content: []api.Content{{Type: "text", Text: message}},
+	}, nil
+}
+
+func TestClient_FullCycle(t *testing.T) {
+	server := mcp.NewServer()
+	if err := server.RegisterTool(&echoTool{}); err != nil {
+		t.Fatalf("RegisterTool() error = %v", err)
+	}
+
+	serverTransport, clientTransport := NewPipe()
+	done := make(chan error, 1)
+	go func() {
+		done <- server.StartWithTransport(context.Background(), serverTransport)
+	}()
+
+	c := New(clientTransport)
+
+	initResult, err := c.Initialize(api.ClientInfo{Name: "test-client", Version: "1.0.0"})
+	if err != nil {
+		t.Fatalf("Initialize() error = %v", err)
+	}
+	if initResult.ServerInfo.Name != mcp.ServerName {
+		t.Errorf("ServerInfo.Name = %v, want %v", initResult.ServerInfo.Name, mcp.ServerName)
+	}
+
+	tools, err := c.ListTools()
+	if err != nil {
+		t.Fatalf("ListTools() error = %v", err)
+	}
+	if len(tools) != 1 || tools[0].Name != "echo" {
+		t.Errorf("ListTools() = %v, want [echo]", tools)
+	}
+
+	result, err := c.CallTool("echo", map[string]interface{}{"message": "hello"})
+	if err != nil {
+		t.Fatalf("CallTool() error = %v", err)
+	}
+	if len(result.Content) != 1 || result.Content[0].Text != "hello" {
+		t.Errorf("CallTool() content = %v, want hello", result.Content)
+	}
+
+	_, err = c.CallTool("missing", nil)
+	var rpcErr *RPCError
+	if !errors.As(err, &rpcErr) {
+		t.Fatalf("CallTool() error = %v, want RPCError", err)
+	}
+	if rpcErr.Code != mcp.MethodNotFound {
+		t.Errorf("RPCError code = %d, want %d", rpcErr.Code, mcp.MethodNotFound)
+	}
+
+	if err := c.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if err := <-done; err != nil {
+		t.Errorf("server exited with error = %v", err)
+	}
+}
+
+func TestPipe_SendAfterClose(t *testing.T) {
+	serverTransport, clientTransport := NewPipe()
+
+	if err := clientTransport.Send(&api.Request{JSONRPC: mcp.JSONRPCVersion, ID: 1, Method: mcp.MethodToolsList}); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if err := clientTransport.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if err := clientTransport.Send(&api.Request{JSONRPC: mcp.JSONRPCVersion, ID: 2, Method: mcp.MethodToolsList}); err == nil {
+		t.Error("Send() after Close expected error, got nil")
+	}
+
+	// The server still reads what was sent before Close, then EOF
+	req, err := serverTransport.Read()
+	if err != nil || fmt.Sprint(req.ID) != "1" {
+		t.Fatalf("Read() = %v, %v, want request 1", req, err)
+	}
+	if _, err := serverTransport.Read(); !errors.Is(err, io.EOF) {
+		t.Errorf("Read() after Close error = %v, want EOF", err)
+	}
+}
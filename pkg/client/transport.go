@@ -0,0 +1,217 @@
+package client
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/rosstaco/vexdoc-mcp/pkg/api"
+)
+
+// Transport is the client side of an MCP connection
+type Transport interface {
+	Send(*api.Request) error
+	Receive() (*api.Response, error)
+	Close() error
+}
+
+// StreamTransport implements Transport over newline-delimited JSON streams,
+// such as the stdin/stdout of a server subprocess
+type StreamTransport struct {
+	reader *bufio.Scanner
+	writer io.WriteCloser
+	mu     sync.Mutex
+}
+
+// NewStreamTransport creates a transport reading responses from r and writing requests to w
+func NewStreamTransport(r io.Reader, w io.WriteCloser) *StreamTransport {
+	scanner := bufio.NewScanner(r)
+	// Match the server's maximum message size
+	buf := make([]byte, 0, 64*1024)
+	scanner.Buffer(buf, 1024*1024)
+
+	return &StreamTransport{
+		reader: scanner,
+		writer: w,
+	}
+}
+
+// Send writes a request followed by a newline
+func (t *StreamTransport) Send(req *api.Request) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("error marshaling request: %w", err)
+	}
+	if _, err := t.writer.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("error writing request: %w", err)
+	}
+	return nil
+}
+
+// Receive reads the next response
+func (t *StreamTransport) Receive() (*api.Response, error) {
+	if !t.reader.Scan() {
+		if err := t.reader.Err(); err != nil {
+			return nil, fmt.Errorf("error reading response: %w", err)
+		}
+		return nil, io.EOF
+	}
+
+	var resp api.Response
+	if err := json.Unmarshal(t.reader.Bytes(), &resp); err != nil {
+		return nil, fmt.Errorf("error parsing JSON response: %w", err)
+	}
+	return &resp, nil
+}
+
+// Close closes the request stream, signalling EOF to the server
+func (t *StreamTransport) Close() error {
+	return t.writer.Close()
+}
+
+// pipe is a pair of in-memory message queues shared by both ends of NewPipe.
+// Neither queue is ever closed; each end signals closing on its own done
+// channel, so a send after Close fails instead of panicking.
+type pipe struct {
+	requests   chan []byte
+	responses  chan []byte
+	done       chan struct{}
+	clientDone chan struct{}
+	closeReq   sync.Once
+	closeDone  sync.Once
+}
+
+// NewPipe returns a connected pair of in-memory transports: the first is
+// passed to the server, the second to a Client. Messages are JSON encoded in
+// transit so both ends see exactly what a wire transport would deliver.
+func NewPipe() (api.Transport, Transport) {
+	p := &pipe{
+		requests:   make(chan []byte, 16),
+		responses:  make(chan []byte, 16),
+		done:       make(chan struct{}),
+		clientDone: make(chan struct{}),
+	}
+	return &serverPipe{p}, &clientPipe{p}
+}
+
+// serverPipe is the server end of an in-memory pipe
+type serverPipe struct {
+	*pipe
+}
+
+// Read reads the next request sent by the client
+func (p *serverPipe) Read() (*api.Request, error) {
+	var data []byte
+	select {
+	case data = <-p.requests:
+	case <-p.clientDone:
+		// Deliver anything sent before the client closed
+		select {
+		case data = <-p.requests:
+		default:
+			return nil, io.EOF
+		}
+	}
+
+	var req api.Request
+	if err := json.Unmarshal(data, &req); err != nil {
+		return nil, fmt.Errorf("error parsing JSON request: %w", err)
+	}
+	return &req, nil
+}
+
+// Write delivers a response to the client
+func (p *serverPipe) Write(resp *api.Response) error {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return fmt.Errorf("error marshaling response: %w", err)
+	}
+
+	select {
+	case p.responses <- data:
+		return nil
+	case <-p.done:
+		return fmt.Errorf("transport is closed")
+	}
+}
+
+// Notify delivers a notification to the client
+func (p *serverPipe) Notify(notification *api.Notification) error {
+	data, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("error marshaling notification: %w", err)
+	}
+
+	select {
+	case p.responses <- data:
+		return nil
+	case <-p.done:
+		return fmt.Errorf("transport is closed")
+	}
+}
+
+// Close marks the server end as closed
+func (p *serverPipe) Close() error {
+	p.closeDone.Do(func() { close(p.done) })
+	return nil
+}
+
+// clientPipe is the client end of an in-memory pipe
+type clientPipe struct {
+	*pipe
+}
+
+// Send delivers a request to the server
+func (p *clientPipe) Send(req *api.Request) error {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("error marshaling request: %w", err)
+	}
+
+	select {
+	case <-p.clientDone:
+		return fmt.Errorf("transport is closed")
+	default:
+	}
+	select {
+	case p.requests <- data:
+		return nil
+	case <-p.done:
+		return fmt.Errorf("transport is closed")
+	case <-p.clientDone:
+		return fmt.Errorf("transport is closed")
+	}
+}
+
+// Receive reads the next response written by the server
+func (p *clientPipe) Receive() (*api.Response, error) {
+	var data []byte
+	select {
+	case data = <-p.responses:
+	case <-p.done:
+		// Deliver anything written before the server closed
+		select {
+		case data = <-p.responses:
+		default:
+			return nil, io.EOF
+		}
+	}
+
+	var resp api.Response
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("error parsing JSON response: %w", err)
+	}
+	return &resp, nil
+}
+
+// Close marks the client end as closed, signalling EOF to the server once
+// it has read the pending requests
+func (p *clientPipe) Close() error {
+	p.closeReq.Do(func() { close(p.clientDone) })
+	return nil
+}